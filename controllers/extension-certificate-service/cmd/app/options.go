@@ -27,6 +27,7 @@ const ExtensionName = "extension-certificate-service"
 // Options holds configuration passed to the Certificate Service controller.
 type Options struct {
 	certOptions        *certificateservicecmd.CertificateServiceOptions
+	configFileOptions  *controllercmd.ManagerConfigFileOptions
 	restOptions        *controllercmd.RESTOptions
 	managerOptions     *controllercmd.ManagerOptions
 	controllerOptions  *controllercmd.ControllerOptions
@@ -38,8 +39,9 @@ type Options struct {
 // NewOptions creates a new Options instance.
 func NewOptions() *Options {
 	options := &Options{
-		certOptions: &certificateservicecmd.CertificateServiceOptions{},
-		restOptions: &controllercmd.RESTOptions{},
+		certOptions:       &certificateservicecmd.CertificateServiceOptions{},
+		configFileOptions: &controllercmd.ManagerConfigFileOptions{},
+		restOptions:       &controllercmd.RESTOptions{},
 		managerOptions: &controllercmd.ManagerOptions{
 			// These are default values.
 			LeaderElection:          true,
@@ -55,6 +57,7 @@ func NewOptions() *Options {
 	}
 
 	options.optionAggregator = controllercmd.NewOptionAggregator(
+		options.configFileOptions,
 		options.restOptions,
 		options.managerOptions,
 		options.controllerOptions,
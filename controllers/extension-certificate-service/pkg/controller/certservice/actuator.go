@@ -104,6 +104,21 @@ func (a *actuator) Delete(ctx context.Context, ex *extensionsv1alpha1.Extension)
 	return a.deleteRBAC(ctx, namespace)
 }
 
+// Migrate the Extension resource.
+func (a *actuator) Migrate(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
+	namespace := ex.GetNamespace()
+	if err := controller.SetKeepObjects(ctx, a.client, namespace, ShootResourcesName, true); err != nil {
+		return err
+	}
+
+	return a.Delete(ctx, ex)
+}
+
+// Restore the Extension resource.
+func (a *actuator) Restore(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
+	return a.Reconcile(ctx, ex)
+}
+
 // InjectConfig injects the rest config to this actuator.
 func (a *actuator) InjectConfig(config *rest.Config) error {
 	a.config = config
@@ -28,6 +28,7 @@ const ExtensionName = service.ExtensionServiceName
 // Options holds configuration passed to the DNS Service controller.
 type Options struct {
 	serviceOptions     *dnsservicecmd.DNSServiceOptions
+	configFileOptions  *controllercmd.ManagerConfigFileOptions
 	restOptions        *controllercmd.RESTOptions
 	managerOptions     *controllercmd.ManagerOptions
 	controllerOptions  *controllercmd.ControllerOptions
@@ -39,8 +40,9 @@ type Options struct {
 // NewOptions creates a new Options instance.
 func NewOptions() *Options {
 	options := &Options{
-		serviceOptions: &dnsservicecmd.DNSServiceOptions{},
-		restOptions:    &controllercmd.RESTOptions{},
+		serviceOptions:    &dnsservicecmd.DNSServiceOptions{},
+		configFileOptions: &controllercmd.ManagerConfigFileOptions{},
+		restOptions:       &controllercmd.RESTOptions{},
 		managerOptions: &controllercmd.ManagerOptions{
 			// These are default values.
 			LeaderElection:          true,
@@ -56,6 +58,7 @@ func NewOptions() *Options {
 	}
 
 	options.optionAggregator = controllercmd.NewOptionAggregator(
+		options.configFileOptions,
 		options.serviceOptions,
 		options.restOptions,
 		options.managerOptions,
@@ -123,6 +123,32 @@ func (a *actuator) Delete(ctx context.Context, ex *extensionsv1alpha1.Extension)
 	return a.deleteShootResources(ctx, ex.Namespace)
 }
 
+// Migrate the Extension resource.
+func (a *actuator) Migrate(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
+	// The DNS entries are kept as their records must survive the migration.
+	a.logger.Info("Component is being migrated", "component", service.ExtensionServiceName, "namespace", ex.Namespace)
+	if err := controller.DeleteManagedResource(ctx, a.client, ex.Namespace, SeedResourcesName); err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{}
+	secret.SetName(service.SecretName)
+	secret.SetNamespace(ex.Namespace)
+	if err := a.client.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	if err := controller.SetKeepObjects(ctx, a.client, ex.Namespace, ShootResourcesName, true); err != nil {
+		return err
+	}
+	return a.deleteShootResources(ctx, ex.Namespace)
+}
+
+// Restore the Extension resource.
+func (a *actuator) Restore(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
+	return a.Reconcile(ctx, ex)
+}
+
 func (a *actuator) shootId(namespace string) string {
 	return fmt.Sprintf("%s.gardener.cloud/%s", a.controllerConfig.GardenID, namespace)
 }
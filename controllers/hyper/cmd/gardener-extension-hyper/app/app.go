@@ -15,6 +15,7 @@ import (
 	provideraws "github.com/gardener/gardener-extensions/controllers/provider-aws/cmd/gardener-extension-provider-aws/app"
 	providerazure "github.com/gardener/gardener-extensions/controllers/provider-azure/cmd/gardener-extension-provider-azure/app"
 	providergcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/cmd/gardener-extension-provider-gcp/app"
+	providerlocalbackup "github.com/gardener/gardener-extensions/controllers/provider-local-backup/cmd/gardener-extension-provider-local-backup/app"
 	provideropenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/cmd/gardener-extension-provider-openstack/app"
 	providerpacket "github.com/gardener/gardener-extensions/controllers/provider-packet/cmd/gardener-extension-provider-packet/app"
 	"github.com/spf13/cobra"
@@ -38,6 +39,7 @@ func NewHyperCommand(ctx context.Context) *cobra.Command {
 		provideropenstack.NewControllerManagerCommand(ctx),
 		provideralicloud.NewControllerManagerCommand(ctx),
 		providerpacket.NewControllerManagerCommand(ctx),
+		providerlocalbackup.NewControllerManagerCommand(ctx),
 		certservice.NewServiceControllerCommand(ctx),
 		networkcalico.NewControllerManagerCommand(ctx),
 		dnsservice.NewServiceControllerCommand(ctx),
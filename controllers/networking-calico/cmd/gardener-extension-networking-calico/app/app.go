@@ -22,11 +22,13 @@ import (
 	calicocontroller "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/controller"
 
 	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/calico"
+	calicocmd "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/cmd"
 
 	calicoinstall "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/install"
 	"github.com/gardener/gardener-extensions/pkg/controller"
 
 	controllercmd "github.com/gardener/gardener-extensions/pkg/controller/cmd"
+	webhookcmd "github.com/gardener/gardener-extensions/pkg/webhook/cmd"
 
 	"github.com/spf13/cobra"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -35,11 +37,13 @@ import (
 // NewControllerManagerCommand creates a new command for running a Calico controller.
 func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 	var (
-		restOpts = &controllercmd.RESTOptions{}
-		mgrOpts  = &controllercmd.ManagerOptions{
+		mgrConfigFileOpts = &controllercmd.ManagerConfigFileOptions{}
+		restOpts          = &controllercmd.RESTOptions{}
+		mgrOpts           = &controllercmd.ManagerOptions{
 			LeaderElection:          true,
 			LeaderElectionID:        controllercmd.LeaderElectionNameID(calico.Name),
 			LeaderElectionNamespace: os.Getenv("LEADER_ELECTION_NAMESPACE"),
+			WebhookServerPort:       443,
 		}
 		// options for the networking-calico controller
 		calicoCtrlOpts = &controllercmd.ControllerOptions{
@@ -49,11 +53,22 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			IgnoreOperationAnnotation: true,
 		}
 
+		// options for the webhook server
+		webhookServerOptions = &webhookcmd.ServerOptions{
+			CertDir:   "/tmp/gardener-extensions-cert",
+			Namespace: os.Getenv("WEBHOOK_CONFIG_NAMESPACE"),
+		}
+
+		webhookSwitches = calicocmd.WebhookSwitchOptions()
+		webhookOptions  = webhookcmd.NewAddToManagerOptions(calico.Name, webhookServerOptions, webhookSwitches)
+
 		aggOption = controllercmd.NewOptionAggregator(
+			mgrConfigFileOpts,
 			restOpts,
 			mgrOpts,
 			calicoCtrlOpts,
 			reconcileOpts,
+			webhookOptions,
 		)
 	)
 
@@ -80,6 +95,10 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 
 			reconcileOpts.Completed().Apply(&calicocontroller.DefaultAddOptions.IgnoreOperationAnnotation)
 
+			if _, _, err := webhookOptions.Completed().AddToManager(mgr); err != nil {
+				controllercmd.LogErrAndExit(err, "Could not add webhooks to manager")
+			}
+
 			if err := calicocontroller.AddToManager(mgr); err != nil {
 				controllercmd.LogErrAndExit(err, "Could not add controllers to manager")
 			}
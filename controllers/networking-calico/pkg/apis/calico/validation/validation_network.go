@@ -0,0 +1,78 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apiscalico "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// IPAMHostLocal is the host-local IPAM plugin type.
+	IPAMHostLocal = "host-local"
+	// IPAMCalico is the calico IPAM plugin type.
+	IPAMCalico = "calico-ipam"
+	// CIDRUsePodCIDR is the CIDR value that makes the host-local IPAM plugin use the pod CIDR of the node.
+	CIDRUsePodCIDR = "usePodCidr"
+)
+
+var (
+	supportedBackends = sets.NewString(string(apiscalico.Bird), string(apiscalico.None))
+	supportedIPAMs    = sets.NewString(IPAMHostLocal, IPAMCalico)
+)
+
+// ValidateNetworkConfig validates a NetworkConfig object.
+func ValidateNetworkConfig(networkConfig *apiscalico.NetworkConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !supportedBackends.Has(string(networkConfig.Backend)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("backend"), networkConfig.Backend, supportedBackends.List()))
+	}
+
+	if ipam := networkConfig.IPAM; ipam != nil {
+		ipamPath := fldPath.Child("ipam")
+
+		if len(ipam.Type) > 0 && !supportedIPAMs.Has(ipam.Type) {
+			allErrs = append(allErrs, field.NotSupported(ipamPath.Child("type"), ipam.Type, supportedIPAMs.List()))
+		}
+
+		if ipam.CIDR != nil {
+			cidrPath := ipamPath.Child("cidr")
+			if ipam.Type != IPAMHostLocal {
+				allErrs = append(allErrs, field.Forbidden(cidrPath, fmt.Sprintf("cidr is only supported for ipam type %q", IPAMHostLocal)))
+			} else if *ipam.CIDR != CIDRUsePodCIDR {
+				allErrs = append(allErrs, extensionsvalidation.ValidateCIDR(string(*ipam.CIDR), cidrPath)...)
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateNetworkConfigUpdate validates a NetworkConfig object before an update. The backend and the IPAM
+// configuration are immutable.
+func ValidateNetworkConfigUpdate(oldNetworkConfig, newNetworkConfig *apiscalico.NetworkConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, extensionsvalidation.ValidateImmutableField(newNetworkConfig.Backend, oldNetworkConfig.Backend, fldPath.Child("backend"))...)
+	allErrs = append(allErrs, extensionsvalidation.ValidateImmutableField(newNetworkConfig.IPAM, oldNetworkConfig.IPAM, fldPath.Child("ipam"))...)
+
+	return allErrs
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apiscalico "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico"
+	. "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("NetworkConfig validation", func() {
+	var (
+		networkConfig *apiscalico.NetworkConfig
+		fldPath       = field.NewPath("spec", "providerConfig")
+	)
+
+	BeforeEach(func() {
+		cidr := apiscalico.CIDR("10.96.0.0/11")
+		networkConfig = &apiscalico.NetworkConfig{
+			Backend: apiscalico.Bird,
+			IPAM: &apiscalico.IPAM{
+				Type: IPAMHostLocal,
+				CIDR: &cidr,
+			},
+		}
+	})
+
+	Describe("#ValidateNetworkConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateNetworkConfig(networkConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow using the pod cidr", func() {
+			cidr := apiscalico.CIDR(CIDRUsePodCIDR)
+			networkConfig.IPAM.CIDR = &cidr
+
+			Expect(ValidateNetworkConfig(networkConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported backends and ipam types", func() {
+			networkConfig.Backend = "foo"
+			networkConfig.IPAM.Type = "bar"
+
+			Expect(ValidateNetworkConfig(networkConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.providerConfig.backend"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.providerConfig.ipam.type"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.providerConfig.ipam.cidr"),
+			}))))
+		})
+
+		It("should forbid an invalid cidr", func() {
+			cidr := apiscalico.CIDR("10.96.0.0")
+			networkConfig.IPAM.CIDR = &cidr
+
+			Expect(ValidateNetworkConfig(networkConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.ipam.cidr"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateNetworkConfigUpdate", func() {
+		It("should allow an unchanged configuration", func() {
+			Expect(ValidateNetworkConfigUpdate(networkConfig, networkConfig.DeepCopy(), fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid changing the backend", func() {
+			newNetworkConfig := networkConfig.DeepCopy()
+			newNetworkConfig.Backend = apiscalico.None
+
+			Expect(ValidateNetworkConfigUpdate(networkConfig, newNetworkConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.backend"),
+			}))))
+		})
+	})
+})
@@ -0,0 +1,52 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+
+	apiscalico "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico"
+	calicovalidation "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/validation"
+	"github.com/gardener/gardener-extensions/pkg/webhook/validator"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateNetwork validates the NetworkConfig of the given Network, if any. On updates, it additionally checks that
+// immutable fields have not been changed.
+func ValidateNetwork(_ context.Context, decoder runtime.Decoder, new, old *extensionsv1alpha1.Network) field.ErrorList {
+	if new.Spec.ProviderConfig == nil {
+		return nil
+	}
+
+	providerConfigPath := field.NewPath("spec", "providerConfig")
+	networkConfig := &apiscalico.NetworkConfig{}
+	if errs := validator.DecodeProviderConfig(decoder, new.Spec.ProviderConfig, networkConfig, providerConfigPath); len(errs) > 0 {
+		return errs
+	}
+
+	allErrs := calicovalidation.ValidateNetworkConfig(networkConfig, providerConfigPath)
+
+	if old != nil && old.Spec.ProviderConfig != nil {
+		oldNetworkConfig := &apiscalico.NetworkConfig{}
+		if errs := validator.DecodeProviderConfig(decoder, old.Spec.ProviderConfig, oldNetworkConfig, providerConfigPath); len(errs) == 0 {
+			allErrs = append(allErrs, calicovalidation.ValidateNetworkConfigUpdate(oldNetworkConfig, networkConfig, providerConfigPath)...)
+		}
+	}
+
+	return allErrs
+}
@@ -34,8 +34,9 @@ const Name = "os-coreos-alicloud"
 // NewControllerCommand creates a new command for running a CoreOS Alicloud controller.
 func NewControllerCommand(ctx context.Context) *cobra.Command {
 	var (
-		restOpts = &controllercmd.RESTOptions{}
-		mgrOpts  = &controllercmd.ManagerOptions{
+		mgrConfigFileOpts = &controllercmd.ManagerConfigFileOptions{}
+		restOpts          = &controllercmd.RESTOptions{}
+		mgrOpts           = &controllercmd.ManagerOptions{
 			LeaderElection:          true,
 			LeaderElectionID:        controllercmd.LeaderElectionNameID(Name),
 			LeaderElectionNamespace: os.Getenv("LEADER_ELECTION_NAMESPACE"),
@@ -48,6 +49,7 @@ func NewControllerCommand(ctx context.Context) *cobra.Command {
 		controllerSwitches = coreos.ControllerSwitchOptions()
 
 		aggOption = controllercmd.NewOptionAggregator(
+			mgrConfigFileOpts,
 			restOpts,
 			mgrOpts,
 			ctrlOpts,
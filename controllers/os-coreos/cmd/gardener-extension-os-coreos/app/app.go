@@ -34,8 +34,9 @@ const Name = "os-coreos"
 // NewControllerCommand creates a new CoreOS controller command.
 func NewControllerCommand(ctx context.Context) *cobra.Command {
 	var (
-		restOpts = &controllercmd.RESTOptions{}
-		mgrOpts  = &controllercmd.ManagerOptions{
+		mgrConfigFileOpts = &controllercmd.ManagerConfigFileOptions{}
+		restOpts          = &controllercmd.RESTOptions{}
+		mgrOpts           = &controllercmd.ManagerOptions{
 			LeaderElection:          true,
 			LeaderElectionID:        controllercmd.LeaderElectionNameID(Name),
 			LeaderElectionNamespace: os.Getenv("LEADER_ELECTION_NAMESPACE"),
@@ -47,6 +48,7 @@ func NewControllerCommand(ctx context.Context) *cobra.Command {
 		controllerSwitches = coreos.ControllerSwitchOptions()
 
 		aggOption = controllercmd.NewOptionAggregator(
+			mgrConfigFileOpts,
 			restOpts,
 			mgrOpts,
 			ctrlOpts,
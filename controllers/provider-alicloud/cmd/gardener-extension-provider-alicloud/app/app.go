@@ -42,8 +42,9 @@ import (
 // NewControllerManagerCommand creates a new command for running a Alicloud provider controller.
 func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 	var (
-		restOpts = &controllercmd.RESTOptions{}
-		mgrOpts  = &controllercmd.ManagerOptions{
+		mgrConfigFileOpts = &controllercmd.ManagerConfigFileOptions{}
+		restOpts          = &controllercmd.RESTOptions{}
+		mgrOpts           = &controllercmd.ManagerOptions{
 			LeaderElection:          true,
 			LeaderElectionID:        controllercmd.LeaderElectionNameID(alicloud.Name),
 			LeaderElectionNamespace: os.Getenv("LEADER_ELECTION_NAMESPACE"),
@@ -70,7 +71,10 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		infraCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
-		reconcileOpts = &controllercmd.ReconcilerOptions{}
+		infraDriftDetectionOpts = &controllercmd.DriftDetectionOptions{}
+		infraTerraformerOpts    = &controllercmd.TerraformerOptions{}
+		infraCtrlOptsUnprefixed = controllercmd.NewOptionAggregator(infraCtrlOpts, infraDriftDetectionOpts, infraTerraformerOpts)
+		reconcileOpts           = &controllercmd.ReconcilerOptions{}
 
 		// options for the worker controller
 		workerCtrlOpts = &controllercmd.ControllerOptions{
@@ -92,12 +96,13 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		webhookOptions     = webhookcmd.NewAddToManagerOptions(alicloud.Name, webhookServerOptions, webhookSwitches)
 
 		aggOption = controllercmd.NewOptionAggregator(
+			mgrConfigFileOpts,
 			restOpts,
 			mgrOpts,
 			controllercmd.PrefixOption("backupbucket-", backupBucketCtrlOpts),
 			controllercmd.PrefixOption("backupentry-", backupEntryCtrlOpts),
 			controllercmd.PrefixOption("controlplane-", controlPlaneCtrlOpts),
-			controllercmd.PrefixOption("infrastructure-", infraCtrlOpts),
+			controllercmd.PrefixOption("infrastructure-", &infraCtrlOptsUnprefixed),
 			controllercmd.PrefixOption("worker-", &workerCtrlOptsUnprefixed),
 			configFileOpts,
 			controllerSwitches,
@@ -142,9 +147,14 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			backupEntryCtrlOpts.Completed().Apply(&alicloudbackupentry.DefaultAddOptions.Controller)
 			controlPlaneCtrlOpts.Completed().Apply(&alicloudcontrolplane.DefaultAddOptions.Controller)
 			infraCtrlOpts.Completed().Apply(&alicloudinfrastructure.DefaultAddOptions.Controller)
+			infraDriftDetectionOpts.Completed().Apply(&alicloudinfrastructure.DefaultAddOptions.DriftDetectionInterval)
+			infraTerraformerOpts.Completed().Apply(&alicloudinfrastructure.DefaultAddOptions.TerraformerFactory)
 			reconcileOpts.Completed().Apply(&alicloudinfrastructure.DefaultAddOptions.IgnoreOperationAnnotation)
 			reconcileOpts.Completed().Apply(&alicloudcontrolplane.DefaultAddOptions.IgnoreOperationAnnotation)
 			reconcileOpts.Completed().Apply(&alicloudworker.DefaultAddOptions.IgnoreOperationAnnotation)
+			reconcileOpts.Completed().ApplyDryRun(&alicloudinfrastructure.DefaultAddOptions.DryRun)
+			reconcileOpts.Completed().ApplyDryRun(&alicloudcontrolplane.DefaultAddOptions.DryRun)
+			reconcileOpts.Completed().ApplyDryRun(&alicloudworker.DefaultAddOptions.DryRun)
 			workerCtrlOpts.Completed().Apply(&alicloudworker.DefaultAddOptions.Controller)
 
 			if _, _, err := webhookOptions.Completed().AddToManager(mgr); err != nil {
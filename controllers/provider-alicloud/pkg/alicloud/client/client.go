@@ -82,6 +82,9 @@ func (c *storageClient) DeleteObjectsWithPrefix(ctx context.Context, bucketName,
 		lsRes, err := bucket.ListObjects(oss.Marker(marker), oss.Prefix(prefix), oss.MaxKeys(1000), expirationOption)
 
 		if err != nil {
+			if ossErr, ok := err.(oss.ServiceError); ok && ossErr.StatusCode == http.StatusNotFound {
+				return nil
+			}
 			return err
 		}
 
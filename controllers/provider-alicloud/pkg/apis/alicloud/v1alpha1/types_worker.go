@@ -20,6 +20,23 @@ import (
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// InstanceChargeType is the billing method of the machines, i.e. `PostPaid` or `PrePaid`.
+	// +optional
+	InstanceChargeType *string `json:"instanceChargeType,omitempty"`
+	// Tags are additional tags that are added to the machines.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+	// RollingUpdateTimeout is the maximum duration of a rolling update of the machines. Defaults to 30 minutes.
+	// +optional
+	RollingUpdateTimeout *metav1.Duration `json:"rollingUpdateTimeout,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // WorkerStatus contains information about created worker resources.
 type WorkerStatus struct {
 	metav1.TypeMeta `json:",inline"`
@@ -24,6 +24,7 @@ import (
 	unsafe "unsafe"
 
 	alicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -165,6 +166,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*alicloud.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(a.(*WorkerConfig), b.(*alicloud.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*alicloud.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*alicloud.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_alicloud_WorkerStatus(a.(*WorkerStatus), b.(*alicloud.WorkerStatus), scope)
 	}); err != nil {
@@ -486,6 +497,30 @@ func Convert_alicloud_VSwitch_To_v1alpha1_VSwitch(in *alicloud.VSwitch, out *VSw
 	return autoConvert_alicloud_VSwitch_To_v1alpha1_VSwitch(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(in *WorkerConfig, out *alicloud.WorkerConfig, s conversion.Scope) error {
+	out.InstanceChargeType = (*string)(unsafe.Pointer(in.InstanceChargeType))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.RollingUpdateTimeout = (*v1.Duration)(unsafe.Pointer(in.RollingUpdateTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(in *WorkerConfig, out *alicloud.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(in, out, s)
+}
+
+func autoConvert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(in *alicloud.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.InstanceChargeType = (*string)(unsafe.Pointer(in.InstanceChargeType))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.RollingUpdateTimeout = (*v1.Duration)(unsafe.Pointer(in.RollingUpdateTimeout))
+	return nil
+}
+
+// Convert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(in *alicloud.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_alicloud_WorkerStatus(in *WorkerStatus, out *alicloud.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]alicloud.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	return nil
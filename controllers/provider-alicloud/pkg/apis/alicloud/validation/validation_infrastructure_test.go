@@ -0,0 +1,140 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisalicloud.InfrastructureConfig
+		fldPath              = field.NewPath("spec", "providerConfig")
+		vpcCIDR              = "10.250.0.0/16"
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC: apisalicloud.VPC{
+					CIDR: &vpcCIDR,
+				},
+				Zones: []apisalicloud.Zone{
+					{
+						Name:   "eu-central-1a",
+						Worker: "10.250.0.0/19",
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid specifying both a vpc id and a vpc cidr", func() {
+			id := "vpc-1234"
+			infrastructureConfig.Networks.VPC.ID = &id
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.vpc"),
+			}))))
+		})
+
+		It("should require either a vpc id or a vpc cidr", func() {
+			infrastructureConfig.Networks.VPC.CIDR = nil
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.networks.vpc"),
+			}))))
+		})
+
+		It("should forbid worker cidrs that are not a subset of the vpc cidr", func() {
+			infrastructureConfig.Networks.Zones[0].Worker = "10.251.0.0/19"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.zones[0].worker"),
+			}))))
+		})
+
+		It("should forbid duplicate zones", func() {
+			infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, infrastructureConfig.Networks.Zones[0])
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.providerConfig.networks.zones[1].name"),
+			}))))
+		})
+
+		It("should require at least one zone", func() {
+			infrastructureConfig.Networks.Zones = nil
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.networks.zones"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should allow adding a zone", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = append(newInfrastructureConfig.Networks.Zones, apisalicloud.Zone{
+				Name:   "eu-central-1b",
+				Worker: "10.250.32.0/19",
+			})
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid changing the vpc", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newCIDR := "10.251.0.0/16"
+			newInfrastructureConfig.Networks.VPC.CIDR = &newCIDR
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.vpc"),
+			}))))
+		})
+
+		It("should forbid changing or removing existing zones", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[0].Worker = "10.250.32.0/19"
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.zones[0]"),
+			}))))
+
+			newInfrastructureConfig.Networks.Zones = nil
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.providerConfig.networks.zones"),
+			}))))
+		})
+	})
+})
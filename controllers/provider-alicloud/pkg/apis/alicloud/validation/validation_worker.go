@@ -0,0 +1,47 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	maxTagKeyLength   = 128
+	maxTagValueLength = 128
+)
+
+var (
+	reservedTagKeyPrefixes = []string{"kubernetes.io/cluster/", "kubernetes.io/role/"}
+
+	availableInstanceChargeTypes = sets.NewString("PostPaid", "PrePaid")
+)
+
+// ValidateWorkerConfig validates a WorkerConfig object.
+func ValidateWorkerConfig(workerConfig *apisalicloud.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if chargeType := workerConfig.InstanceChargeType; chargeType != nil && !availableInstanceChargeTypes.Has(*chargeType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("instanceChargeType"), *chargeType, availableInstanceChargeTypes.List()))
+	}
+
+	allErrs = append(allErrs, extensionsvalidation.ValidateTags(workerConfig.Tags, reservedTagKeyPrefixes, maxTagKeyLength, maxTagValueLength, fldPath.Child("tags"))...)
+
+	return allErrs
+}
@@ -18,23 +18,21 @@ import (
 	"context"
 
 	alicloudclient "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud/client"
-	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
 type actuator struct {
-	backupbucket.Actuator
 	client client.Client
 	logger logr.Logger
 }
 
-func newActuator() backupbucket.Actuator {
+func newActuator() genericactuator.BackupBucketDelegate {
 	return &actuator{
-		logger: log.Log.WithName("alicloud-backupbucket-actuator"),
+		logger: logger,
 	}
 }
 
@@ -43,7 +41,11 @@ func (a *actuator) InjectClient(client client.Client) error {
 	return nil
 }
 
-func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+func (a *actuator) GetGeneratedSecretData(_ context.Context, _ *extensionsv1alpha1.BackupBucket) (map[string][]byte, error) {
+	return nil, nil
+}
+
+func (a *actuator) CreateBucketIfNotExists(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
 	alicloudClient, err := alicloudclient.NewStorageClientFromSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Spec.Region)
 	if err != nil {
 		return err
@@ -52,7 +54,21 @@ func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupB
 	return alicloudClient.CreateBucketIfNotExists(ctx, bb.Name)
 }
 
-func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+// EnsureLifecyclePolicy does nothing as the lifecycle of the objects is managed by etcd-backup-restore.
+func (a *actuator) EnsureLifecyclePolicy(_ context.Context, _ *extensionsv1alpha1.BackupBucket) error {
+	return nil
+}
+
+func (a *actuator) DeleteObjectsWithPrefix(ctx context.Context, bb *extensionsv1alpha1.BackupBucket, prefix string) error {
+	alicloudClient, err := alicloudclient.NewStorageClientFromSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	return alicloudClient.DeleteObjectsWithPrefix(ctx, bb.Name, prefix)
+}
+
+func (a *actuator) DeleteBucketIfExists(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
 	alicloudClient, err := alicloudclient.NewStorageClientFromSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Spec.Region)
 	if err != nil {
 		return err
@@ -40,6 +40,8 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// DryRun specifies whether ControlPlane resources are only dry-run instead of being reconciled.
+	DryRun bool
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -51,6 +53,7 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 			imagevector.ImageVector(), alicloud.CloudProviderConfigName, nil, mgr.GetWebhookServer().Port, logger),
 		ControllerOptions: opts.Controller,
 		Predicates:        controlplane.DefaultPredicates(alicloud.Type, opts.IgnoreOperationAnnotation),
+		DryRun:            opts.DryRun,
 	})
 }
 
@@ -17,7 +17,6 @@ package infrastructure
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
@@ -34,7 +33,6 @@ import (
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -55,12 +53,12 @@ var StatusTypeMeta = func() metav1.TypeMeta {
 	}
 }()
 
-// NewActuator instantiates an actuator with the default dependencies.
-func NewActuator() infrastructure.Actuator {
+// NewActuator instantiates an actuator with the given terraformer factory and the default dependencies otherwise.
+func NewActuator(terraformerFactory extensionsterraformer.Factory) infrastructure.Actuator {
 	return NewActuatorWithDeps(
 		log.Log.WithName("infrastructure-actuator"),
 		alicloudclient.DefaultFactory(),
-		extensionsterraformer.DefaultFactory(),
+		terraformerFactory,
 		extensionschartrenderer.DefaultFactory(),
 		DefaultTerraformOps(),
 	)
@@ -136,9 +134,13 @@ func (a *actuator) getConfigAndCredentialsForInfra(ctx context.Context, infra *e
 }
 
 func (a *actuator) fetchEIPInternetChargeType(vpcClient alicloudclient.VPC, tf extensionsterraformer.Interface) (string, error) {
-	stateVariables, err := tf.GetStateOutputVariables(TerraformerOutputKeyVPCID)
+	var stateVariables map[string]string
+	state, err := extensionsterraformer.ReadState(tf)
+	if err == nil {
+		stateVariables, err = state.GetStateOutputVariables(TerraformerOutputKeyVPCID)
+	}
 	if err != nil {
-		if apierrors.IsNotFound(err) || terraformer.IsVariablesNotFoundError(err) {
+		if apierrors.IsNotFound(err) || extensionsterraformer.IsVariablesNotFoundError(err) {
 			return alicloudclient.DefaultInternetChargeType, nil
 		}
 		return "", err
@@ -196,24 +198,32 @@ func (a *actuator) newTerraformer(infra *extensionsv1alpha1.Infrastructure, cred
 	return common.NewTerraformer(a.terraformerFactory, a.config, credentials, TerraformerPurpose, infra.Namespace, infra.Name)
 }
 
-func (a *actuator) extractStatus(tf extensionsterraformer.Interface, infraConfig *alicloudv1alpha1.InfrastructureConfig) (*alicloudv1alpha1.InfrastructureStatus, error) {
-	outputVarKeys := []string{
-		TerraformerOutputKeyVPCID,
-		TerraformerOutputKeyVPCCIDR,
-		TerraformerOutputKeySecurityGroupID,
-		TerraformerOutputKeyKeyPairName,
+func (a *actuator) extractStatus(tf extensionsterraformer.StateGetter, infraConfig *alicloudv1alpha1.InfrastructureConfig) (*alicloudv1alpha1.InfrastructureStatus, error) {
+	state, err := extensionsterraformer.ReadState(tf)
+	if err != nil {
+		return nil, err
 	}
 
-	for zoneIndex := range infraConfig.Networks.Zones {
-		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", TerraformerOutputKeyVSwitchNodesPrefix, zoneIndex))
+	vpcID, err := state.OutputString(TerraformerOutputKeyVPCID)
+	if err != nil {
+		return nil, err
 	}
 
-	vars, err := tf.GetStateOutputVariables(outputVarKeys...)
+	securityGroup := state.Resource("alicloud_security_group", "sg")
+	if securityGroup == nil {
+		return nil, fmt.Errorf("resource alicloud_security_group.sg not found in Terraform state")
+	}
+
+	keyPair := state.Resource("alicloud_key_pair", "publickey")
+	if keyPair == nil {
+		return nil, fmt.Errorf("resource alicloud_key_pair.publickey not found in Terraform state")
+	}
+	keyPairName, err := keyPair.StringAttribute("key_name")
 	if err != nil {
 		return nil, err
 	}
 
-	vswitches, err := computeProviderStatusVSwitches(infraConfig, vars)
+	vswitches, err := computeProviderStatusVSwitches(infraConfig, state)
 	if err != nil {
 		return nil, err
 	}
@@ -221,48 +231,46 @@ func (a *actuator) extractStatus(tf extensionsterraformer.Interface, infraConfig
 	return &alicloudv1alpha1.InfrastructureStatus{
 		TypeMeta: StatusTypeMeta,
 		VPC: alicloudv1alpha1.VPCStatus{
-			ID:        vars[TerraformerOutputKeyVPCID],
+			ID:        vpcID,
 			VSwitches: vswitches,
 			SecurityGroups: []alicloudv1alpha1.SecurityGroup{
 				{
 					Purpose: alicloudv1alpha1.PurposeNodes,
-					ID:      vars[TerraformerOutputKeySecurityGroupID],
+					ID:      securityGroup.ID,
 				},
 			},
 		},
-		KeyPairName: vars[TerraformerOutputKeyKeyPairName],
+		KeyPairName: keyPairName,
 	}, nil
 }
 
-func computeProviderStatusVSwitches(infrastructure *alicloudv1alpha1.InfrastructureConfig, values map[string]string) ([]alicloudv1alpha1.VSwitch, error) {
-	var vswitchesToReturn []alicloudv1alpha1.VSwitch
+// vswitchNodesResourcePrefix is the prefix of the names of the alicloud_vswitch resources for the nodes.
+const vswitchNodesResourcePrefix = "vsw_z"
 
-	for key, value := range values {
-		var (
-			prefix  string
-			purpose alicloudv1alpha1.Purpose
-		)
-
-		if strings.HasPrefix(key, TerraformerOutputKeyVSwitchNodesPrefix) {
-			prefix = TerraformerOutputKeyVSwitchNodesPrefix
-			purpose = alicloudv1alpha1.PurposeNodes
-		}
+func computeProviderStatusVSwitches(infrastructure *alicloudv1alpha1.InfrastructureConfig, state *extensionsterraformer.State) ([]alicloudv1alpha1.VSwitch, error) {
+	var vswitchesToReturn []alicloudv1alpha1.VSwitch
 
-		if len(prefix) == 0 {
+	for _, resource := range state.ResourcesByType("alicloud_vswitch") {
+		if !strings.HasPrefix(resource.Name, vswitchNodesResourcePrefix) {
 			continue
 		}
 
-		zoneID, err := strconv.Atoi(strings.TrimPrefix(key, prefix))
+		zone, err := resource.StringAttribute("availability_zone")
 		if err != nil {
 			return nil, err
 		}
+
 		vswitchesToReturn = append(vswitchesToReturn, alicloudv1alpha1.VSwitch{
-			ID:      value,
-			Purpose: purpose,
-			Zone:    infrastructure.Networks.Zones[zoneID].Name,
+			ID:      resource.ID,
+			Purpose: alicloudv1alpha1.PurposeNodes,
+			Zone:    zone,
 		})
 	}
 
+	if expected := len(infrastructure.Networks.Zones); len(vswitchesToReturn) != expected {
+		return nil, fmt.Errorf("expected %d node vswitches in Terraform state but found %d", expected, len(vswitchesToReturn))
+	}
+
 	return vswitchesToReturn, nil
 }
 
@@ -288,10 +296,23 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 		return err
 	}
 
-	if err := tf.InitializeWith(initializer).Apply(); err != nil {
-		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infra.Name)
+	state, err := infrastructure.GetTerraformState(infra)
+	if err != nil {
+		return err
+	}
+
+	applyErr := tf.InitializeWith(extensionsterraformer.WithState(a.client, initializer, state)).Apply()
+
+	// The Terraform state is persisted even if the apply failed as it might already reference created resources.
+	if err := infrastructure.SaveTerraformState(ctx, a.client, tf, infra); err != nil {
+		return err
+	}
+
+	infrastructure.ReportTerraformApplyResult(ctx, a.logger, infra, applyErr)
+	if applyErr != nil {
+		a.logger.Error(applyErr, "failed to apply the terraform config", "infrastructure", infra.Name)
 		return &controllererrors.RequeueAfterError{
-			Cause:        err,
+			Cause:        applyErr,
 			RequeueAfter: 30 * time.Second,
 		}
 	}
@@ -307,9 +328,44 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 	})
 }
 
+// DryRun implements infrastructure.DryRunner.
+func (a *actuator) DryRun(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) (*extensioncontroller.DryRunResult, error) {
+	config, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := a.newTerraformer(infra, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	initializerValues, err := a.getInitializerValues(tf, infra, config, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	initializer, err := a.newInitializer(infra, config, initializerValues)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := infrastructure.CurrentTerraformState(infra, tf)
+	if err != nil {
+		return nil, err
+	}
+
+	planTF, err := common.NewTerraformer(a.terraformerFactory, a.config, credentials, infrastructure.TerraformPlanPurpose(TerraformerPurpose), infra.Namespace, infra.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return infrastructure.PlanTerraform(ctx, a.client, planTF, initializer, state)
+}
+
 // Delete implements infrastructure.Actuator.
 func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
-	_, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
+	config, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
 	if err != nil {
 		return err
 	}
@@ -323,9 +379,45 @@ func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrast
 	if err != nil {
 		return err
 	}
-	if !configExists {
+
+	state, err := infrastructure.GetTerraformState(infra)
+	if err != nil {
+		return err
+	}
+
+	if !configExists && len(state) == 0 {
 		return nil
 	}
 
-	return tf.Destroy()
+	initializerValues, err := a.getInitializerValues(tf, infra, config, credentials)
+	if err != nil {
+		return err
+	}
+
+	initializer, err := a.newInitializer(infra, config, initializerValues)
+	if err != nil {
+		return err
+	}
+
+	return tf.InitializeWith(extensionsterraformer.WithState(a.client, initializer, state)).Destroy()
+}
+
+// Migrate implements infrastructure.Actuator.
+func (a *actuator) Migrate(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
+	_, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
+	if err != nil {
+		return err
+	}
+
+	tf, err := a.newTerraformer(infra, credentials)
+	if err != nil {
+		return err
+	}
+
+	return infrastructure.MigrateTerraformState(ctx, a.client, tf, infra)
+}
+
+// Restore implements infrastructure.Actuator.
+func (a *actuator) Restore(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
+	return a.Reconcile(ctx, infra, cluster)
 }
@@ -29,6 +29,8 @@ import (
 	mockalicloudclient "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/mock/provider-alicloud/alicloud/client"
 	mockinfrastructure "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/mock/provider-alicloud/controller/infrastructure"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
 	mockchartrenderer "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/gardener/chartrenderer"
 	mockterraformer "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/gardener/terraformer"
@@ -141,9 +143,9 @@ var _ = Describe("Actuator", func() {
 					mainContent      = "main"
 					variablesContent = "variables"
 					tfVarsContent    = "tfVars"
+					stateContent     = "state"
 
 					vpcID           = "vpcID"
-					vpcCIDRString   = "vpcCIDR"
 					natGatewayID    = "natGatewayID"
 					securityGroupID = "sgID"
 					keyPairName     = "keyPairName"
@@ -178,10 +180,7 @@ var _ = Describe("Actuator", func() {
 
 					alicloudClientFactory.EXPECT().NewVPC(region, accessKeyID, accessKeySecret).Return(vpcClient, nil),
 
-					terraformer.EXPECT().GetStateOutputVariables(TerraformerOutputKeyVPCID).
-						Return(map[string]string{
-							TerraformerOutputKeyVPCID: vpcID,
-						}, nil),
+					terraformer.EXPECT().GetState().Return([]byte(fmt.Sprintf(`{"version": 4, "outputs": {%q: {"value": %q}}}`, TerraformerOutputKeyVPCID, vpcID)), nil),
 
 					vpcClient.EXPECT().DescribeNatGateways(describeNATGatewaysReq).Return(&vpc.DescribeNatGatewaysResponse{
 						NatGateways: vpc.NatGateways{
@@ -215,13 +214,19 @@ var _ = Describe("Actuator", func() {
 
 					terraformer.EXPECT().Apply(),
 
-					terraformer.EXPECT().GetStateOutputVariables(TerraformerOutputKeyVPCID, TerraformerOutputKeyVPCCIDR, TerraformerOutputKeySecurityGroupID, TerraformerOutputKeyKeyPairName).
-						Return(map[string]string{
-							TerraformerOutputKeyVPCID:           vpcID,
-							TerraformerOutputKeyVPCCIDR:         vpcCIDRString,
-							TerraformerOutputKeySecurityGroupID: securityGroupID,
-							TerraformerOutputKeyKeyPairName:     keyPairName,
-						}, nil),
+					terraformer.EXPECT().GetState().Return([]byte(stateContent), nil),
+					c.EXPECT().Status().Return(c),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: infra.Namespace, Name: infra.Name}, &infra),
+					c.EXPECT().Update(ctx, &infra),
+
+					terraformer.EXPECT().GetState().Return([]byte(fmt.Sprintf(`{
+  "version": 4,
+  "outputs": {%q: {"value": %q}},
+  "resources": [
+    {"mode": "managed", "type": "alicloud_security_group", "name": "sg", "instances": [{"attributes": {"id": %q}}]},
+    {"mode": "managed", "type": "alicloud_key_pair", "name": "publickey", "instances": [{"attributes": {"id": %q, "key_name": %q}}]}
+  ]
+}`, TerraformerOutputKeyVPCID, vpcID, securityGroupID, keyPairName, keyPairName)), nil),
 
 					c.EXPECT().Status().Return(c),
 					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: infra.Namespace, Name: infra.Name}, &infra),
@@ -234,6 +239,7 @@ var _ = Describe("Actuator", func() {
 				ExpectInject(inject.ConfigInto(&restConfig, actuator))
 
 				Expect(actuator.Reconcile(ctx, &infra, &cluster)).To(Succeed())
+				Expect(controller.GetState(&infra, controller.StateKeyTerraform)).To(Equal([]byte(stateContent)))
 				Expect(infra.Status.ProviderStatus.Object).To(Equal(&alicloudv1alpha1.InfrastructureStatus{
 					TypeMeta: StatusTypeMeta,
 					VPC: alicloudv1alpha1.VPCStatus{
@@ -249,5 +255,259 @@ var _ = Describe("Actuator", func() {
 				}))
 			})
 		})
+
+		Describe("#DryRun", func() {
+			It("should plan the infrastructure with a dedicated terraformer", func() {
+				var (
+					ctx                   = context.TODO()
+					logger                = logr.NewMockLogger(ctrl)
+					alicloudClientFactory = mockalicloudclient.NewMockFactory(ctrl)
+					vpcClient             = mockalicloudclient.NewMockVPC(ctrl)
+					terraformerFactory    = mockterraformer.NewMockFactory(ctrl)
+					terraformer           = mockterraformer.NewMockInterface(ctrl)
+					planTerraformer       = mockterraformer.NewMockInterface(ctrl)
+					chartRendererFactory  = mockchartrenderer.NewMockFactory(ctrl)
+					terraformChartOps     = mockinfrastructure.NewMockTerraformChartOps(ctrl)
+					actuator              = NewActuatorWithDeps(logger, alicloudClientFactory, terraformerFactory, chartRendererFactory, terraformChartOps)
+					c                     = mockclient.NewMockClient(ctrl)
+					initializer           = mockterraformer.NewMockInitializer(ctrl)
+					restConfig            rest.Config
+
+					chartRenderer = mockgardenerchartrenderer.NewMockInterface(ctrl)
+
+					cidr   = "192.168.0.0/16"
+					config = alicloudv1alpha1.InfrastructureConfig{
+						Networks: alicloudv1alpha1.Networks{
+							VPC: alicloudv1alpha1.VPC{
+								CIDR: &cidr,
+							},
+						},
+					}
+					configYAML      = ExpectEncode(runtime.Encode(serializer, &config))
+					secretNamespace = "secretns"
+					secretName      = "secret"
+					region          = "region"
+					infra           = extensionsv1alpha1.Infrastructure{
+						Spec: extensionsv1alpha1.InfrastructureSpec{
+							ProviderConfig: &runtime.RawExtension{
+								Raw: configYAML,
+							},
+							Region: region,
+							SecretRef: corev1.SecretReference{
+								Namespace: secretNamespace,
+								Name:      secretName,
+							},
+						},
+					}
+					accessKeyID          = "accessKeyID"
+					accessKeySecret      = "accessKeySecret"
+					cluster              = controller.Cluster{}
+					variablesEnvironment = map[string]string{
+						common.TerraformVarAccessKeyID:     accessKeyID,
+						common.TerraformVarAccessKeySecret: accessKeySecret,
+					}
+
+					initializerValues = InitializerValues{}
+					chartValues       = map[string]interface{}{}
+
+					mainContent      = "main"
+					variablesContent = "variables"
+					tfVarsContent    = "tfVars"
+					stateContent     = "state"
+
+					vpcID        = "vpcID"
+					natGatewayID = "natGatewayID"
+				)
+
+				describeNATGatewaysReq := vpc.CreateDescribeNatGatewaysRequest()
+				describeNATGatewaysReq.VpcId = vpcID
+
+				gomock.InOrder(
+					chartRendererFactory.EXPECT().NewForConfig(&restConfig).Return(chartRenderer, nil),
+
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: secretNamespace, Name: secretName}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+						SetArg(2, corev1.Secret{
+							Data: map[string][]byte{
+								alicloud.AccessKeyID:     []byte(accessKeyID),
+								alicloud.AccessKeySecret: []byte(accessKeySecret),
+							},
+						}),
+
+					terraformerFactory.EXPECT().NewForConfig(gomock.Any(), &restConfig, TerraformerPurpose, infra.Namespace, infra.Name, imagevector.TerraformerImage()).
+						Return(terraformer, nil),
+					terraformer.EXPECT().SetVariablesEnvironment(variablesEnvironment).Return(terraformer),
+					terraformer.EXPECT().SetJobBackoffLimit(int32(0)).Return(terraformer),
+					terraformer.EXPECT().SetActiveDeadlineSeconds(int64(630)).Return(terraformer),
+					terraformer.EXPECT().SetDeadlineCleaning(5*time.Minute).Return(terraformer),
+					terraformer.EXPECT().SetDeadlinePod(15*time.Minute).Return(terraformer),
+					terraformer.EXPECT().SetDeadlineJob(15*time.Minute).Return(terraformer),
+
+					alicloudClientFactory.EXPECT().NewVPC(region, accessKeyID, accessKeySecret).Return(vpcClient, nil),
+					terraformer.EXPECT().GetState().Return([]byte(fmt.Sprintf(`{"version": 4, "outputs": {%q: {"value": %q}}}`, TerraformerOutputKeyVPCID, vpcID)), nil),
+					vpcClient.EXPECT().DescribeNatGateways(describeNATGatewaysReq).Return(&vpc.DescribeNatGatewaysResponse{
+						NatGateways: vpc.NatGateways{
+							NatGateway: []vpc.NatGateway{
+								{
+									NatGatewayId: natGatewayID,
+								},
+							},
+						},
+					}, nil),
+
+					terraformChartOps.EXPECT().ComputeCreateVPCInitializerValues(&config, alicloudclient.DefaultInternetChargeType).Return(&initializerValues),
+					terraformChartOps.EXPECT().ComputeChartValues(&infra, &config, &initializerValues).Return(chartValues),
+					chartRenderer.EXPECT().Render(
+						alicloud.InfraChartPath,
+						alicloud.InfraRelease,
+						infra.Namespace,
+						chartValues,
+					).Return(&chartrenderer.RenderedChart{
+						Manifests: []manifest.Manifest{
+							mkManifest(chart.TerraformMainTFFilename, mainContent),
+							mkManifest(chart.TerraformVariablesTFFilename, variablesContent),
+							mkManifest(chart.TerraformTFVarsFilename, tfVarsContent),
+						},
+					}, nil),
+					terraformerFactory.EXPECT().DefaultInitializer(c, mainContent, variablesContent, []byte(tfVarsContent)).Return(initializer),
+
+					terraformer.EXPECT().GetState().Return([]byte(stateContent), nil),
+
+					terraformerFactory.EXPECT().NewForConfig(gomock.Any(), &restConfig, TerraformerPurpose+"-plan", infra.Namespace, infra.Name, imagevector.TerraformerImage()).
+						Return(planTerraformer, nil),
+					planTerraformer.EXPECT().SetVariablesEnvironment(variablesEnvironment).Return(planTerraformer),
+					planTerraformer.EXPECT().SetJobBackoffLimit(int32(0)).Return(planTerraformer),
+					planTerraformer.EXPECT().SetActiveDeadlineSeconds(int64(630)).Return(planTerraformer),
+					planTerraformer.EXPECT().SetDeadlineCleaning(5*time.Minute).Return(planTerraformer),
+					planTerraformer.EXPECT().SetDeadlinePod(15*time.Minute).Return(planTerraformer),
+					planTerraformer.EXPECT().SetDeadlineJob(15*time.Minute).Return(planTerraformer),
+
+					planTerraformer.EXPECT().CleanupConfiguration(ctx),
+					planTerraformer.EXPECT().InitializeWith(gomock.Any()).Return(planTerraformer),
+					planTerraformer.EXPECT().Plan().Return(&extensionsterraformer.Plan{
+						Changes: []extensionsterraformer.ResourceChange{
+							{Address: "alicloud_vpc.vpc", Type: "alicloud_vpc", Action: extensionsterraformer.ResourceChangeActionUpdate},
+						},
+					}, nil),
+					planTerraformer.EXPECT().CleanupConfiguration(ctx),
+				)
+
+				ExpectInject(inject.ClientInto(c, actuator))
+				ExpectInject(inject.SchemeInto(scheme, actuator))
+				ExpectInject(inject.ConfigInto(&restConfig, actuator))
+
+				result, err := actuator.(infrastructure.DryRunner).DryRun(ctx, &infra, &cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Changes).To(Equal([]controller.DryRunChange{
+					{Kind: "alicloud_vpc", Name: "alicloud_vpc.vpc", Action: controller.DryRunActionUpdate},
+				}))
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should destroy the infrastructure with a terraformer of the factory", func() {
+				var (
+					ctx                   = context.TODO()
+					logger                = logr.NewMockLogger(ctrl)
+					alicloudClientFactory = mockalicloudclient.NewMockFactory(ctrl)
+					vpcClient             = mockalicloudclient.NewMockVPC(ctrl)
+					terraformerFactory    = mockterraformer.NewMockFactory(ctrl)
+					terraformer           = mockterraformer.NewMockInterface(ctrl)
+					chartRendererFactory  = mockchartrenderer.NewMockFactory(ctrl)
+					terraformChartOps     = mockinfrastructure.NewMockTerraformChartOps(ctrl)
+					actuator              = NewActuatorWithDeps(logger, alicloudClientFactory, terraformerFactory, chartRendererFactory, terraformChartOps)
+					c                     = mockclient.NewMockClient(ctrl)
+					initializer           = mockterraformer.NewMockInitializer(ctrl)
+					restConfig            rest.Config
+
+					chartRenderer = mockgardenerchartrenderer.NewMockInterface(ctrl)
+
+					config          = alicloudv1alpha1.InfrastructureConfig{}
+					configYAML      = ExpectEncode(runtime.Encode(serializer, &config))
+					secretNamespace = "secretns"
+					secretName      = "secret"
+					infra           = extensionsv1alpha1.Infrastructure{
+						Spec: extensionsv1alpha1.InfrastructureSpec{
+							ProviderConfig: &runtime.RawExtension{
+								Raw: configYAML,
+							},
+							Region: "region",
+							SecretRef: corev1.SecretReference{
+								Namespace: secretNamespace,
+								Name:      secretName,
+							},
+						},
+					}
+					accessKeyID     = "accessKeyID"
+					accessKeySecret = "accessKeySecret"
+					cluster         = controller.Cluster{}
+
+					initializerValues = InitializerValues{}
+					chartValues       = map[string]interface{}{}
+
+					mainContent      = "main"
+					variablesContent = "variables"
+					tfVarsContent    = "tfVars"
+
+					vpcID = "vpcID"
+				)
+
+				describeNATGatewaysReq := vpc.CreateDescribeNatGatewaysRequest()
+				describeNATGatewaysReq.VpcId = vpcID
+
+				gomock.InOrder(
+					chartRendererFactory.EXPECT().NewForConfig(&restConfig).Return(chartRenderer, nil),
+
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: secretNamespace, Name: secretName}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+						SetArg(2, corev1.Secret{
+							Data: map[string][]byte{
+								alicloud.AccessKeyID:     []byte(accessKeyID),
+								alicloud.AccessKeySecret: []byte(accessKeySecret),
+							},
+						}),
+
+					terraformerFactory.EXPECT().NewForConfig(gomock.Any(), &restConfig, TerraformerPurpose, infra.Namespace, infra.Name, imagevector.TerraformerImage()).
+						Return(terraformer, nil),
+					terraformer.EXPECT().SetVariablesEnvironment(map[string]string{
+						common.TerraformVarAccessKeyID:     accessKeyID,
+						common.TerraformVarAccessKeySecret: accessKeySecret,
+					}).Return(terraformer),
+					terraformer.EXPECT().SetJobBackoffLimit(int32(0)).Return(terraformer),
+					terraformer.EXPECT().SetActiveDeadlineSeconds(int64(630)).Return(terraformer),
+					terraformer.EXPECT().SetDeadlineCleaning(5*time.Minute).Return(terraformer),
+					terraformer.EXPECT().SetDeadlinePod(15*time.Minute).Return(terraformer),
+					terraformer.EXPECT().SetDeadlineJob(15*time.Minute).Return(terraformer),
+
+					terraformer.EXPECT().ConfigExists().Return(true, nil),
+
+					alicloudClientFactory.EXPECT().NewVPC(infra.Spec.Region, accessKeyID, accessKeySecret).Return(vpcClient, nil),
+					terraformer.EXPECT().GetState().Return([]byte(fmt.Sprintf(`{"version": 4, "outputs": {%q: {"value": %q}}}`, TerraformerOutputKeyVPCID, vpcID)), nil),
+					vpcClient.EXPECT().DescribeNatGateways(describeNATGatewaysReq).Return(&vpc.DescribeNatGatewaysResponse{}, nil),
+					terraformChartOps.EXPECT().ComputeCreateVPCInitializerValues(&config, alicloudclient.DefaultInternetChargeType).Return(&initializerValues),
+					terraformChartOps.EXPECT().ComputeChartValues(&infra, &config, &initializerValues).Return(chartValues),
+					chartRenderer.EXPECT().Render(
+						alicloud.InfraChartPath,
+						alicloud.InfraRelease,
+						infra.Namespace,
+						chartValues,
+					).Return(&chartrenderer.RenderedChart{
+						Manifests: []manifest.Manifest{
+							mkManifest(chart.TerraformMainTFFilename, mainContent),
+							mkManifest(chart.TerraformVariablesTFFilename, variablesContent),
+							mkManifest(chart.TerraformTFVarsFilename, tfVarsContent),
+						},
+					}, nil),
+					terraformerFactory.EXPECT().DefaultInitializer(c, mainContent, variablesContent, []byte(tfVarsContent)).Return(initializer),
+
+					terraformer.EXPECT().InitializeWith(initializer).Return(terraformer),
+					terraformer.EXPECT().Destroy(),
+				)
+
+				ExpectInject(inject.ClientInto(c, actuator))
+				ExpectInject(inject.SchemeInto(scheme, actuator))
+				ExpectInject(inject.ConfigInto(&restConfig, actuator))
+
+				Expect(actuator.Delete(ctx, &infra, &cluster)).To(Succeed())
+			})
+		})
 	})
 })
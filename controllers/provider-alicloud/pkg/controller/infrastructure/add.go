@@ -15,15 +15,20 @@
 package infrastructure
 
 import (
+	"time"
+
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
 var (
 	// DefaultAddOptions are the default AddOptions for AddToManager.
-	DefaultAddOptions = AddOptions{}
+	DefaultAddOptions = AddOptions{
+		TerraformerFactory: extensionsterraformer.DefaultFactory(),
+	}
 )
 
 // AddOptions are options to apply when adding the infrastructure controller to the manager.
@@ -32,15 +37,25 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// DryRun specifies whether Infrastructure resources are only dry-run instead of being reconciled.
+	DryRun bool
+	// DriftDetectionInterval is the interval in which Infrastructure resources are checked for drift.
+	// Zero disables the drift detection.
+	DriftDetectionInterval time.Duration
+	// TerraformerFactory is the factory for the Terraformers of the actuator.
+	TerraformerFactory extensionsterraformer.Factory
 }
 
 // AddToManagerWithOptions adds a controller with the given AddOptions to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, options AddOptions) error {
 	return infrastructure.Add(mgr, infrastructure.AddArgs{
-		Actuator:          NewActuator(),
-		ControllerOptions: options.Controller,
-		Predicates:        infrastructure.DefaultPredicates(alicloud.Type, options.IgnoreOperationAnnotation),
+		Actuator:                 NewActuator(options.TerraformerFactory),
+		ControllerOptions:        options.Controller,
+		Predicates:               infrastructure.DefaultPredicates(alicloud.Type, options.IgnoreOperationAnnotation),
+		DryRun:                   options.DryRun,
+		DriftDetectionInterval:   options.DriftDetectionInterval,
+		DriftDetectionPredicates: infrastructure.DefaultDriftDetectionPredicates(alicloud.Type),
 	})
 }
 
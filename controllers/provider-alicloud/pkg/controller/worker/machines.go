@@ -112,11 +112,22 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			ID:      machineImageID,
 		})
 
+		workerConfig := &alicloudapi.WorkerConfig{}
+		providerConfig, err := worker.DecodeProviderConfig(w.decoder, pool, workerConfig)
+		if err != nil {
+			return err
+		}
+
 		volumeSize, err := worker.DiskSize(pool.Volume.Size)
 		if err != nil {
 			return err
 		}
 
+		instanceChargeType := "PostPaid"
+		if workerConfig.InstanceChargeType != nil {
+			instanceChargeType = *workerConfig.InstanceChargeType
+		}
+
 		for zoneIndex, zone := range pool.Zones {
 			nodesVSwitch, err := alicloudapihelper.FindVSwitchForPurposeAndZone(infrastructureStatus.VPC.VSwitches, alicloudapi.PurposeNodes, zone)
 			if err != nil {
@@ -134,38 +145,43 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 					"category": pool.Volume.Type,
 					"size":     volumeSize,
 				},
-				"instanceChargeType":      "PostPaid",
+				"instanceChargeType":      instanceChargeType,
 				"internetChargeType":      "PayByTraffic",
 				"internetMaxBandwidthIn":  5,
 				"internetMaxBandwidthOut": 5,
 				"spotStrategy":            "NoSpot",
-				"tags": map[string]string{
+				"tags": worker.MergeTags(workerConfig.Tags, map[string]string{
 					fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace):     "1",
 					fmt.Sprintf("kubernetes.io/role/worker/%s", w.worker.Namespace): "1",
-				},
+				}),
 				"secret": map[string]interface{}{
 					"userData": string(pool.UserData),
 				},
 				"keyPairName": infrastructureStatus.KeyPairName,
 			}
 
+			machineClassSpecHash, err := worker.MachineClassHashWithProviderConfig(machineClassSpec, shootVersionMajorMinor, providerConfig)
+			if err != nil {
+				return err
+			}
+
 			var (
-				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-				deploymentName       = fmt.Sprintf("%s-%s-%s", w.worker.Namespace, pool.Name, zone)
-				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+				deploymentName = fmt.Sprintf("%s-%s-%s", w.worker.Namespace, pool.Name, zone)
+				className      = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
-				Name:           deploymentName,
-				ClassName:      className,
-				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
-				Labels:         pool.Labels,
-				Annotations:    pool.Annotations,
-				Taints:         pool.Taints,
+				Name:                 deploymentName,
+				ClassName:            className,
+				SecretName:           className,
+				Minimum:              worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
+				Maximum:              worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
+				MaxSurge:             worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
+				MaxUnavailable:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
+				Labels:               pool.Labels,
+				Annotations:          pool.Annotations,
+				Taints:               pool.Taints,
+				RollingUpdateTimeout: worker.RollingUpdateTimeout(workerConfig.RollingUpdateTimeout),
 			})
 
 			machineClassSpec["name"] = className
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
 	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
@@ -424,6 +425,90 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should render the worker config of the pools into the machine classes", func() {
+				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+
+				prePaid := "PrePaid"
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&alicloudv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: alicloudv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						InstanceChargeType: &prePaid,
+						Tags: map[string]string{
+							"team": "foo",
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				var machineClasses []map[string]interface{}
+				chartApplier.
+					EXPECT().
+					ApplyChart(context.TODO(), filepath.Join(alicloud.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _, _, _ string, values, _ map[string]interface{}) error {
+						machineClasses = values["machineClasses"].([]map[string]interface{})
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+				Expect(machineClasses).To(HaveLen(4))
+
+				pool1Class, pool2Class := machineClasses[0], machineClasses[2]
+				Expect(pool1Class["instanceChargeType"]).To(Equal(prePaid))
+				Expect(pool1Class["tags"]).To(Equal(map[string]string{
+					"team": "foo",
+					fmt.Sprintf("kubernetes.io/cluster/%s", namespace):     "1",
+					fmt.Sprintf("kubernetes.io/role/worker/%s", namespace): "1",
+				}))
+
+				Expect(pool2Class["instanceChargeType"]).To(Equal("PostPaid"))
+			})
+
+			It("should roll the machines if only the tags of a pool change", func() {
+				workerConfigWithTags := func(tags map[string]string) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Raw: encode(&alicloudv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: alicloudv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							Tags: tags,
+						}),
+					}
+				}
+				classHash := func() string {
+					expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+					workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					return strings.TrimPrefix(result[0].ClassName, fmt.Sprintf("%s-%s-%s-", namespace, namePool1, zone1))
+				}
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithTags(map[string]string{"team": "foo"})
+				hashFoo := classHash()
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithTags(map[string]string{"team": "bar"})
+				Expect(classHash()).NotTo(Equal(hashFoo))
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithTags(map[string]string{"team": "foo"})
+				Expect(classHash()).To(Equal(hashFoo))
+			})
+
+			It("should fail because the worker config cannot be decoded", func() {
+				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte("invalid")}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -0,0 +1,93 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	alicloudvalidation "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/validation"
+	"github.com/gardener/gardener-extensions/pkg/webhook/validator"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var providerConfigPath = field.NewPath("spec", "providerConfig")
+
+// ValidateInfrastructure validates the InfrastructureConfig of the given Infrastructure. On updates, it additionally
+// checks that immutable fields have not been changed.
+func ValidateInfrastructure(_ context.Context, decoder runtime.Decoder, new, old *extensionsv1alpha1.Infrastructure) field.ErrorList {
+	infraConfig := &apisalicloud.InfrastructureConfig{}
+	if errs := validator.DecodeProviderConfig(decoder, new.Spec.ProviderConfig, infraConfig, providerConfigPath); len(errs) > 0 {
+		return errs
+	}
+
+	allErrs := alicloudvalidation.ValidateInfrastructureConfig(infraConfig, providerConfigPath)
+
+	if old != nil {
+		oldInfraConfig := &apisalicloud.InfrastructureConfig{}
+		if errs := validator.DecodeProviderConfig(decoder, old.Spec.ProviderConfig, oldInfraConfig, providerConfigPath); len(errs) == 0 {
+			allErrs = append(allErrs, alicloudvalidation.ValidateInfrastructureConfigUpdate(oldInfraConfig, infraConfig, providerConfigPath)...)
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateControlPlane validates the ControlPlaneConfig of the given ControlPlane. On updates, it additionally
+// checks that immutable fields have not been changed.
+func ValidateControlPlane(_ context.Context, decoder runtime.Decoder, new, old *extensionsv1alpha1.ControlPlane) field.ErrorList {
+	cpConfig := &apisalicloud.ControlPlaneConfig{}
+	if errs := validator.DecodeProviderConfig(decoder, new.Spec.ProviderConfig, cpConfig, providerConfigPath); len(errs) > 0 {
+		return errs
+	}
+
+	allErrs := alicloudvalidation.ValidateControlPlaneConfig(cpConfig, providerConfigPath)
+
+	if old != nil {
+		oldCPConfig := &apisalicloud.ControlPlaneConfig{}
+		if errs := validator.DecodeProviderConfig(decoder, old.Spec.ProviderConfig, oldCPConfig, providerConfigPath); len(errs) == 0 {
+			allErrs = append(allErrs, alicloudvalidation.ValidateControlPlaneConfigUpdate(oldCPConfig, cpConfig, providerConfigPath)...)
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateWorker validates that each worker pool of the given Worker specifies unique zones and validates the
+// WorkerConfig of each worker pool, if any.
+func ValidateWorker(_ context.Context, decoder runtime.Decoder, new, _ *extensionsv1alpha1.Worker) field.ErrorList {
+	poolsPath := field.NewPath("spec", "pools")
+	allErrs := validator.ValidateWorkerPoolZones(new.Spec.Pools, poolsPath)
+
+	for i, pool := range new.Spec.Pools {
+		if pool.ProviderConfig == nil {
+			continue
+		}
+
+		poolProviderConfigPath := poolsPath.Index(i).Child("providerConfig")
+		workerConfig := &apisalicloud.WorkerConfig{}
+		if errs := validator.DecodeProviderConfig(decoder, pool.ProviderConfig, workerConfig, poolProviderConfigPath); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+
+		allErrs = append(allErrs, alicloudvalidation.ValidateWorkerConfig(workerConfig, poolProviderConfigPath)...)
+	}
+
+	return allErrs
+}
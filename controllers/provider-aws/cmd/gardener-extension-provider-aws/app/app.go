@@ -42,8 +42,9 @@ import (
 // NewControllerManagerCommand creates a new command for running a AWS provider controller.
 func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 	var (
-		restOpts = &controllercmd.RESTOptions{}
-		mgrOpts  = &controllercmd.ManagerOptions{
+		mgrConfigFileOpts = &controllercmd.ManagerConfigFileOptions{}
+		restOpts          = &controllercmd.RESTOptions{}
+		mgrOpts           = &controllercmd.ManagerOptions{
 			LeaderElection:          true,
 			LeaderElectionID:        controllercmd.LeaderElectionNameID(aws.Name),
 			LeaderElectionNamespace: os.Getenv("LEADER_ELECTION_NAMESPACE"),
@@ -70,7 +71,13 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		infraCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
-		reconcileOpts = &controllercmd.ReconcilerOptions{}
+		infraDriftDetectionOpts = &controllercmd.DriftDetectionOptions{}
+		infraTerraformerOpts    = &controllercmd.TerraformerOptions{}
+		infraReconcileOpts      = &awsinfrastructure.Options{
+			Reconciler: awsinfrastructure.ReconcilerTerraform,
+		}
+		infraCtrlOptsUnprefixed = controllercmd.NewOptionAggregator(infraCtrlOpts, infraDriftDetectionOpts, infraTerraformerOpts, infraReconcileOpts)
+		reconcileOpts           = &controllercmd.ReconcilerOptions{}
 
 		// options for the worker controller
 		workerCtrlOpts = &controllercmd.ControllerOptions{
@@ -92,12 +99,13 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		webhookOptions     = webhookcmd.NewAddToManagerOptions(aws.Name, webhookServerOptions, webhookSwitches)
 
 		aggOption = controllercmd.NewOptionAggregator(
+			mgrConfigFileOpts,
 			restOpts,
 			mgrOpts,
 			controllercmd.PrefixOption("backupbucket-", backupBucketCtrlOpts),
 			controllercmd.PrefixOption("backupentry-", backupEntryCtrlOpts),
 			controllercmd.PrefixOption("controlplane-", controlPlaneCtrlOpts),
-			controllercmd.PrefixOption("infrastructure-", infraCtrlOpts),
+			controllercmd.PrefixOption("infrastructure-", &infraCtrlOptsUnprefixed),
 			controllercmd.PrefixOption("worker-", &workerCtrlOptsUnprefixed),
 			configFileOpts,
 			controllerSwitches,
@@ -142,16 +150,22 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			backupEntryCtrlOpts.Completed().Apply(&awsbackupentry.DefaultAddOptions.Controller)
 			controlPlaneCtrlOpts.Completed().Apply(&awscontrolplane.DefaultAddOptions.Controller)
 			infraCtrlOpts.Completed().Apply(&awsinfrastructure.DefaultAddOptions.Controller)
+			infraDriftDetectionOpts.Completed().Apply(&awsinfrastructure.DefaultAddOptions.DriftDetectionInterval)
+			infraTerraformerOpts.Completed().Apply(&awsinfrastructure.DefaultAddOptions.TerraformerFactory)
+			infraReconcileOpts.Completed().Apply(&awsinfrastructure.DefaultAddOptions.Reconciler)
 			reconcileOpts.Completed().Apply(&awsinfrastructure.DefaultAddOptions.IgnoreOperationAnnotation)
 			reconcileOpts.Completed().Apply(&awscontrolplane.DefaultAddOptions.IgnoreOperationAnnotation)
 			reconcileOpts.Completed().Apply(&awsworker.DefaultAddOptions.IgnoreOperationAnnotation)
+			reconcileOpts.Completed().ApplyDryRun(&awsinfrastructure.DefaultAddOptions.DryRun)
+			reconcileOpts.Completed().ApplyDryRun(&awscontrolplane.DefaultAddOptions.DryRun)
+			reconcileOpts.Completed().ApplyDryRun(&awsworker.DefaultAddOptions.DryRun)
 			workerCtrlOpts.Completed().Apply(&awsworker.DefaultAddOptions.Controller)
 
-			_, shootWebhooks, err := webhookOptions.Completed().AddToManager(mgr)
-			if err != nil {
+			webhookConfig := webhookOptions.Completed()
+			if _, _, err := webhookConfig.AddToManager(mgr); err != nil {
 				controllercmd.LogErrAndExit(err, "Could not add webhooks to manager")
 			}
-			awscontrolplane.DefaultAddOptions.ShootWebhooks = shootWebhooks
+			awscontrolplane.DefaultAddOptions.ShootWebhooks = webhookConfig.ShootWebhooks
 
 			if err := controllerSwitches.Completed().AddToManager(mgr); err != nil {
 				controllercmd.LogErrAndExit(err, "Could not add controllers to manager")
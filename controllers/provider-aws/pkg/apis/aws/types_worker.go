@@ -20,6 +20,44 @@ import (
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// Volume contains additional configuration for the root volumes of the machines.
+	Volume *Volume
+	// DataVolumes contains configuration for additional volumes attached to the machines.
+	DataVolumes []DataVolume
+	// Tags are additional tags that are added to the machines.
+	Tags map[string]string
+	// RollingUpdateTimeout is the maximum duration of a rolling update of the machines. It is excluded from the
+	// machine class hash as it does not affect the machines.
+	RollingUpdateTimeout *metav1.Duration `json:"-"`
+}
+
+// Volume contains configuration for EBS volumes.
+type Volume struct {
+	// IOPS is the number of I/O operations per second that the volume supports. It is only supported for volumes of
+	// type `io1`.
+	IOPS *int64
+	// Encrypted indicates whether the volume is encrypted.
+	Encrypted *bool
+}
+
+// DataVolume contains configuration for an additional EBS volume.
+type DataVolume struct {
+	Volume
+
+	// DeviceName is the device name under which the volume is exposed to the machine, e.g. `/dev/sdf`.
+	DeviceName string
+	// Size is the size of the volume, e.g. `50Gi`.
+	Size string
+	// Type is the type of the volume, e.g. `gp2`.
+	Type *string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // WorkerStatus contains information about created worker resources.
 type WorkerStatus struct {
 	metav1.TypeMeta
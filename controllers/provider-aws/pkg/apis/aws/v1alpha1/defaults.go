@@ -21,3 +21,11 @@ import (
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_DataVolume sets defaults for additional EBS volumes.
+func SetDefaults_DataVolume(obj *DataVolume) {
+	if obj.Type == nil {
+		volumeType := "gp2"
+		obj.Type = &volumeType
+	}
+}
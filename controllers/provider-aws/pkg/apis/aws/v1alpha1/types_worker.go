@@ -20,6 +20,50 @@ import (
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Volume contains additional configuration for the root volumes of the machines.
+	// +optional
+	Volume *Volume `json:"volume,omitempty"`
+	// DataVolumes contains configuration for additional volumes attached to the machines.
+	// +optional
+	DataVolumes []DataVolume `json:"dataVolumes,omitempty"`
+	// Tags are additional tags that are added to the machines.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+	// RollingUpdateTimeout is the maximum duration of a rolling update of the machines. Defaults to 30 minutes.
+	// +optional
+	RollingUpdateTimeout *metav1.Duration `json:"rollingUpdateTimeout,omitempty"`
+}
+
+// Volume contains configuration for EBS volumes.
+type Volume struct {
+	// IOPS is the number of I/O operations per second that the volume supports. It is only supported for volumes of
+	// type `io1`.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+	// Encrypted indicates whether the volume is encrypted.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+}
+
+// DataVolume contains configuration for an additional EBS volume.
+type DataVolume struct {
+	Volume `json:",inline"`
+
+	// DeviceName is the device name under which the volume is exposed to the machine, e.g. `/dev/sdf`.
+	DeviceName string `json:"deviceName"`
+	// Size is the size of the volume, e.g. `50Gi`.
+	Size string `json:"size"`
+	// Type is the type of the volume, e.g. `gp2`. Defaults to `gp2`.
+	// +optional
+	Type *string `json:"type,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // WorkerStatus contains information about created worker resources.
 type WorkerStatus struct {
 	metav1.TypeMeta `json:",inline"`
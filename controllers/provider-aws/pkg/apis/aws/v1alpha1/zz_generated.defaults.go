@@ -28,5 +28,13 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&WorkerConfig{}, func(obj interface{}) { SetObjectDefaults_WorkerConfig(obj.(*WorkerConfig)) })
 	return nil
 }
+
+func SetObjectDefaults_WorkerConfig(in *WorkerConfig) {
+	for i := range in.DataVolumes {
+		a := &in.DataVolumes[i]
+		SetDefaults_DataVolume(a)
+	}
+}
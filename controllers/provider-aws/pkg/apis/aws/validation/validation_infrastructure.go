@@ -0,0 +1,93 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object.
+func ValidateInfrastructureConfig(infra *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := fldPath.Child("networks")
+	vpcPath := networksPath.Child("vpc")
+
+	vpc := infra.Networks.VPC
+	switch {
+	case vpc.ID != nil && vpc.CIDR != nil:
+		allErrs = append(allErrs, field.Invalid(vpcPath, vpc, "must specify either an id or a cidr, not both"))
+	case vpc.ID == nil && vpc.CIDR == nil:
+		allErrs = append(allErrs, field.Required(vpcPath, "must specify either an id or a cidr"))
+	case vpc.ID != nil && len(*vpc.ID) == 0:
+		allErrs = append(allErrs, field.Required(vpcPath.Child("id"), "must provide a vpc id"))
+	case vpc.CIDR != nil:
+		allErrs = append(allErrs, extensionsvalidation.ValidateCIDR(*vpc.CIDR, vpcPath.Child("cidr"))...)
+	}
+
+	zonesPath := networksPath.Child("zones")
+	if len(infra.Networks.Zones) == 0 {
+		allErrs = append(allErrs, field.Required(zonesPath, "must provide at least one zone"))
+	}
+
+	zones := map[string]bool{}
+	for i, zone := range infra.Networks.Zones {
+		idxPath := zonesPath.Index(i)
+
+		if len(zone.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if zones[zone.Name] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), zone.Name))
+		}
+		zones[zone.Name] = true
+
+		allErrs = append(allErrs, validateZoneCIDR(zone.Internal, vpc.CIDR, idxPath.Child("internal"))...)
+		allErrs = append(allErrs, validateZoneCIDR(zone.Public, vpc.CIDR, idxPath.Child("public"))...)
+		allErrs = append(allErrs, validateZoneCIDR(zone.Workers, vpc.CIDR, idxPath.Child("workers"))...)
+	}
+
+	return allErrs
+}
+
+func validateZoneCIDR(cidr string, vpcCIDR *string, fldPath *field.Path) field.ErrorList {
+	allErrs := extensionsvalidation.ValidateCIDR(cidr, fldPath)
+	if vpcCIDR != nil {
+		allErrs = append(allErrs, extensionsvalidation.ValidateCIDRIsSubset(cidr, *vpcCIDR, fldPath)...)
+	}
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update. The VPC and existing
+// zones are immutable, however, new zones may be added.
+func ValidateInfrastructureConfigUpdate(oldInfra, newInfra *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := fldPath.Child("networks")
+	allErrs = append(allErrs, extensionsvalidation.ValidateImmutableField(newInfra.Networks.VPC, oldInfra.Networks.VPC, networksPath.Child("vpc"))...)
+
+	zonesPath := networksPath.Child("zones")
+	if len(newInfra.Networks.Zones) < len(oldInfra.Networks.Zones) {
+		allErrs = append(allErrs, field.Forbidden(zonesPath, "zones must not be removed"))
+		return allErrs
+	}
+	for i, oldZone := range oldInfra.Networks.Zones {
+		allErrs = append(allErrs, extensionsvalidation.ValidateImmutableField(newInfra.Networks.Zones[i], oldZone, zonesPath.Index(i))...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisaws.InfrastructureConfig
+		fldPath              = field.NewPath("spec", "providerConfig")
+		vpcCIDR              = "10.250.0.0/16"
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisaws.InfrastructureConfig{
+			Networks: apisaws.Networks{
+				VPC: apisaws.VPC{
+					CIDR: &vpcCIDR,
+				},
+				Zones: []apisaws.Zone{
+					{
+						Name:     "eu-west-1a",
+						Internal: "10.250.112.0/22",
+						Public:   "10.250.96.0/22",
+						Workers:  "10.250.0.0/19",
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid specifying both a vpc id and a vpc cidr", func() {
+			id := "vpc-1234"
+			infrastructureConfig.Networks.VPC.ID = &id
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.vpc"),
+			}))))
+		})
+
+		It("should forbid zone cidrs that are not a subset of the vpc cidr", func() {
+			infrastructureConfig.Networks.Zones[0].Workers = "10.251.0.0/19"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.zones[0].workers"),
+			}))))
+		})
+
+		It("should forbid duplicate zones", func() {
+			infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, infrastructureConfig.Networks.Zones[0])
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.providerConfig.networks.zones[1].name"),
+			}))))
+		})
+
+		It("should require at least one zone", func() {
+			infrastructureConfig.Networks.Zones = nil
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.networks.zones"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should allow adding a zone", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = append(newInfrastructureConfig.Networks.Zones, apisaws.Zone{
+				Name:     "eu-west-1b",
+				Internal: "10.250.116.0/22",
+				Public:   "10.250.100.0/22",
+				Workers:  "10.250.32.0/19",
+			})
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid changing the vpc cidr", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newCIDR := "10.251.0.0/16"
+			newInfrastructureConfig.Networks.VPC.CIDR = &newCIDR
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.vpc"),
+			}))))
+		})
+
+		It("should forbid changing or removing existing zones", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[0].Name = "eu-west-1c"
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.zones[0]"),
+			}))))
+
+			newInfrastructureConfig.Networks.Zones = nil
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.providerConfig.networks.zones"),
+			}))))
+		})
+	})
+})
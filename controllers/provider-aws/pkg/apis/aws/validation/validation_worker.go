@@ -0,0 +1,88 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// volumeTypeIO1 is the only EBS volume type with provisioned IOPS.
+	volumeTypeIO1 = "io1"
+
+	maxTagKeyLength   = 127
+	maxTagValueLength = 255
+)
+
+var reservedTagKeyPrefixes = []string{"kubernetes.io/cluster/", "kubernetes.io/role/"}
+
+// ValidateWorkerConfig validates a WorkerConfig object. The volumeType is the type of the root volume of the worker pool.
+func ValidateWorkerConfig(workerConfig *apisaws.WorkerConfig, volumeType string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig.Volume != nil {
+		allErrs = append(allErrs, validateVolume(workerConfig.Volume, volumeType, fldPath.Child("volume"))...)
+	}
+
+	dataVolumesPath := fldPath.Child("dataVolumes")
+	deviceNames := sets.NewString()
+	for i, dataVolume := range workerConfig.DataVolumes {
+		idxPath := dataVolumesPath.Index(i)
+
+		if len(dataVolume.DeviceName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("deviceName"), "must provide a device name"))
+		} else if deviceNames.Has(dataVolume.DeviceName) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("deviceName"), dataVolume.DeviceName))
+		}
+		deviceNames.Insert(dataVolume.DeviceName)
+
+		allErrs = append(allErrs, extensionsvalidation.ValidateDiskSize(dataVolume.Size, idxPath.Child("size"))...)
+
+		var dataVolumeType string
+		if dataVolume.Type != nil {
+			dataVolumeType = *dataVolume.Type
+			if len(dataVolumeType) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("type"), "must provide a type"))
+			}
+		}
+
+		allErrs = append(allErrs, validateVolume(&dataVolume.Volume, dataVolumeType, idxPath)...)
+	}
+
+	allErrs = append(allErrs, extensionsvalidation.ValidateTags(workerConfig.Tags, reservedTagKeyPrefixes, maxTagKeyLength, maxTagValueLength, fldPath.Child("tags"))...)
+
+	return allErrs
+}
+
+func validateVolume(volume *apisaws.Volume, volumeType string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if volume.IOPS != nil {
+		iopsPath := fldPath.Child("iops")
+		if volumeType != volumeTypeIO1 {
+			allErrs = append(allErrs, field.Forbidden(iopsPath, fmt.Sprintf("is only supported for volumes of type %q", volumeTypeIO1)))
+		} else if *volume.IOPS <= 0 {
+			allErrs = append(allErrs, field.Invalid(iopsPath, *volume.IOPS, "must be positive"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("WorkerConfig validation", func() {
+	var (
+		workerConfig *apisaws.WorkerConfig
+		fldPath      = field.NewPath("spec", "pools").Index(0).Child("providerConfig")
+
+		io1  = "io1"
+		iops = int64(1000)
+	)
+
+	BeforeEach(func() {
+		encrypted := true
+
+		workerConfig = &apisaws.WorkerConfig{
+			Volume: &apisaws.Volume{
+				IOPS:      &iops,
+				Encrypted: &encrypted,
+			},
+			DataVolumes: []apisaws.DataVolume{
+				{
+					DeviceName: "/dev/sdf",
+					Size:       "100Gi",
+					Type:       &io1,
+					Volume: apisaws.Volume{
+						IOPS: &iops,
+					},
+				},
+			},
+			Tags: map[string]string{
+				"team": "foo",
+			},
+		}
+	})
+
+	Describe("#ValidateWorkerConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateWorkerConfig(workerConfig, io1, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid iops for volumes that are not of type io1", func() {
+			gp2 := "gp2"
+			workerConfig.DataVolumes[0].Type = &gp2
+
+			Expect(ValidateWorkerConfig(workerConfig, "gp2", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.pools[0].providerConfig.volume.iops"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.pools[0].providerConfig.dataVolumes[0].iops"),
+				})),
+			))
+		})
+
+		It("should forbid data volumes without device name or with invalid size", func() {
+			workerConfig.DataVolumes = append(workerConfig.DataVolumes,
+				apisaws.DataVolume{Size: "foo"},
+				apisaws.DataVolume{DeviceName: "/dev/sdf", Size: "-1Gi"},
+			)
+
+			Expect(ValidateWorkerConfig(workerConfig, io1, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.pools[0].providerConfig.dataVolumes[1].deviceName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.pools[0].providerConfig.dataVolumes[1].size"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.pools[0].providerConfig.dataVolumes[2].deviceName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.pools[0].providerConfig.dataVolumes[2].size"),
+				})),
+			))
+		})
+
+		It("should forbid reserved tag keys", func() {
+			workerConfig.Tags["kubernetes.io/cluster/foo"] = "1"
+
+			Expect(ValidateWorkerConfig(workerConfig, io1, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.pools[0].providerConfig.tags[kubernetes.io/cluster/foo]"),
+			}))))
+		})
+	})
+})
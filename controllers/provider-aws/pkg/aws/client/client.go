@@ -24,6 +24,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
@@ -32,6 +33,16 @@ import (
 // the AWS region <region>.
 // It initializes the clients for the various services like EC2, ELB, etc.
 func NewClient(accessKeyID, secretAccessKey, region string) (Interface, error) {
+	c, err := NewServiceClients(accessKeyID, secretAccessKey, region)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewServiceClients creates a new Client for the given AWS credentials <accessKeyID>, <secretAccessKey>, and
+// the AWS region <region>. In contrast to NewClient, it gives direct access to the clients of the various services.
+func NewServiceClients(accessKeyID, secretAccessKey, region string) (*Client, error) {
 	var (
 		awsConfig = &aws.Config{
 			Credentials: credentials.NewStaticCredentials(accessKeyID, secretAccessKey, ""),
@@ -47,6 +58,7 @@ func NewClient(accessKeyID, secretAccessKey, region string) (Interface, error) {
 	return &Client{
 		EC2: ec2.New(s, config),
 		ELB: elb.New(s, config),
+		IAM: iam.New(s, config),
 		STS: sts.New(s, config),
 		S3:  s3.New(s, config),
 	}, nil
@@ -202,6 +214,9 @@ func (c *Client) DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix str
 		}
 		return !lastPage
 	}); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket {
+			return nil
+		}
 		return err
 	}
 	if len(objectIDs) == 0 {
@@ -246,6 +261,29 @@ func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket, region str
 	return nil
 }
 
+// EnsureBucketLifecycleConfiguration configures the s3 bucket with name <bucket> to abort incomplete multipart
+// uploads after <abortIncompleteMultipartUploadDays> days.
+func (c *Client) EnsureBucketLifecycleConfiguration(ctx context.Context, bucket string) error {
+	_, err := c.S3.PutBucketLifecycleConfigurationWithContext(ctx, &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: []*s3.LifecycleRule{
+				{
+					ID:     aws.String(abortIncompleteMultipartUploadRuleID),
+					Status: aws.String(s3.ExpirationStatusEnabled),
+					Filter: &s3.LifecycleRuleFilter{
+						Prefix: aws.String(""),
+					},
+					AbortIncompleteMultipartUpload: &s3.AbortIncompleteMultipartUpload{
+						DaysAfterInitiation: aws.Int64(abortIncompleteMultipartUploadDays),
+					},
+				},
+			},
+		},
+	})
+	return err
+}
+
 // DeleteBucketIfExists deletes the s3 bucket with name <bucket>. If it does not exist,
 // no error is returned.
 func (c *Client) DeleteBucketIfExists(ctx context.Context, bucket string) error {
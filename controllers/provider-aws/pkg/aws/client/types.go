@@ -19,6 +19,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 )
@@ -32,6 +33,12 @@ const (
 	//
 	// The specified bucket us exist.
 	errCodeBucketNotEmpty = "BucketNotEmpty"
+
+	// abortIncompleteMultipartUploadRuleID is the id of the bucket lifecycle rule that aborts incomplete
+	// multipart uploads.
+	abortIncompleteMultipartUploadRuleID = "abort-incomplete-multipart-uploads"
+	// abortIncompleteMultipartUploadDays is the number of days after which incomplete multipart uploads are aborted.
+	abortIncompleteMultipartUploadDays = 7
 )
 
 // Interface is an interface which must be implemented by AWS clients.
@@ -42,6 +49,7 @@ type Interface interface {
 	// S3 wrappers
 	DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error
 	CreateBucketIfNotExists(ctx context.Context, bucket, region string) error
+	EnsureBucketLifecycleConfiguration(ctx context.Context, bucket string) error
 	DeleteBucketIfExists(ctx context.Context, bucket string) error
 
 	// The following functions are only temporary needed due to https://github.com/gardener/gardener/issues/129.
@@ -54,11 +62,13 @@ type Interface interface {
 // Client is a struct containing several clients for the different AWS services it needs to interact with.
 // * EC2 is the standard client for the EC2 service.
 // * ELB is the standard client for the ELB service.
+// * IAM is the standard client for the IAM service.
 // * STS is the standard client for the STS service.
 // * S3 is the standard client for the S3 service.
 type Client struct {
 	EC2 ec2iface.EC2API
 	ELB elbiface.ELBAPI
+	IAM iamiface.IAMAPI
 	STS stsiface.STSAPI
 	S3  s3iface.S3API
 }
@@ -32,6 +32,14 @@ const (
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
 	// AWSLBReadvertiserImageName is the name of the AWSLBReadvertiser image.
 	AWSLBReadvertiserImageName = "aws-lb-readvertiser"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSINodeDriverRegistrarImageName is the name of the CSI driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSIPluginImageName is the name of the CSI plugin image.
+	CSIPluginImageName = "aws-ebs-csi-driver"
 
 	// AccessKeyID is a constant for the key in a cloud provider secret and backup secret that holds the AWS access key id.
 	AccessKeyID = "accessKeyID"
@@ -74,6 +82,13 @@ const (
 	MachineControllerManagerMonitoringConfigName = "machine-controller-manager-monitoring-config"
 	// BackupSecretName is the name of the secret containing the credentials for storing the backups of Shoot clusters.
 	BackupSecretName = "etcd-backup"
+
+	// CSIVersionConstraint is the constraint for the shoot Kubernetes versions for which the EBS CSI driver is deployed
+	// and in-tree EBS volumes are migrated to it.
+	CSIVersionConstraint = ">= 1.18"
+	// InTreeVolumePluginVersionConstraint is the constraint for the shoot Kubernetes versions for which the in-tree EBS
+	// volume plugin is used.
+	InTreeVolumePluginVersionConstraint = "< 1.18"
 )
 
 var (
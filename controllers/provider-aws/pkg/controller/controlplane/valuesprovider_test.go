@@ -17,11 +17,13 @@ package controlplane
 import (
 	"context"
 	"encoding/json"
+	"path/filepath"
 
 	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	"github.com/gardener/gardener-extensions/pkg/util/test"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
@@ -56,6 +58,7 @@ var _ = Describe("ValuesProvider", func() {
 				Namespace: namespace,
 			},
 			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				Region: "eu-west-1",
 				ProviderConfig: &runtime.RawExtension{
 					Raw: encode(&apisaws.ControlPlaneConfig{
 						CloudControllerManager: &apisaws.CloudControllerManagerConfig{
@@ -101,6 +104,16 @@ var _ = Describe("ValuesProvider", func() {
 				},
 			},
 		}
+		csiCluster = &extensionscontroller.Cluster{
+			Shoot: &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: cluster.Shoot.Spec.Cloud,
+					Kubernetes: gardenv1beta1.Kubernetes{
+						Version: "1.18.2",
+					},
+				},
+			},
+		}
 		cpService = &corev1.Service{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      v1alpha1constants.DeploymentNameKubeAPIServer,
@@ -121,6 +134,8 @@ var _ = Describe("ValuesProvider", func() {
 			"cloud-controller-manager":                "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
 			"cloud-controller-manager-server":         "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
 			awsLBReadvertiserDeploymentName:           "599aeee0cbbfdab4ea29c642cb04a6c9a3eb90ec21b41570efb987958f99d4b1",
+			csiAttacherName:                           "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+			csiProvisionerName:                        "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
 		}
 
 		configChartValues = map[string]interface{}{
@@ -146,6 +161,20 @@ var _ = Describe("ValuesProvider", func() {
 			},
 		}
 
+		controlPlaneChartValues = map[string]interface{}{
+			"cloud-controller-manager": ccmChartValues,
+			"csi-aws": map[string]interface{}{
+				"enabled":  false,
+				"replicas": 1,
+				"region":   "eu-west-1",
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-csi-attacher":    "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+					"checksum/secret-csi-provisioner": "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
+					"checksum/secret-cloudprovider":   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
+				},
+			},
+		}
+
 		cpExposureChartValues = map[string]interface{}{
 			"domain":   "10.10.10.1",
 			"replicas": 1,
@@ -189,7 +218,70 @@ var _ = Describe("ValuesProvider", func() {
 			// Call GetControlPlaneChartValues method and check the result
 			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, checksums, false)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(values).To(Equal(ccmChartValues))
+			Expect(values).To(Equal(controlPlaneChartValues))
+		})
+
+		It("should enable the CSI driver for Kubernetes versions matching the CSI version constraint", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneChartValues method and check the result
+			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, csiCluster, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("csi-aws", HaveKeyWithValue("enabled", true)))
+		})
+	})
+
+	Describe("#GetControlPlaneShootChartValues", func() {
+		It("should return correct control plane shoot chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-aws": map[string]interface{}{"enabled": false},
+			}))
+
+			values, err = vp.GetControlPlaneShootChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-aws": map[string]interface{}{"enabled": true},
+			}))
+		})
+	})
+
+	Describe("#GetStorageClassesChartValues", func() {
+		It("should return correct storage classes chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"useCSI": false}))
+
+			values, err = vp.GetStorageClassesChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"useCSI": true}))
+		})
+
+		It("should keep the in-tree storage classes but make the CSI one the default", func() {
+			vp := NewValuesProvider(logger)
+
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			storageClasses, err := test.RenderStorageClasses(filepath.Join("..", "..", "..", "..", "..", storageClassChart.Path), csiCluster.Shoot.Spec.Kubernetes.Version, values)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storageClasses).To(HaveKey("default"))
+			Expect(storageClasses["default"].Annotations).NotTo(HaveKey("storageclass.kubernetes.io/is-default-class"))
+			Expect(storageClasses).To(HaveKey("gp2"))
+			Expect(storageClasses["gp2"].Annotations).NotTo(HaveKey("storageclass.kubernetes.io/is-default-class"))
+			Expect(storageClasses["csi-aws-gp2"].Annotations).To(HaveKeyWithValue("storageclass.kubernetes.io/is-default-class", "true"))
 		})
 	})
 
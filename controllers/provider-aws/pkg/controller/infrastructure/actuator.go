@@ -16,6 +16,7 @@ package infrastructure
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/imagevector"
@@ -23,6 +24,7 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	glogger "github.com/gardener/gardener/pkg/logger"
@@ -39,7 +41,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
-type actuator struct {
+// common contains the fields and injection functions shared by the Terraform and the native actuator.
+type common struct {
 	logger logr.Logger
 
 	restConfig *rest.Config
@@ -49,27 +52,42 @@ type actuator struct {
 	decoder runtime.Decoder
 }
 
-// NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources.
-func NewActuator() infrastructure.Actuator {
-	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
-	}
+func (c *common) InjectScheme(scheme *runtime.Scheme) error {
+	c.scheme = scheme
+	c.decoder = serializer.NewCodecFactory(c.scheme).UniversalDecoder()
+	return nil
 }
 
-func (a *actuator) InjectScheme(scheme *runtime.Scheme) error {
-	a.scheme = scheme
-	a.decoder = serializer.NewCodecFactory(a.scheme).UniversalDecoder()
+func (c *common) InjectClient(client client.Client) error {
+	c.client = client
 	return nil
 }
 
-func (a *actuator) InjectClient(client client.Client) error {
-	a.client = client
+func (c *common) InjectConfig(config *rest.Config) error {
+	c.restConfig = config
 	return nil
 }
 
-func (a *actuator) InjectConfig(config *rest.Config) error {
-	a.restConfig = config
-	return nil
+type actuator struct {
+	common
+
+	terraformerFactory extensionsterraformer.Factory
+}
+
+// NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources and uses the
+// Terraformers of the given factory.
+func NewActuator(terraformerFactory extensionsterraformer.Factory) infrastructure.Actuator {
+	return NewActuatorWithDeps(log.Log.WithName("infrastructure-actuator"), terraformerFactory)
+}
+
+// NewActuatorWithDeps creates a new Actuator with the given dependencies.
+func NewActuatorWithDeps(logger logr.Logger, terraformerFactory extensionsterraformer.Factory) infrastructure.Actuator {
+	return &actuator{
+		common: common{
+			logger: logger,
+		},
+		terraformerFactory: terraformerFactory,
+	}
 }
 
 func (a *actuator) Reconcile(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
@@ -80,10 +98,23 @@ func (a *actuator) Delete(ctx context.Context, config *extensionsv1alpha1.Infras
 	return a.delete(ctx, config, cluster)
 }
 
+func (a *actuator) Migrate(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	tf, err := a.newTerraformer(aws.TerraformerPurposeInfra, config.Namespace, config.Name)
+	if err != nil {
+		return fmt.Errorf("could not create the Terraformer: %+v", err)
+	}
+
+	return infrastructure.MigrateTerraformState(ctx, a.client, tf, config)
+}
+
+func (a *actuator) Restore(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.reconcile(ctx, config, cluster)
+}
+
 // Helper functions
 
-func (a *actuator) newTerraformer(purpose, namespace, name string) (*terraformer.Terraformer, error) {
-	t, err := terraformer.NewForConfig(glogger.NewLogger("info"), a.restConfig, purpose, namespace, name, imagevector.TerraformerImage())
+func (a *actuator) newTerraformer(purpose, namespace, name string) (extensionsterraformer.Interface, error) {
+	t, err := a.terraformerFactory.NewForConfig(glogger.NewLogger("info"), a.restConfig, purpose, namespace, name, imagevector.TerraformerImage())
 	if err != nil {
 		return nil, err
 	}
@@ -23,19 +23,23 @@ import (
 	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	infrastructurecontroller "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	v1alpha1constantshelper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	glogger "github.com/gardener/gardener/pkg/logger"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/gardener/gardener/pkg/utils/flow"
-	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
-	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 func (a *actuator) delete(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	_, providerSecret, release, err := a.renderTerraformInfraChart(ctx, infrastructure)
+	if err != nil {
+		return err
+	}
+
 	tf, err := a.newTerraformer(aws.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
 	if err != nil {
 		return fmt.Errorf("could not create the Terraformer: %+v", err)
@@ -46,20 +50,37 @@ func (a *actuator) delete(ctx context.Context, infrastructure *extensionsv1alpha
 		return fmt.Errorf("terraform configuration was not found: %+v", err)
 	}
 
-	stateVariables, err := tf.GetStateOutputVariables(aws.VPCIDKey)
+	state, err := infrastructurecontroller.GetTerraformState(infrastructure)
+	if err != nil {
+		return err
+	}
+
+	// If the Terraform configuration is missing in the seed, e.g. after a migration, it is restored together with
+	// the Terraform state persisted in the status of the infrastructure before it is destroyed.
+	tf = tf.InitializeWith(extensionsterraformer.WithState(
+		a.client,
+		a.terraformerFactory.DefaultInitializer(
+			a.client,
+			release.FileContent("main.tf"),
+			release.FileContent("variables.tf"),
+			[]byte(release.FileContent("terraform.tfvars"))),
+		state),
+	)
+
+	var stateVariables map[string]string
+	tfState, err := infrastructurecontroller.ReadTerraformState(tf, configExists, infrastructure)
+	if err == nil {
+		stateVariables, err = tfState.GetStateOutputVariables(aws.VPCIDKey)
+	}
 	if err != nil {
-		if apierrors.IsNotFound(err) || terraformer.IsVariablesNotFoundError(err) {
+		if apierrors.IsNotFound(err) || extensionsterraformer.IsVariablesNotFoundError(err) {
 			a.logger.Info("Skipping explicit AWS load balancer and security group deletion because not all variables have been found in the Terraform state.")
 			return nil
 		}
 		return err
 	}
 	vpcID := stateVariables[aws.VPCIDKey]
-
-	providerSecret := &corev1.Secret{}
-	if err := a.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
-		return err
-	}
+	configExists = configExists || len(state) > 0
 
 	awsClient, err := awsclient.NewClient(string(providerSecret.Data[aws.AccessKeyID]), string(providerSecret.Data[aws.SecretAccessKey]), infrastructure.Spec.Region)
 	if err != nil {
@@ -98,7 +119,9 @@ func (a *actuator) delete(ctx context.Context, infrastructure *extensionsv1alpha
 	return nil
 }
 
-func (a *actuator) destroyKubernetesLoadBalancersAndSecurityGroups(ctx context.Context, awsClient awsclient.Interface, vpcID, clusterName string) error {
+// destroyKubernetesLoadBalancersAndSecurityGroups deletes the load balancers and security groups that have been
+// created by Kubernetes in the given VPC.
+func (c *common) destroyKubernetesLoadBalancersAndSecurityGroups(ctx context.Context, awsClient awsclient.Interface, vpcID, clusterName string) error {
 	loadBalancers, err := awsClient.ListKubernetesELBs(ctx, vpcID, clusterName)
 	if err != nil {
 		return err
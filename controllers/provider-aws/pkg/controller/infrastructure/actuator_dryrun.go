@@ -0,0 +1,68 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/imagevector"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	infrastructurecontroller "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	glogger "github.com/gardener/gardener/pkg/logger"
+)
+
+// DryRun implements infrastructure.DryRunner. It plans the Terraform configuration of the given Infrastructure
+// against its current Terraform state and returns the changes an apply would make.
+func (a *actuator) DryRun(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (*extensionscontroller.DryRunResult, error) {
+	_, providerSecret, release, err := a.renderTerraformInfraChart(ctx, infrastructure)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := a.newTerraformer(aws.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not create terraformer object: %+v", err)
+	}
+
+	state, err := infrastructurecontroller.CurrentTerraformState(infrastructure, tf)
+	if err != nil {
+		return nil, err
+	}
+
+	planTF, err := a.terraformerFactory.NewForConfig(glogger.NewLogger("info"), a.restConfig, infrastructurecontroller.TerraformPlanPurpose(aws.TerraformerPurposeInfra), infrastructure.Namespace, infrastructure.Name, imagevector.TerraformerImage())
+	if err != nil {
+		return nil, fmt.Errorf("could not create terraformer object: %+v", err)
+	}
+
+	return infrastructurecontroller.PlanTerraform(
+		ctx,
+		a.client,
+		planTF.
+			SetVariablesEnvironment(generateTerraformInfraVariablesEnvironment(providerSecret)).
+			SetActiveDeadlineSeconds(630).
+			SetDeadlinePod(15*time.Minute),
+		a.terraformerFactory.DefaultInitializer(
+			a.client,
+			release.FileContent("main.tf"),
+			release.FileContent("variables.tf"),
+			[]byte(release.FileContent("terraform.tfvars"))),
+		state,
+	)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/controller/infrastructure/native"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	infrastructurecontroller "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+// nativeActuator reconciles the AWS infrastructure with the native Reconciler instead of Terraform. It does not
+// implement infrastructure.DryRunner.
+type nativeActuator struct {
+	common
+}
+
+// NewNativeActuator creates a new Actuator that reconciles the AWS resources of the handled Infrastructure
+// resources without Terraform.
+func NewNativeActuator() infrastructurecontroller.Actuator {
+	return &nativeActuator{
+		common: common{
+			logger: log.Log.WithName("infrastructure-native-actuator"),
+		},
+	}
+}
+
+func (a *nativeActuator) Reconcile(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	infrastructureConfig, providerSecret, err := a.getInfrastructureConfigAndSecret(ctx, infrastructure)
+	if err != nil {
+		return err
+	}
+
+	awsClient, err := newServiceClients(providerSecret, infrastructure.Spec.Region)
+	if err != nil {
+		return err
+	}
+	reconciler := native.NewReconciler(a.logger.WithValues("infrastructure", infrastructure.Name), awsClient.EC2, awsClient.IAM)
+
+	imported, err := importFromTerraformState(infrastructure)
+	if err != nil {
+		return err
+	}
+
+	a.reportProgress(ctx, infrastructure, 20, "Reconciling the AWS resources")
+	status, err := reconciler.Reconcile(ctx, infrastructure, infrastructureConfig, imported)
+	if err != nil {
+		a.logger.Error(err, "failed to reconcile the AWS resources", "infrastructure", infrastructure.Name)
+		infrastructurecontroller.ReportInfrastructureReady(ctx, a.logger, infrastructure, gardencorev1alpha1.ConditionFalse, "ReconcileFailed", err.Error())
+		return &controllererrors.RequeueAfterError{
+			Cause:        err,
+			RequeueAfter: 30 * time.Second,
+		}
+	}
+
+	infrastructurecontroller.ReportInfrastructureReady(ctx, a.logger, infrastructure, gardencorev1alpha1.ConditionTrue, "ReconcileSucceeded", "The AWS resources have been reconciled successfully.")
+	a.reportProgress(ctx, infrastructure, 90, "Updating the provider status")
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, infrastructure, func() error {
+		infrastructure.Status.ProviderStatus = &runtime.RawExtension{Object: status}
+		return nil
+	})
+}
+
+func (a *nativeActuator) Delete(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	infrastructureConfig, providerSecret, err := a.getInfrastructureConfigAndSecret(ctx, infrastructure)
+	if err != nil {
+		return err
+	}
+
+	awsClient, err := newServiceClients(providerSecret, infrastructure.Spec.Region)
+	if err != nil {
+		return err
+	}
+	reconciler := native.NewReconciler(a.logger.WithValues("infrastructure", infrastructure.Name), awsClient.EC2, awsClient.IAM)
+
+	vpcID, err := reconciler.VPCID(ctx, infrastructure, infrastructureConfig)
+	if err != nil {
+		return err
+	}
+	if vpcID != "" {
+		if err := a.destroyKubernetesLoadBalancersAndSecurityGroups(ctx, awsClient, vpcID, infrastructure.Namespace); err != nil {
+			return &controllererrors.RequeueAfterError{
+				Cause:        err,
+				RequeueAfter: 30 * time.Second,
+			}
+		}
+	}
+
+	if err := reconciler.Delete(ctx, infrastructure, infrastructureConfig); err != nil {
+		a.logger.Error(err, "failed to delete the AWS resources", "infrastructure", infrastructure.Name)
+		return &controllererrors.RequeueAfterError{
+			Cause:        err,
+			RequeueAfter: 30 * time.Second,
+		}
+	}
+	return nil
+}
+
+// Migrate does nothing as the native reconciler does not keep any state in the seed.
+func (a *nativeActuator) Migrate(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return nil
+}
+
+func (a *nativeActuator) Restore(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.Reconcile(ctx, infrastructure, cluster)
+}
+
+// newServiceClients creates the AWS service clients for the credentials in the given provider secret.
+func newServiceClients(providerSecret *corev1.Secret, region string) (*awsclient.Client, error) {
+	return awsclient.NewServiceClients(string(providerSecret.Data[aws.AccessKeyID]), string(providerSecret.Data[aws.SecretAccessKey]), region)
+}
+
+// importFromTerraformState returns the ids of the resources in the Terraform state persisted in the status of the
+// given Infrastructure, so that the native reconciler takes over the resources an earlier Terraform apply created.
+func importFromTerraformState(infrastructure *extensionsv1alpha1.Infrastructure) (native.ImportedIDs, error) {
+	data, err := infrastructurecontroller.GetTerraformState(infrastructure)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	state, err := extensionsterraformer.ParseState(data)
+	if err != nil {
+		return nil, err
+	}
+	return native.ImportFromTerraformState(state), nil
+}
@@ -18,7 +18,6 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"time"
 
@@ -28,10 +27,11 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	infrastructurecontroller "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	corev1 "k8s.io/api/core/v1"
@@ -41,56 +41,103 @@ import (
 )
 
 func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
-	infrastructureConfig := &awsapi.InfrastructureConfig{}
-	if _, _, err := a.decoder.Decode(infrastructure.Spec.ProviderConfig.Raw, nil, infrastructureConfig); err != nil {
-		return fmt.Errorf("could not decode provider config: %+v", err)
-	}
-
-	providerSecret := &corev1.Secret{}
-	if err := a.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
-		return err
-	}
-
-	terraformConfig, err := generateTerraformInfraConfig(ctx, infrastructure, infrastructureConfig, providerSecret)
-	if err != nil {
-		return fmt.Errorf("failed to generate Terraform config: %+v", err)
-	}
-
-	chartRenderer, err := chartrenderer.NewForConfig(a.restConfig)
+	infrastructureConfig, providerSecret, release, err := a.renderTerraformInfraChart(ctx, infrastructure)
 	if err != nil {
-		return fmt.Errorf("could not create chart renderer: %+v", err)
+		return err
 	}
 
-	release, err := chartRenderer.Render(filepath.Join(aws.InternalChartsPath, "aws-infra"), "aws-infra", infrastructure.Namespace, terraformConfig)
+	tf, err := a.newTerraformer(aws.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
 	if err != nil {
-		return fmt.Errorf("could not render Terraform chart: %+v", err)
+		return fmt.Errorf("could not create terraformer object: %+v", err)
 	}
 
-	tf, err := a.newTerraformer(aws.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
+	state, err := infrastructurecontroller.GetTerraformState(infrastructure)
 	if err != nil {
-		return fmt.Errorf("could not create terraformer object: %+v", err)
+		return err
 	}
 
-	if err := tf.
+	a.reportProgress(ctx, infrastructure, 20, "Applying the Terraform configuration")
+	applyErr := tf.
 		SetVariablesEnvironment(generateTerraformInfraVariablesEnvironment(providerSecret)).
-		InitializeWith(terraformer.DefaultInitializer(
+		InitializeWith(extensionsterraformer.WithState(
 			a.client,
-			release.FileContent("main.tf"),
-			release.FileContent("variables.tf"),
-			[]byte(release.FileContent("terraform.tfvars"))),
+			a.terraformerFactory.DefaultInitializer(
+				a.client,
+				release.FileContent("main.tf"),
+				release.FileContent("variables.tf"),
+				[]byte(release.FileContent("terraform.tfvars"))),
+			state),
 		).
-		Apply(); err != nil {
+		Apply()
+
+	// The Terraform state is persisted even if the apply failed as it might already reference created resources.
+	if err := infrastructurecontroller.SaveTerraformState(ctx, a.client, tf, infrastructure); err != nil {
+		return fmt.Errorf("could not persist the Terraform state: %+v", err)
+	}
 
-		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infrastructure.Name)
+	infrastructurecontroller.ReportTerraformApplyResult(ctx, a.logger, infrastructure, applyErr)
+	if applyErr != nil {
+		a.logger.Error(applyErr, "failed to apply the terraform config", "infrastructure", infrastructure.Name)
 		return &controllererrors.RequeueAfterError{
-			Cause:        err,
+			Cause:        applyErr,
 			RequeueAfter: 30 * time.Second,
 		}
 	}
 
+	a.reportProgress(ctx, infrastructure, 90, "Updating the provider status")
 	return a.updateProviderStatus(ctx, tf, infrastructure, infrastructureConfig)
 }
 
+// reportProgress reports the given progress of the infrastructure. Failures are only logged as the progress
+// reports are not essential for the reconciliation.
+func (c *common) reportProgress(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, progress int, description string) {
+	if err := extensionscontroller.ReportProgress(ctx, progress, description); err != nil {
+		c.logger.Error(err, "could not report the progress", "infrastructure", infrastructure.Name)
+	}
+}
+
+// getInfrastructureConfigAndSecret decodes the provider config of the given Infrastructure and reads its provider
+// secret.
+func (c *common) getInfrastructureConfigAndSecret(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) (*awsapi.InfrastructureConfig, *corev1.Secret, error) {
+	infrastructureConfig := &awsapi.InfrastructureConfig{}
+	if _, _, err := c.decoder.Decode(infrastructure.Spec.ProviderConfig.Raw, nil, infrastructureConfig); err != nil {
+		return nil, nil, fmt.Errorf("could not decode provider config: %+v", err)
+	}
+
+	providerSecret := &corev1.Secret{}
+	if err := c.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
+		return nil, nil, err
+	}
+
+	return infrastructureConfig, providerSecret, nil
+}
+
+// renderTerraformInfraChart decodes the provider config of the given Infrastructure, reads its provider secret and
+// renders the Terraform configuration.
+func (a *actuator) renderTerraformInfraChart(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) (*awsapi.InfrastructureConfig, *corev1.Secret, *chartrenderer.RenderedChart, error) {
+	infrastructureConfig, providerSecret, err := a.getInfrastructureConfigAndSecret(ctx, infrastructure)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	terraformConfig, err := generateTerraformInfraConfig(ctx, infrastructure, infrastructureConfig, providerSecret)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate Terraform config: %+v", err)
+	}
+
+	chartRenderer, err := chartrenderer.NewForConfig(a.restConfig)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not create chart renderer: %+v", err)
+	}
+
+	release, err := chartRenderer.Render(filepath.Join(aws.InternalChartsPath, "aws-infra"), "aws-infra", infrastructure.Namespace, terraformConfig)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not render Terraform chart: %+v", err)
+	}
+
+	return infrastructureConfig, providerSecret, release, nil
+}
+
 func generateTerraformInfraConfig(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig, providerSecret *corev1.Secret) (map[string]interface{}, error) {
 	var (
 		dhcpDomainName    = "ec2.internal"
@@ -162,26 +209,28 @@ func generateTerraformInfraConfig(ctx context.Context, infrastructure *extension
 	}, nil
 }
 
-func (a *actuator) updateProviderStatus(ctx context.Context, tf *terraformer.Terraformer, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig) error {
-	outputVarKeys := []string{
+func (a *actuator) updateProviderStatus(ctx context.Context, tf extensionsterraformer.StateGetter, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig) error {
+	state, err := extensionsterraformer.ReadState(tf)
+	if err != nil {
+		return err
+	}
+
+	output := make(map[string]string)
+	for _, key := range []string{
 		aws.VPCIDKey,
 		aws.SSHKeyName,
 		aws.IAMInstanceProfileNodes,
 		aws.NodesRole,
 		aws.SecurityGroupsNodes,
+	} {
+		value, err := state.OutputString(key)
+		if err != nil {
+			return err
+		}
+		output[key] = value
 	}
 
-	for zoneIndex := range infrastructureConfig.Networks.Zones {
-		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetNodesPrefix, zoneIndex))
-		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetPublicPrefix, zoneIndex))
-	}
-
-	output, err := tf.GetStateOutputVariables(outputVarKeys...)
-	if err != nil {
-		return err
-	}
-
-	subnets, err := computeProviderStatusSubnets(infrastructureConfig, output)
+	subnets, err := computeProviderStatusSubnets(infrastructureConfig, state)
 	if err != nil {
 		return err
 	}
@@ -226,34 +275,42 @@ func (a *actuator) updateProviderStatus(ctx context.Context, tf *terraformer.Ter
 	})
 }
 
-func computeProviderStatusSubnets(infrastructure *awsapi.InfrastructureConfig, values map[string]string) ([]awsv1alpha1.Subnet, error) {
+const (
+	// subnetNodesResourcePrefix is the prefix of the names of the aws_subnet resources for the nodes.
+	subnetNodesResourcePrefix = "nodes_z"
+	// subnetPublicResourcePrefix is the prefix of the names of the public aws_subnet resources.
+	subnetPublicResourcePrefix = "public_utility_z"
+)
+
+func computeProviderStatusSubnets(infrastructure *awsapi.InfrastructureConfig, state *extensionsterraformer.State) ([]awsv1alpha1.Subnet, error) {
 	var subnetsToReturn []awsv1alpha1.Subnet
 
-	for key, value := range values {
-		var prefix, purpose string
-		if strings.HasPrefix(key, aws.SubnetPublicPrefix) {
-			prefix = aws.SubnetPublicPrefix
+	for _, resource := range state.ResourcesByType("aws_subnet") {
+		var purpose string
+		switch {
+		case strings.HasPrefix(resource.Name, subnetPublicResourcePrefix):
 			purpose = awsapi.PurposePublic
-		}
-		if strings.HasPrefix(key, aws.SubnetNodesPrefix) {
-			prefix = aws.SubnetNodesPrefix
+		case strings.HasPrefix(resource.Name, subnetNodesResourcePrefix):
 			purpose = awsv1alpha1.PurposeNodes
-		}
-
-		if len(prefix) == 0 {
+		default:
 			continue
 		}
 
-		zoneID, err := strconv.Atoi(strings.TrimPrefix(key, prefix))
+		zone, err := resource.StringAttribute("availability_zone")
 		if err != nil {
 			return nil, err
 		}
+
 		subnetsToReturn = append(subnetsToReturn, awsv1alpha1.Subnet{
-			ID:      value,
+			ID:      resource.ID,
 			Purpose: purpose,
-			Zone:    infrastructure.Networks.Zones[zoneID].Name,
+			Zone:    zone,
 		})
 	}
 
+	if expected := 2 * len(infrastructure.Networks.Zones); len(subnetsToReturn) != expected {
+		return nil, fmt.Errorf("expected %d node and public subnets in Terraform state but found %d", expected, len(subnetsToReturn))
+	}
+
 	return subnetsToReturn, nil
 }
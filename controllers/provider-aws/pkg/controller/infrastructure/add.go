@@ -15,15 +15,21 @@
 package infrastructure
 
 import (
+	"time"
+
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
 var (
 	// DefaultAddOptions are the default AddOptions for AddToManager.
-	DefaultAddOptions = AddOptions{}
+	DefaultAddOptions = AddOptions{
+		Reconciler:         ReconcilerTerraform,
+		TerraformerFactory: extensionsterraformer.DefaultFactory(),
+	}
 )
 
 // AddOptions are options to apply when adding the AWS infrastructure controller to the manager.
@@ -32,15 +38,33 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// DryRun specifies whether Infrastructure resources are only dry-run instead of being reconciled.
+	DryRun bool
+	// DriftDetectionInterval is the interval in which Infrastructure resources are checked for drift.
+	// Zero disables the drift detection.
+	DriftDetectionInterval time.Duration
+	// TerraformerFactory is the factory for the Terraformers of the actuator.
+	TerraformerFactory extensionsterraformer.Factory
+	// Reconciler is the reconciler that is used for the infrastructure, either ReconcilerTerraform or
+	// ReconcilerNative.
+	Reconciler string
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
+	actuator := NewActuator(opts.TerraformerFactory)
+	if opts.Reconciler == ReconcilerNative {
+		actuator = NewNativeActuator()
+	}
+
 	return infrastructure.Add(mgr, infrastructure.AddArgs{
-		Actuator:          NewActuator(),
-		ControllerOptions: opts.Controller,
-		Predicates:        infrastructure.DefaultPredicates(aws.Type, opts.IgnoreOperationAnnotation),
+		Actuator:                 actuator,
+		ControllerOptions:        opts.Controller,
+		Predicates:               infrastructure.DefaultPredicates(aws.Type, opts.IgnoreOperationAnnotation),
+		DryRun:                   opts.DryRun,
+		DriftDetectionInterval:   opts.DriftDetectionInterval,
+		DriftDetectionPredicates: infrastructure.DefaultDriftDetectionPredicates(aws.Type),
 	})
 }
 
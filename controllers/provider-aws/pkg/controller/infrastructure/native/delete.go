@@ -0,0 +1,228 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"context"
+	"fmt"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Delete deletes all AWS resources of the given Infrastructure. Resources that do not exist anymore are skipped,
+// hence it can be retried until it succeeds. An existing VPC the InfrastructureConfig refers to is kept.
+func (r *Reconciler) Delete(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, config *awsapi.InfrastructureConfig) error {
+	o := r.newOperation(infrastructure, config, nil)
+
+	if err := o.deleteKeyPair(ctx); err != nil {
+		return err
+	}
+	for _, purpose := range []string{awsapi.PurposeNodes, purposeBastions} {
+		if err := o.deleteIAM(ctx, purpose); err != nil {
+			return err
+		}
+	}
+
+	vpcID, err := r.VPCID(ctx, infrastructure, config)
+	if err != nil || vpcID == "" {
+		return err
+	}
+
+	for index, zone := range config.Networks.Zones {
+		if err := o.deleteZone(ctx, index, zone, vpcID); err != nil {
+			return err
+		}
+	}
+
+	for _, purpose := range []string{"nodes", purposeBastions} {
+		if err := o.deleteByTags(ctx, o.tags(o.name(purpose)), o.describeSecurityGroups, func(id string) error {
+			_, err := o.ec2.DeleteSecurityGroupWithContext(ctx, &ec2.DeleteSecurityGroupInput{GroupId: aws.String(id)})
+			return err
+		}, filter("vpc-id", vpcID)); err != nil {
+			return err
+		}
+	}
+
+	if err := o.deleteRouteTable(ctx, o.clusterName, vpcID); err != nil {
+		return err
+	}
+
+	if config.Networks.VPC.ID != nil {
+		return nil
+	}
+	return o.deleteVPC(ctx, vpcID)
+}
+
+func (o *operation) deleteKeyPair(ctx context.Context) error {
+	_, err := o.ec2.DeleteKeyPairWithContext(ctx, &ec2.DeleteKeyPairInput{KeyName: aws.String(o.name("ssh-publickey"))})
+	return err
+}
+
+// deleteIAM deletes the instance profile, the inline policy and the role with the given purpose.
+func (o *operation) deleteIAM(ctx context.Context, purpose string) error {
+	name := o.name(purpose)
+
+	getOutput, err := o.iam.GetInstanceProfileWithContext(ctx, &iam.GetInstanceProfileInput{InstanceProfileName: aws.String(name)})
+	switch {
+	case err == nil:
+		for _, role := range getOutput.InstanceProfile.Roles {
+			if _, err := o.iam.RemoveRoleFromInstanceProfileWithContext(ctx, &iam.RemoveRoleFromInstanceProfileInput{
+				InstanceProfileName: aws.String(name),
+				RoleName:            role.RoleName,
+			}); err != nil {
+				return err
+			}
+		}
+		if _, err := o.iam.DeleteInstanceProfileWithContext(ctx, &iam.DeleteInstanceProfileInput{InstanceProfileName: aws.String(name)}); err != nil {
+			return err
+		}
+	case !isErrorCode(err, iam.ErrCodeNoSuchEntityException):
+		return err
+	}
+
+	if _, err := o.iam.DeleteRolePolicyWithContext(ctx, &iam.DeleteRolePolicyInput{
+		RoleName:   aws.String(name),
+		PolicyName: aws.String(name),
+	}); err != nil && !isErrorCode(err, iam.ErrCodeNoSuchEntityException) {
+		return err
+	}
+
+	if _, err := o.iam.DeleteRoleWithContext(ctx, &iam.DeleteRoleInput{RoleName: aws.String(name)}); err != nil && !isErrorCode(err, iam.ErrCodeNoSuchEntityException) {
+		return err
+	}
+	return nil
+}
+
+// deleteZone deletes the NAT gateway, the elastic ip, the private route table and the subnets of the zone with
+// the given index.
+func (o *operation) deleteZone(ctx context.Context, index int, zone awsapi.Zone, vpcID string) error {
+	if err := o.deleteByTags(ctx, o.tags(o.zoneName("natgw", index)), o.describeNATGateways, func(id string) error {
+		return o.deleteNATGateway(ctx, id)
+	}); err != nil {
+		return err
+	}
+
+	if err := o.deleteByTags(ctx, o.tags(o.zoneName("eip-natgw", index)), o.describeAddresses, func(id string) error {
+		_, err := o.ec2.ReleaseAddressWithContext(ctx, &ec2.ReleaseAddressInput{AllocationId: aws.String(id)})
+		return err
+	}); err != nil {
+		return err
+	}
+
+	if err := o.deleteRouteTable(ctx, o.name("private-"+zone.Name), vpcID); err != nil {
+		return err
+	}
+
+	for _, prefix := range []string{"nodes", "private-utility", "public-utility"} {
+		if err := o.deleteByTags(ctx, o.tags(o.zoneName(prefix, index)), o.describeSubnets, func(id string) error {
+			_, err := o.ec2.DeleteSubnetWithContext(ctx, &ec2.DeleteSubnetInput{SubnetId: aws.String(id)})
+			return err
+		}, filter("vpc-id", vpcID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteNATGateway deletes the NAT gateway with the given id and waits until it is deleted, as its network
+// interface blocks the deletion of its subnet and elastic ip before.
+func (o *operation) deleteNATGateway(ctx context.Context, id string) error {
+	if _, err := o.ec2.DeleteNatGatewayWithContext(ctx, &ec2.DeleteNatGatewayInput{NatGatewayId: aws.String(id)}); err != nil {
+		return err
+	}
+
+	return wait.PollImmediateUntil(o.pollInterval, func() (bool, error) {
+		output, err := o.ec2.DescribeNatGatewaysWithContext(ctx, &ec2.DescribeNatGatewaysInput{NatGatewayIds: []*string{aws.String(id)}})
+		if err != nil {
+			return false, err
+		}
+		for _, natGateway := range output.NatGateways {
+			switch aws.StringValue(natGateway.State) {
+			case ec2.NatGatewayStateDeleted:
+			case ec2.NatGatewayStateFailed:
+				return false, fmt.Errorf("nat gateway %s failed: %s", id, aws.StringValue(natGateway.FailureMessage))
+			default:
+				return false, nil
+			}
+		}
+		return true, nil
+	}, ctx.Done())
+}
+
+// deleteRouteTable removes all subnet associations of the route table with the given name and deletes it.
+func (o *operation) deleteRouteTable(ctx context.Context, name, vpcID string) error {
+	return o.deleteByTags(ctx, o.tags(name), o.describeRouteTables, func(id string) error {
+		routeTable, err := o.getRouteTable(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, association := range routeTable.Associations {
+			if aws.BoolValue(association.Main) {
+				continue
+			}
+			if _, err := o.ec2.DisassociateRouteTableWithContext(ctx, &ec2.DisassociateRouteTableInput{AssociationId: association.RouteTableAssociationId}); err != nil {
+				return err
+			}
+		}
+		_, err = o.ec2.DeleteRouteTableWithContext(ctx, &ec2.DeleteRouteTableInput{RouteTableId: aws.String(id)})
+		return err
+	}, filter("vpc-id", vpcID))
+}
+
+// deleteVPC deletes the internet gateway, the VPC and the DHCP options created for the cluster.
+func (o *operation) deleteVPC(ctx context.Context, vpcID string) error {
+	if err := o.deleteByTags(ctx, o.tags(o.clusterName), o.describeInternetGateways, func(id string) error {
+		if _, err := o.ec2.DetachInternetGatewayWithContext(ctx, &ec2.DetachInternetGatewayInput{
+			InternetGatewayId: aws.String(id),
+			VpcId:             aws.String(vpcID),
+		}); err != nil && !isErrorCode(err, "Gateway.NotAttached") {
+			return err
+		}
+		_, err := o.ec2.DeleteInternetGatewayWithContext(ctx, &ec2.DeleteInternetGatewayInput{InternetGatewayId: aws.String(id)})
+		return err
+	}); err != nil {
+		return err
+	}
+
+	if _, err := o.ec2.DeleteVpcWithContext(ctx, &ec2.DeleteVpcInput{VpcId: aws.String(vpcID)}); err != nil && !isNotFoundError(err) {
+		return err
+	}
+
+	return o.deleteByTags(ctx, o.tags(o.clusterName), o.describeDHCPOptions, func(id string) error {
+		_, err := o.ec2.DeleteDhcpOptionsWithContext(ctx, &ec2.DeleteDhcpOptionsInput{DhcpOptionsId: aws.String(id)})
+		return err
+	})
+}
+
+// deleteByTags calls <deleteFn> for all resources that have the given tags and match the given filters.
+func (o *operation) deleteByTags(ctx context.Context, tags []*ec2.Tag, describe describeFunc, deleteFn func(id string) error, filters ...*ec2.Filter) error {
+	ids, err := describe(ctx, nil, append(tagFilters(tags), filters...))
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := deleteFn(id); err != nil && !isNotFoundError(err) {
+			return err
+		}
+		o.logger.Info("Deleted resource", "id", id)
+	}
+	return nil
+}
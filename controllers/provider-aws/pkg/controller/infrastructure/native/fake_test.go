@@ -0,0 +1,644 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+)
+
+// fakeResource is an EC2 resource of the fakeEC2 client.
+type fakeResource struct {
+	kind         string
+	attributes   map[string]string
+	tags         map[string]string
+	routes       []*ec2.Route
+	associations []*ec2.RouteTableAssociation
+	permissions  map[string]bool
+}
+
+// fakeEC2 is an in-memory implementation of the parts of the EC2 API used by the native reconciler. Calling any
+// other method panics.
+type fakeEC2 struct {
+	ec2iface.EC2API
+
+	nextID       int
+	resources    map[string]*fakeResource
+	keyPairs     map[string][]byte
+	clientTokens map[string]string
+
+	// createTagsError is called for each resource to be tagged. If it returns an error, tagging fails.
+	createTagsError func(id string) error
+}
+
+func newFakeEC2() *fakeEC2 {
+	return &fakeEC2{
+		resources:    map[string]*fakeResource{},
+		keyPairs:     map[string][]byte{},
+		clientTokens: map[string]string{},
+	}
+}
+
+func (f *fakeEC2) add(kind string, attributes map[string]string) string {
+	f.nextID++
+	id := fmt.Sprintf("%s-%d", kind, f.nextID)
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+	f.resources[id] = &fakeResource{kind: kind, attributes: attributes, tags: map[string]string{}, permissions: map[string]bool{}}
+	return id
+}
+
+// ids returns the ids of the resources of the given kind that are not deleted.
+func (f *fakeEC2) ids(kind string) []string {
+	var out []string
+	for id, resource := range f.resources {
+		if resource.kind == kind && resource.attributes["state"] != ec2.NatGatewayStateDeleted {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func (f *fakeEC2) describe(kind string, ids []*string, filters []*ec2.Filter) ([]string, error) {
+	var out []string
+	if len(ids) > 0 {
+		for _, id := range ids {
+			resource, ok := f.resources[aws.StringValue(id)]
+			if !ok || resource.kind != kind {
+				return nil, awserr.New(kind+".NotFound", "not found", nil)
+			}
+			if matches(resource, filters) {
+				out = append(out, aws.StringValue(id))
+			}
+		}
+		return out, nil
+	}
+
+	for id, resource := range f.resources {
+		if resource.kind == kind && matches(resource, filters) {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+func matches(resource *fakeResource, filters []*ec2.Filter) bool {
+	for _, filter := range filters {
+		name := aws.StringValue(filter.Name)
+		value, ok := resource.attributes[name]
+		if strings.HasPrefix(name, "tag:") {
+			value, ok = resource.tags[strings.TrimPrefix(name, "tag:")]
+		}
+		if !ok || !contains(aws.StringValueSlice(filter.Values), value) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeEC2) get(kind, id string) (*fakeResource, error) {
+	resource, ok := f.resources[id]
+	if !ok || resource.kind != kind {
+		return nil, awserr.New(kind+".NotFound", "not found", nil)
+	}
+	return resource, nil
+}
+
+func (f *fakeEC2) delete(kind, id string) error {
+	if _, err := f.get(kind, id); err != nil {
+		return err
+	}
+	for otherID, other := range f.resources {
+		for _, value := range other.attributes {
+			if value == id && other.attributes["state"] != ec2.NatGatewayStateDeleted {
+				return awserr.New("DependencyViolation", fmt.Sprintf("%s has a dependent object %s", id, otherID), nil)
+			}
+		}
+	}
+	delete(f.resources, id)
+	return nil
+}
+
+func (f *fakeEC2) CreateTagsWithContext(_ aws.Context, input *ec2.CreateTagsInput, _ ...request.Option) (*ec2.CreateTagsOutput, error) {
+	for _, id := range input.Resources {
+		resource, ok := f.resources[aws.StringValue(id)]
+		if !ok {
+			return nil, awserr.New("InvalidID", "not found", nil)
+		}
+		if f.createTagsError != nil {
+			if err := f.createTagsError(aws.StringValue(id)); err != nil {
+				return nil, err
+			}
+		}
+		for _, tag := range input.Tags {
+			resource.tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+	return &ec2.CreateTagsOutput{}, nil
+}
+
+func (f *fakeEC2) DescribeDhcpOptionsWithContext(_ aws.Context, input *ec2.DescribeDhcpOptionsInput, _ ...request.Option) (*ec2.DescribeDhcpOptionsOutput, error) {
+	ids, err := f.describe("dopt", input.DhcpOptionsIds, input.Filters)
+	output := &ec2.DescribeDhcpOptionsOutput{}
+	for _, id := range ids {
+		output.DhcpOptions = append(output.DhcpOptions, &ec2.DhcpOptions{DhcpOptionsId: aws.String(id)})
+	}
+	return output, err
+}
+
+func (f *fakeEC2) CreateDhcpOptionsWithContext(_ aws.Context, _ *ec2.CreateDhcpOptionsInput, _ ...request.Option) (*ec2.CreateDhcpOptionsOutput, error) {
+	return &ec2.CreateDhcpOptionsOutput{DhcpOptions: &ec2.DhcpOptions{DhcpOptionsId: aws.String(f.add("dopt", nil))}}, nil
+}
+
+func (f *fakeEC2) DeleteDhcpOptionsWithContext(_ aws.Context, input *ec2.DeleteDhcpOptionsInput, _ ...request.Option) (*ec2.DeleteDhcpOptionsOutput, error) {
+	return &ec2.DeleteDhcpOptionsOutput{}, f.delete("dopt", aws.StringValue(input.DhcpOptionsId))
+}
+
+func (f *fakeEC2) DescribeVpcsWithContext(_ aws.Context, input *ec2.DescribeVpcsInput, _ ...request.Option) (*ec2.DescribeVpcsOutput, error) {
+	ids, err := f.describe("vpc", input.VpcIds, input.Filters)
+	output := &ec2.DescribeVpcsOutput{}
+	for _, id := range ids {
+		output.Vpcs = append(output.Vpcs, &ec2.Vpc{VpcId: aws.String(id)})
+	}
+	return output, err
+}
+
+func (f *fakeEC2) CreateVpcWithContext(_ aws.Context, input *ec2.CreateVpcInput, _ ...request.Option) (*ec2.CreateVpcOutput, error) {
+	return &ec2.CreateVpcOutput{Vpc: &ec2.Vpc{VpcId: aws.String(f.add("vpc", map[string]string{"cidr": aws.StringValue(input.CidrBlock)}))}}, nil
+}
+
+func (f *fakeEC2) ModifyVpcAttributeWithContext(_ aws.Context, input *ec2.ModifyVpcAttributeInput, _ ...request.Option) (*ec2.ModifyVpcAttributeOutput, error) {
+	_, err := f.get("vpc", aws.StringValue(input.VpcId))
+	return &ec2.ModifyVpcAttributeOutput{}, err
+}
+
+func (f *fakeEC2) AssociateDhcpOptionsWithContext(_ aws.Context, input *ec2.AssociateDhcpOptionsInput, _ ...request.Option) (*ec2.AssociateDhcpOptionsOutput, error) {
+	vpc, err := f.get("vpc", aws.StringValue(input.VpcId))
+	if err != nil {
+		return nil, err
+	}
+	vpc.attributes["dhcp-options-id"] = aws.StringValue(input.DhcpOptionsId)
+	return &ec2.AssociateDhcpOptionsOutput{}, nil
+}
+
+func (f *fakeEC2) DeleteVpcWithContext(_ aws.Context, input *ec2.DeleteVpcInput, _ ...request.Option) (*ec2.DeleteVpcOutput, error) {
+	vpc, err := f.get("vpc", aws.StringValue(input.VpcId))
+	if err != nil {
+		return nil, err
+	}
+	// The DHCP options do not block the deletion of the VPC.
+	delete(vpc.attributes, "dhcp-options-id")
+	return &ec2.DeleteVpcOutput{}, f.delete("vpc", aws.StringValue(input.VpcId))
+}
+
+func (f *fakeEC2) DescribeInternetGatewaysWithContext(_ aws.Context, input *ec2.DescribeInternetGatewaysInput, _ ...request.Option) (*ec2.DescribeInternetGatewaysOutput, error) {
+	ids, err := f.describe("igw", input.InternetGatewayIds, input.Filters)
+	output := &ec2.DescribeInternetGatewaysOutput{}
+	for _, id := range ids {
+		internetGateway := &ec2.InternetGateway{InternetGatewayId: aws.String(id)}
+		if vpcID, ok := f.resources[id].attributes["attachment.vpc-id"]; ok {
+			internetGateway.Attachments = []*ec2.InternetGatewayAttachment{{VpcId: aws.String(vpcID)}}
+		}
+		output.InternetGateways = append(output.InternetGateways, internetGateway)
+	}
+	return output, err
+}
+
+func (f *fakeEC2) CreateInternetGatewayWithContext(_ aws.Context, _ *ec2.CreateInternetGatewayInput, _ ...request.Option) (*ec2.CreateInternetGatewayOutput, error) {
+	return &ec2.CreateInternetGatewayOutput{InternetGateway: &ec2.InternetGateway{InternetGatewayId: aws.String(f.add("igw", nil))}}, nil
+}
+
+func (f *fakeEC2) AttachInternetGatewayWithContext(_ aws.Context, input *ec2.AttachInternetGatewayInput, _ ...request.Option) (*ec2.AttachInternetGatewayOutput, error) {
+	internetGateway, err := f.get("igw", aws.StringValue(input.InternetGatewayId))
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := internetGateway.attributes["attachment.vpc-id"]; ok {
+		return nil, awserr.New("Resource.AlreadyAssociated", "already attached", nil)
+	}
+	internetGateway.attributes["attachment.vpc-id"] = aws.StringValue(input.VpcId)
+	return &ec2.AttachInternetGatewayOutput{}, nil
+}
+
+func (f *fakeEC2) DetachInternetGatewayWithContext(_ aws.Context, input *ec2.DetachInternetGatewayInput, _ ...request.Option) (*ec2.DetachInternetGatewayOutput, error) {
+	internetGateway, err := f.get("igw", aws.StringValue(input.InternetGatewayId))
+	if err != nil {
+		return nil, err
+	}
+	delete(internetGateway.attributes, "attachment.vpc-id")
+	return &ec2.DetachInternetGatewayOutput{}, nil
+}
+
+func (f *fakeEC2) DeleteInternetGatewayWithContext(_ aws.Context, input *ec2.DeleteInternetGatewayInput, _ ...request.Option) (*ec2.DeleteInternetGatewayOutput, error) {
+	return &ec2.DeleteInternetGatewayOutput{}, f.delete("igw", aws.StringValue(input.InternetGatewayId))
+}
+
+func (f *fakeEC2) DescribeRouteTablesWithContext(_ aws.Context, input *ec2.DescribeRouteTablesInput, _ ...request.Option) (*ec2.DescribeRouteTablesOutput, error) {
+	ids, err := f.describe("rtb", input.RouteTableIds, input.Filters)
+	output := &ec2.DescribeRouteTablesOutput{}
+	for _, id := range ids {
+		output.RouteTables = append(output.RouteTables, &ec2.RouteTable{
+			RouteTableId: aws.String(id),
+			Routes:       f.resources[id].routes,
+			Associations: f.resources[id].associations,
+		})
+	}
+	return output, err
+}
+
+func (f *fakeEC2) CreateRouteTableWithContext(_ aws.Context, input *ec2.CreateRouteTableInput, _ ...request.Option) (*ec2.CreateRouteTableOutput, error) {
+	id := f.add("rtb", map[string]string{"vpc-id": aws.StringValue(input.VpcId)})
+	return &ec2.CreateRouteTableOutput{RouteTable: &ec2.RouteTable{RouteTableId: aws.String(id)}}, nil
+}
+
+func (f *fakeEC2) CreateRouteWithContext(_ aws.Context, input *ec2.CreateRouteInput, _ ...request.Option) (*ec2.CreateRouteOutput, error) {
+	routeTable, err := f.get("rtb", aws.StringValue(input.RouteTableId))
+	if err != nil {
+		return nil, err
+	}
+	for _, route := range routeTable.routes {
+		if aws.StringValue(route.DestinationCidrBlock) == aws.StringValue(input.DestinationCidrBlock) {
+			return nil, awserr.New("RouteAlreadyExists", "route exists", nil)
+		}
+	}
+	routeTable.routes = append(routeTable.routes, &ec2.Route{
+		DestinationCidrBlock: input.DestinationCidrBlock,
+		GatewayId:            input.GatewayId,
+		NatGatewayId:         input.NatGatewayId,
+	})
+	return &ec2.CreateRouteOutput{}, nil
+}
+
+func (f *fakeEC2) ReplaceRouteWithContext(_ aws.Context, input *ec2.ReplaceRouteInput, _ ...request.Option) (*ec2.ReplaceRouteOutput, error) {
+	routeTable, err := f.get("rtb", aws.StringValue(input.RouteTableId))
+	if err != nil {
+		return nil, err
+	}
+	for _, route := range routeTable.routes {
+		if aws.StringValue(route.DestinationCidrBlock) == aws.StringValue(input.DestinationCidrBlock) {
+			route.GatewayId, route.NatGatewayId = input.GatewayId, input.NatGatewayId
+			return &ec2.ReplaceRouteOutput{}, nil
+		}
+	}
+	return nil, awserr.New("InvalidRoute.NotFound", "route not found", nil)
+}
+
+func (f *fakeEC2) AssociateRouteTableWithContext(_ aws.Context, input *ec2.AssociateRouteTableInput, _ ...request.Option) (*ec2.AssociateRouteTableOutput, error) {
+	routeTable, err := f.get("rtb", aws.StringValue(input.RouteTableId))
+	if err != nil {
+		return nil, err
+	}
+	associationID := f.add("rtbassoc", map[string]string{"route-table-id": aws.StringValue(input.RouteTableId), "subnet-id": aws.StringValue(input.SubnetId)})
+	routeTable.associations = append(routeTable.associations, &ec2.RouteTableAssociation{
+		RouteTableAssociationId: aws.String(associationID),
+		SubnetId:                input.SubnetId,
+	})
+	return &ec2.AssociateRouteTableOutput{AssociationId: aws.String(associationID)}, nil
+}
+
+func (f *fakeEC2) DisassociateRouteTableWithContext(_ aws.Context, input *ec2.DisassociateRouteTableInput, _ ...request.Option) (*ec2.DisassociateRouteTableOutput, error) {
+	association, err := f.get("rtbassoc", aws.StringValue(input.AssociationId))
+	if err != nil {
+		return nil, err
+	}
+	routeTable := f.resources[association.attributes["route-table-id"]]
+	for i, a := range routeTable.associations {
+		if aws.StringValue(a.RouteTableAssociationId) == aws.StringValue(input.AssociationId) {
+			routeTable.associations = append(routeTable.associations[:i], routeTable.associations[i+1:]...)
+			break
+		}
+	}
+	delete(f.resources, aws.StringValue(input.AssociationId))
+	return &ec2.DisassociateRouteTableOutput{}, nil
+}
+
+func (f *fakeEC2) DeleteRouteTableWithContext(_ aws.Context, input *ec2.DeleteRouteTableInput, _ ...request.Option) (*ec2.DeleteRouteTableOutput, error) {
+	return &ec2.DeleteRouteTableOutput{}, f.delete("rtb", aws.StringValue(input.RouteTableId))
+}
+
+func (f *fakeEC2) DescribeSubnetsWithContext(_ aws.Context, input *ec2.DescribeSubnetsInput, _ ...request.Option) (*ec2.DescribeSubnetsOutput, error) {
+	ids, err := f.describe("subnet", input.SubnetIds, input.Filters)
+	output := &ec2.DescribeSubnetsOutput{}
+	for _, id := range ids {
+		output.Subnets = append(output.Subnets, &ec2.Subnet{SubnetId: aws.String(id)})
+	}
+	return output, err
+}
+
+func (f *fakeEC2) CreateSubnetWithContext(_ aws.Context, input *ec2.CreateSubnetInput, _ ...request.Option) (*ec2.CreateSubnetOutput, error) {
+	id := f.add("subnet", map[string]string{
+		"vpc-id":            aws.StringValue(input.VpcId),
+		"cidr":              aws.StringValue(input.CidrBlock),
+		"availability-zone": aws.StringValue(input.AvailabilityZone),
+	})
+	return &ec2.CreateSubnetOutput{Subnet: &ec2.Subnet{SubnetId: aws.String(id)}}, nil
+}
+
+func (f *fakeEC2) DeleteSubnetWithContext(_ aws.Context, input *ec2.DeleteSubnetInput, _ ...request.Option) (*ec2.DeleteSubnetOutput, error) {
+	id := aws.StringValue(input.SubnetId)
+	// Deleting a subnet removes its route table associations.
+	for associationID, association := range f.resources {
+		if association.kind == "rtbassoc" && association.attributes["subnet-id"] == id {
+			if _, err := f.DisassociateRouteTableWithContext(nil, &ec2.DisassociateRouteTableInput{AssociationId: aws.String(associationID)}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &ec2.DeleteSubnetOutput{}, f.delete("subnet", id)
+}
+
+func (f *fakeEC2) DescribeAddressesWithContext(_ aws.Context, input *ec2.DescribeAddressesInput, _ ...request.Option) (*ec2.DescribeAddressesOutput, error) {
+	ids, err := f.describe("eipalloc", input.AllocationIds, input.Filters)
+	output := &ec2.DescribeAddressesOutput{}
+	for _, id := range ids {
+		output.Addresses = append(output.Addresses, &ec2.Address{AllocationId: aws.String(id)})
+	}
+	return output, err
+}
+
+func (f *fakeEC2) AllocateAddressWithContext(_ aws.Context, _ *ec2.AllocateAddressInput, _ ...request.Option) (*ec2.AllocateAddressOutput, error) {
+	return &ec2.AllocateAddressOutput{AllocationId: aws.String(f.add("eipalloc", nil))}, nil
+}
+
+func (f *fakeEC2) ReleaseAddressWithContext(_ aws.Context, input *ec2.ReleaseAddressInput, _ ...request.Option) (*ec2.ReleaseAddressOutput, error) {
+	return &ec2.ReleaseAddressOutput{}, f.delete("eipalloc", aws.StringValue(input.AllocationId))
+}
+
+func (f *fakeEC2) DescribeNatGatewaysWithContext(_ aws.Context, input *ec2.DescribeNatGatewaysInput, _ ...request.Option) (*ec2.DescribeNatGatewaysOutput, error) {
+	ids, err := f.describe("nat", input.NatGatewayIds, input.Filter)
+	output := &ec2.DescribeNatGatewaysOutput{}
+	for _, id := range ids {
+		output.NatGateways = append(output.NatGateways, &ec2.NatGateway{
+			NatGatewayId: aws.String(id),
+			State:        aws.String(f.resources[id].attributes["state"]),
+		})
+	}
+	return output, err
+}
+
+func (f *fakeEC2) CreateNatGatewayWithContext(_ aws.Context, input *ec2.CreateNatGatewayInput, _ ...request.Option) (*ec2.CreateNatGatewayOutput, error) {
+	id, ok := f.clientTokens[aws.StringValue(input.ClientToken)]
+	if !ok {
+		id = f.add("nat", map[string]string{
+			"state":         ec2.NatGatewayStatePending,
+			"allocation-id": aws.StringValue(input.AllocationId),
+			"subnet-id":     aws.StringValue(input.SubnetId),
+		})
+		if input.ClientToken != nil {
+			f.clientTokens[*input.ClientToken] = id
+		}
+	}
+	return &ec2.CreateNatGatewayOutput{NatGateway: &ec2.NatGateway{NatGatewayId: aws.String(id)}}, nil
+}
+
+func (f *fakeEC2) WaitUntilNatGatewayAvailableWithContext(_ aws.Context, input *ec2.DescribeNatGatewaysInput, _ ...request.WaiterOption) error {
+	for _, id := range input.NatGatewayIds {
+		natGateway, err := f.get("nat", aws.StringValue(id))
+		if err != nil {
+			return err
+		}
+		natGateway.attributes["state"] = ec2.NatGatewayStateAvailable
+	}
+	return nil
+}
+
+func (f *fakeEC2) DeleteNatGatewayWithContext(_ aws.Context, input *ec2.DeleteNatGatewayInput, _ ...request.Option) (*ec2.DeleteNatGatewayOutput, error) {
+	natGateway, err := f.get("nat", aws.StringValue(input.NatGatewayId))
+	if err != nil {
+		return nil, err
+	}
+	// Deleted NAT gateways are still visible for a while.
+	natGateway.attributes["state"] = ec2.NatGatewayStateDeleted
+	return &ec2.DeleteNatGatewayOutput{}, nil
+}
+
+func (f *fakeEC2) DescribeSecurityGroupsWithContext(_ aws.Context, input *ec2.DescribeSecurityGroupsInput, _ ...request.Option) (*ec2.DescribeSecurityGroupsOutput, error) {
+	ids, err := f.describe("sg", input.GroupIds, input.Filters)
+	output := &ec2.DescribeSecurityGroupsOutput{}
+	for _, id := range ids {
+		output.SecurityGroups = append(output.SecurityGroups, &ec2.SecurityGroup{GroupId: aws.String(id)})
+	}
+	return output, err
+}
+
+func (f *fakeEC2) CreateSecurityGroupWithContext(_ aws.Context, input *ec2.CreateSecurityGroupInput, _ ...request.Option) (*ec2.CreateSecurityGroupOutput, error) {
+	if ids, _ := f.describe("sg", nil, []*ec2.Filter{
+		{Name: aws.String("group-name"), Values: []*string{input.GroupName}},
+		{Name: aws.String("vpc-id"), Values: []*string{input.VpcId}},
+	}); len(ids) > 0 {
+		return nil, awserr.New("InvalidGroup.Duplicate", "group exists", nil)
+	}
+	id := f.add("sg", map[string]string{"vpc-id": aws.StringValue(input.VpcId), "group-name": aws.StringValue(input.GroupName)})
+	// New security groups allow all egress traffic.
+	f.resources[id].permissions["egress -1 0.0.0.0/0"] = true
+	return &ec2.CreateSecurityGroupOutput{GroupId: aws.String(id)}, nil
+}
+
+func (f *fakeEC2) authorize(direction string, groupID *string, permissions []*ec2.IpPermission) error {
+	securityGroup, err := f.get("sg", aws.StringValue(groupID))
+	if err != nil {
+		return err
+	}
+	for _, permission := range permissions {
+		key := permissionKey(direction, permission)
+		if securityGroup.permissions[key] {
+			return awserr.New("InvalidPermission.Duplicate", "rule exists", nil)
+		}
+		securityGroup.permissions[key] = true
+	}
+	return nil
+}
+
+func permissionKey(direction string, permission *ec2.IpPermission) string {
+	key := fmt.Sprintf("%s %s", direction, aws.StringValue(permission.IpProtocol))
+	if permission.FromPort != nil {
+		key += fmt.Sprintf(" %d-%d", aws.Int64Value(permission.FromPort), aws.Int64Value(permission.ToPort))
+	}
+	for _, ipRange := range permission.IpRanges {
+		key += " " + aws.StringValue(ipRange.CidrIp)
+	}
+	for _, pair := range permission.UserIdGroupPairs {
+		key += " " + aws.StringValue(pair.GroupId)
+	}
+	return key
+}
+
+func (f *fakeEC2) AuthorizeSecurityGroupIngressWithContext(_ aws.Context, input *ec2.AuthorizeSecurityGroupIngressInput, _ ...request.Option) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+	return &ec2.AuthorizeSecurityGroupIngressOutput{}, f.authorize("ingress", input.GroupId, input.IpPermissions)
+}
+
+func (f *fakeEC2) AuthorizeSecurityGroupEgressWithContext(_ aws.Context, input *ec2.AuthorizeSecurityGroupEgressInput, _ ...request.Option) (*ec2.AuthorizeSecurityGroupEgressOutput, error) {
+	return &ec2.AuthorizeSecurityGroupEgressOutput{}, f.authorize("egress", input.GroupId, input.IpPermissions)
+}
+
+func (f *fakeEC2) DeleteSecurityGroupWithContext(_ aws.Context, input *ec2.DeleteSecurityGroupInput, _ ...request.Option) (*ec2.DeleteSecurityGroupOutput, error) {
+	id := aws.StringValue(input.GroupId)
+	for otherID, other := range f.resources {
+		if other.kind != "sg" || otherID == id {
+			continue
+		}
+		for key := range other.permissions {
+			if strings.HasSuffix(key, " "+id) {
+				return nil, awserr.New("DependencyViolation", fmt.Sprintf("%s is referenced by %s", id, otherID), nil)
+			}
+		}
+	}
+	return &ec2.DeleteSecurityGroupOutput{}, f.delete("sg", id)
+}
+
+func (f *fakeEC2) DescribeKeyPairsWithContext(_ aws.Context, input *ec2.DescribeKeyPairsInput, _ ...request.Option) (*ec2.DescribeKeyPairsOutput, error) {
+	output := &ec2.DescribeKeyPairsOutput{}
+	for _, name := range input.KeyNames {
+		if _, ok := f.keyPairs[aws.StringValue(name)]; !ok {
+			return nil, awserr.New("InvalidKeyPair.NotFound", "not found", nil)
+		}
+		output.KeyPairs = append(output.KeyPairs, &ec2.KeyPairInfo{KeyName: name})
+	}
+	return output, nil
+}
+
+func (f *fakeEC2) ImportKeyPairWithContext(_ aws.Context, input *ec2.ImportKeyPairInput, _ ...request.Option) (*ec2.ImportKeyPairOutput, error) {
+	f.keyPairs[aws.StringValue(input.KeyName)] = input.PublicKeyMaterial
+	return &ec2.ImportKeyPairOutput{KeyName: input.KeyName}, nil
+}
+
+func (f *fakeEC2) DeleteKeyPairWithContext(_ aws.Context, input *ec2.DeleteKeyPairInput, _ ...request.Option) (*ec2.DeleteKeyPairOutput, error) {
+	delete(f.keyPairs, aws.StringValue(input.KeyName))
+	return &ec2.DeleteKeyPairOutput{}, nil
+}
+
+// fakeIAM is an in-memory implementation of the parts of the IAM API used by the native reconciler. Calling any
+// other method panics.
+type fakeIAM struct {
+	iamiface.IAMAPI
+
+	roles            map[string]*iam.Role
+	rolePolicies     map[string]string
+	instanceProfiles map[string]*iam.InstanceProfile
+}
+
+func newFakeIAM() *fakeIAM {
+	return &fakeIAM{
+		roles:            map[string]*iam.Role{},
+		rolePolicies:     map[string]string{},
+		instanceProfiles: map[string]*iam.InstanceProfile{},
+	}
+}
+
+func noSuchEntity() error {
+	return awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil)
+}
+
+func (f *fakeIAM) GetRoleWithContext(_ aws.Context, input *iam.GetRoleInput, _ ...request.Option) (*iam.GetRoleOutput, error) {
+	role, ok := f.roles[aws.StringValue(input.RoleName)]
+	if !ok {
+		return nil, noSuchEntity()
+	}
+	return &iam.GetRoleOutput{Role: role}, nil
+}
+
+func (f *fakeIAM) CreateRoleWithContext(_ aws.Context, input *iam.CreateRoleInput, _ ...request.Option) (*iam.CreateRoleOutput, error) {
+	role := &iam.Role{RoleName: input.RoleName, Arn: aws.String("arn:aws:iam::123456789012:role/" + aws.StringValue(input.RoleName))}
+	f.roles[aws.StringValue(input.RoleName)] = role
+	return &iam.CreateRoleOutput{Role: role}, nil
+}
+
+func (f *fakeIAM) DeleteRoleWithContext(_ aws.Context, input *iam.DeleteRoleInput, _ ...request.Option) (*iam.DeleteRoleOutput, error) {
+	name := aws.StringValue(input.RoleName)
+	if _, ok := f.roles[name]; !ok {
+		return nil, noSuchEntity()
+	}
+	if _, ok := f.rolePolicies[name]; ok {
+		return nil, awserr.New(iam.ErrCodeDeleteConflictException, "role has policies", nil)
+	}
+	delete(f.roles, name)
+	return &iam.DeleteRoleOutput{}, nil
+}
+
+func (f *fakeIAM) PutRolePolicyWithContext(_ aws.Context, input *iam.PutRolePolicyInput, _ ...request.Option) (*iam.PutRolePolicyOutput, error) {
+	f.rolePolicies[aws.StringValue(input.RoleName)] = aws.StringValue(input.PolicyDocument)
+	return &iam.PutRolePolicyOutput{}, nil
+}
+
+func (f *fakeIAM) DeleteRolePolicyWithContext(_ aws.Context, input *iam.DeleteRolePolicyInput, _ ...request.Option) (*iam.DeleteRolePolicyOutput, error) {
+	if _, ok := f.rolePolicies[aws.StringValue(input.RoleName)]; !ok {
+		return nil, noSuchEntity()
+	}
+	delete(f.rolePolicies, aws.StringValue(input.RoleName))
+	return &iam.DeleteRolePolicyOutput{}, nil
+}
+
+func (f *fakeIAM) GetInstanceProfileWithContext(_ aws.Context, input *iam.GetInstanceProfileInput, _ ...request.Option) (*iam.GetInstanceProfileOutput, error) {
+	instanceProfile, ok := f.instanceProfiles[aws.StringValue(input.InstanceProfileName)]
+	if !ok {
+		return nil, noSuchEntity()
+	}
+	return &iam.GetInstanceProfileOutput{InstanceProfile: instanceProfile}, nil
+}
+
+func (f *fakeIAM) CreateInstanceProfileWithContext(_ aws.Context, input *iam.CreateInstanceProfileInput, _ ...request.Option) (*iam.CreateInstanceProfileOutput, error) {
+	instanceProfile := &iam.InstanceProfile{InstanceProfileName: input.InstanceProfileName}
+	f.instanceProfiles[aws.StringValue(input.InstanceProfileName)] = instanceProfile
+	return &iam.CreateInstanceProfileOutput{InstanceProfile: instanceProfile}, nil
+}
+
+func (f *fakeIAM) AddRoleToInstanceProfileWithContext(_ aws.Context, input *iam.AddRoleToInstanceProfileInput, _ ...request.Option) (*iam.AddRoleToInstanceProfileOutput, error) {
+	instanceProfile, ok := f.instanceProfiles[aws.StringValue(input.InstanceProfileName)]
+	if !ok {
+		return nil, noSuchEntity()
+	}
+	instanceProfile.Roles = append(instanceProfile.Roles, &iam.Role{RoleName: input.RoleName})
+	return &iam.AddRoleToInstanceProfileOutput{}, nil
+}
+
+func (f *fakeIAM) RemoveRoleFromInstanceProfileWithContext(_ aws.Context, input *iam.RemoveRoleFromInstanceProfileInput, _ ...request.Option) (*iam.RemoveRoleFromInstanceProfileOutput, error) {
+	instanceProfile, ok := f.instanceProfiles[aws.StringValue(input.InstanceProfileName)]
+	if !ok {
+		return nil, noSuchEntity()
+	}
+	instanceProfile.Roles = nil
+	return &iam.RemoveRoleFromInstanceProfileOutput{}, nil
+}
+
+func (f *fakeIAM) DeleteInstanceProfileWithContext(_ aws.Context, input *iam.DeleteInstanceProfileInput, _ ...request.Option) (*iam.DeleteInstanceProfileOutput, error) {
+	name := aws.StringValue(input.InstanceProfileName)
+	instanceProfile, ok := f.instanceProfiles[name]
+	if !ok {
+		return nil, noSuchEntity()
+	}
+	if len(instanceProfile.Roles) > 0 {
+		return nil, awserr.New(iam.ErrCodeDeleteConflictException, "instance profile has roles", nil)
+	}
+	delete(f.instanceProfiles, name)
+	return &iam.DeleteInstanceProfileOutput{}, nil
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+const (
+	assumeRolePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+	bastionsRolePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:DescribeRegions"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}`
+
+	nodesRolePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:DescribeInstances"
+      ],
+      "Resource": [
+        "*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ecr:GetAuthorizationToken",
+        "ecr:BatchCheckLayerAvailability",
+        "ecr:GetDownloadUrlForLayer",
+        "ecr:GetRepositoryPolicy",
+        "ecr:DescribeRepositories",
+        "ecr:ListImages",
+        "ecr:BatchGetImage"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}`
+)
+
+// ensureIAM ensures the role, its inline policy and the instance profile with the given purpose. All of them
+// share the same name. It returns the name of the instance profile and the ARN of the role.
+func (o *operation) ensureIAM(ctx context.Context, purpose, policy string) (string, string, error) {
+	name := o.name(purpose)
+
+	roleARN, err := o.ensureRole(ctx, name)
+	if err != nil {
+		return "", "", err
+	}
+
+	if _, err := o.iam.PutRolePolicyWithContext(ctx, &iam.PutRolePolicyInput{
+		RoleName:       aws.String(name),
+		PolicyName:     aws.String(name),
+		PolicyDocument: aws.String(policy),
+	}); err != nil {
+		return "", "", err
+	}
+
+	if err := o.ensureInstanceProfile(ctx, name, name); err != nil {
+		return "", "", err
+	}
+	return name, roleARN, nil
+}
+
+func (o *operation) ensureRole(ctx context.Context, name string) (string, error) {
+	getOutput, err := o.iam.GetRoleWithContext(ctx, &iam.GetRoleInput{RoleName: aws.String(name)})
+	if err == nil {
+		return aws.StringValue(getOutput.Role.Arn), nil
+	}
+	if !isErrorCode(err, iam.ErrCodeNoSuchEntityException) {
+		return "", err
+	}
+
+	createOutput, err := o.iam.CreateRoleWithContext(ctx, &iam.CreateRoleInput{
+		RoleName:                 aws.String(name),
+		Path:                     aws.String("/"),
+		AssumeRolePolicyDocument: aws.String(assumeRolePolicy),
+	})
+	if err != nil {
+		return "", err
+	}
+	o.logger.Info("Created iam role", "name", name)
+	return aws.StringValue(createOutput.Role.Arn), nil
+}
+
+func (o *operation) ensureInstanceProfile(ctx context.Context, name, roleName string) error {
+	var instanceProfile *iam.InstanceProfile
+
+	getOutput, err := o.iam.GetInstanceProfileWithContext(ctx, &iam.GetInstanceProfileInput{InstanceProfileName: aws.String(name)})
+	switch {
+	case err == nil:
+		instanceProfile = getOutput.InstanceProfile
+	case isErrorCode(err, iam.ErrCodeNoSuchEntityException):
+		createOutput, err := o.iam.CreateInstanceProfileWithContext(ctx, &iam.CreateInstanceProfileInput{
+			InstanceProfileName: aws.String(name),
+			Path:                aws.String("/"),
+		})
+		if err != nil {
+			return err
+		}
+		o.logger.Info("Created iam instance profile", "name", name)
+		instanceProfile = createOutput.InstanceProfile
+	default:
+		return err
+	}
+
+	for _, role := range instanceProfile.Roles {
+		if aws.StringValue(role.RoleName) == roleName {
+			return nil
+		}
+	}
+
+	_, err = o.iam.AddRoleToInstanceProfileWithContext(ctx, &iam.AddRoleToInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+		RoleName:            aws.String(roleName),
+	})
+	return err
+}
+
+// ensureKeyPair ensures the key pair with the given public key and returns its name. An existing key pair is not
+// updated if the public key changed.
+func (o *operation) ensureKeyPair(ctx context.Context, publicKey []byte) (string, error) {
+	name := o.name("ssh-publickey")
+
+	_, err := o.ec2.DescribeKeyPairsWithContext(ctx, &ec2.DescribeKeyPairsInput{KeyNames: []*string{aws.String(name)}})
+	if err == nil {
+		return name, nil
+	}
+	if !isNotFoundError(err) {
+		return "", err
+	}
+
+	if _, err := o.ec2.ImportKeyPairWithContext(ctx, &ec2.ImportKeyPairInput{
+		KeyName:           aws.String(name),
+		PublicKeyMaterial: publicKey,
+	}); err != nil {
+		return "", err
+	}
+	o.logger.Info("Imported key pair", "name", name)
+	return name, nil
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+)
+
+// ImportedIDs maps the addresses of resources of the Terraform configuration, e.g. 'aws_subnet.nodes_z0', to the
+// ids of the resources that have been created by Terraform.
+type ImportedIDs map[string]string
+
+// ImportFromTerraformState returns the ids of all managed resources of the root module of the given Terraform
+// state. This allows the Reconciler to take over the resources of an Infrastructure that has been reconciled with
+// Terraform before.
+func ImportFromTerraformState(state *extensionsterraformer.State) ImportedIDs {
+	imported := ImportedIDs{}
+	for _, resource := range state.Resources {
+		if resource.Module != "" || resource.Mode != extensionsterraformer.ResourceModeManaged || resource.ID == "" {
+			continue
+		}
+		imported[resource.Address()] = resource.ID
+	}
+	return imported
+}
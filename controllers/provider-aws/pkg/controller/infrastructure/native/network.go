@@ -0,0 +1,454 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"context"
+	"fmt"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	// allCIDR is the destination of the default routes.
+	allCIDR = "0.0.0.0/0"
+)
+
+// ensureVPC ensures the VPC with its DHCP options and internet gateway. If the InfrastructureConfig refers to an
+// existing VPC, only its internet gateway is looked up. It returns the ids of the VPC and the internet gateway.
+func (o *operation) ensureVPC(ctx context.Context) (string, string, error) {
+	if id := o.config.Networks.VPC.ID; id != nil {
+		internetGatewayIDs, err := o.describeInternetGateways(ctx, nil, []*ec2.Filter{filter("attachment.vpc-id", *id)})
+		if err != nil {
+			return "", "", err
+		}
+		if len(internetGatewayIDs) == 0 {
+			return "", "", fmt.Errorf("no internet gateway is attached to vpc %s", *id)
+		}
+		return *id, internetGatewayIDs[0], nil
+	}
+
+	dhcpOptionsID, err := o.ensureDHCPOptions(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	vpcID, err := o.findVPC(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if vpcID == "" {
+		if o.config.Networks.VPC.CIDR == nil {
+			return "", "", fmt.Errorf("vpc cidr is required to create a vpc")
+		}
+		output, err := o.ec2.CreateVpcWithContext(ctx, &ec2.CreateVpcInput{CidrBlock: o.config.Networks.VPC.CIDR})
+		if err != nil {
+			return "", "", err
+		}
+		vpcID = aws.StringValue(output.Vpc.VpcId)
+		o.logger.Info("Created vpc", "id", vpcID)
+		if err := o.tagCreated(ctx, vpcID, o.tags(o.clusterName), func(ctx context.Context) error {
+			_, err := o.ec2.DeleteVpcWithContext(ctx, &ec2.DeleteVpcInput{VpcId: aws.String(vpcID)})
+			return err
+		}); err != nil {
+			return "", "", err
+		}
+	}
+
+	for _, input := range []*ec2.ModifyVpcAttributeInput{
+		{VpcId: aws.String(vpcID), EnableDnsSupport: &ec2.AttributeBooleanValue{Value: aws.Bool(true)}},
+		{VpcId: aws.String(vpcID), EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: aws.Bool(true)}},
+	} {
+		if _, err := o.ec2.ModifyVpcAttributeWithContext(ctx, input); err != nil {
+			return "", "", err
+		}
+	}
+
+	if _, err := o.ec2.AssociateDhcpOptionsWithContext(ctx, &ec2.AssociateDhcpOptionsInput{
+		DhcpOptionsId: aws.String(dhcpOptionsID),
+		VpcId:         aws.String(vpcID),
+	}); err != nil {
+		return "", "", err
+	}
+
+	internetGatewayID, err := o.ensureInternetGateway(ctx, vpcID)
+	if err != nil {
+		return "", "", err
+	}
+	return vpcID, internetGatewayID, nil
+}
+
+func (o *operation) ensureDHCPOptions(ctx context.Context) (string, error) {
+	tags := o.tags(o.clusterName)
+	id, err := o.find(ctx, "aws_vpc_dhcp_options.vpc_dhcp_options", tags, o.describeDHCPOptions)
+	if err != nil || id != "" {
+		return id, err
+	}
+
+	output, err := o.ec2.CreateDhcpOptionsWithContext(ctx, &ec2.CreateDhcpOptionsInput{
+		DhcpConfigurations: []*ec2.NewDhcpConfiguration{
+			{Key: aws.String("domain-name"), Values: aws.StringSlice([]string{dhcpDomainName(o.region)})},
+			{Key: aws.String("domain-name-servers"), Values: aws.StringSlice([]string{"AmazonProvidedDNS"})},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	id = aws.StringValue(output.DhcpOptions.DhcpOptionsId)
+	o.logger.Info("Created dhcp options", "id", id)
+	return id, o.tagCreated(ctx, id, tags, func(ctx context.Context) error {
+		_, err := o.ec2.DeleteDhcpOptionsWithContext(ctx, &ec2.DeleteDhcpOptionsInput{DhcpOptionsId: aws.String(id)})
+		return err
+	})
+}
+
+func (o *operation) describeDHCPOptions(ctx context.Context, id *string, filters []*ec2.Filter) ([]string, error) {
+	output, err := o.ec2.DescribeDhcpOptionsWithContext(ctx, &ec2.DescribeDhcpOptionsInput{DhcpOptionsIds: ids(id), Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, options := range output.DhcpOptions {
+		out = append(out, aws.StringValue(options.DhcpOptionsId))
+	}
+	return out, nil
+}
+
+// findVPC returns the id of the VPC created for the cluster or an empty string if it does not exist.
+func (o *operation) findVPC(ctx context.Context) (string, error) {
+	return o.find(ctx, "aws_vpc.vpc", o.tags(o.clusterName), o.describeVPCs)
+}
+
+func (o *operation) describeVPCs(ctx context.Context, id *string, filters []*ec2.Filter) ([]string, error) {
+	output, err := o.ec2.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{VpcIds: ids(id), Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, vpc := range output.Vpcs {
+		out = append(out, aws.StringValue(vpc.VpcId))
+	}
+	return out, nil
+}
+
+func (o *operation) ensureInternetGateway(ctx context.Context, vpcID string) (string, error) {
+	tags := o.tags(o.clusterName)
+	id, err := o.find(ctx, "aws_internet_gateway.igw", tags, o.describeInternetGateways)
+	if err != nil {
+		return "", err
+	}
+	if id == "" {
+		output, err := o.ec2.CreateInternetGatewayWithContext(ctx, &ec2.CreateInternetGatewayInput{})
+		if err != nil {
+			return "", err
+		}
+		id = aws.StringValue(output.InternetGateway.InternetGatewayId)
+		o.logger.Info("Created internet gateway", "id", id)
+		if err := o.tagCreated(ctx, id, tags, func(ctx context.Context) error {
+			_, err := o.ec2.DeleteInternetGatewayWithContext(ctx, &ec2.DeleteInternetGatewayInput{InternetGatewayId: aws.String(id)})
+			return err
+		}); err != nil {
+			return "", err
+		}
+	}
+
+	attachedIDs, err := o.describeInternetGateways(ctx, aws.String(id), []*ec2.Filter{filter("attachment.vpc-id", vpcID)})
+	if err != nil || len(attachedIDs) > 0 {
+		return id, err
+	}
+
+	_, err = o.ec2.AttachInternetGatewayWithContext(ctx, &ec2.AttachInternetGatewayInput{
+		InternetGatewayId: aws.String(id),
+		VpcId:             aws.String(vpcID),
+	})
+	return id, err
+}
+
+func (o *operation) describeInternetGateways(ctx context.Context, id *string, filters []*ec2.Filter) ([]string, error) {
+	output, err := o.ec2.DescribeInternetGatewaysWithContext(ctx, &ec2.DescribeInternetGatewaysInput{InternetGatewayIds: ids(id), Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, internetGateway := range output.InternetGateways {
+		out = append(out, aws.StringValue(internetGateway.InternetGatewayId))
+	}
+	return out, nil
+}
+
+// ensureRouteTable ensures the route table with the given name in the given VPC and returns its id.
+func (o *operation) ensureRouteTable(ctx context.Context, address, name, vpcID string) (string, error) {
+	tags := o.tags(name)
+	id, err := o.find(ctx, address, tags, o.describeRouteTables, filter("vpc-id", vpcID))
+	if err != nil || id != "" {
+		return id, err
+	}
+
+	output, err := o.ec2.CreateRouteTableWithContext(ctx, &ec2.CreateRouteTableInput{VpcId: aws.String(vpcID)})
+	if err != nil {
+		return "", err
+	}
+	id = aws.StringValue(output.RouteTable.RouteTableId)
+	o.logger.Info("Created route table", "name", name, "id", id)
+	return id, o.tagCreated(ctx, id, tags, func(ctx context.Context) error {
+		_, err := o.ec2.DeleteRouteTableWithContext(ctx, &ec2.DeleteRouteTableInput{RouteTableId: aws.String(id)})
+		return err
+	})
+}
+
+func (o *operation) describeRouteTables(ctx context.Context, id *string, filters []*ec2.Filter) ([]string, error) {
+	output, err := o.ec2.DescribeRouteTablesWithContext(ctx, &ec2.DescribeRouteTablesInput{RouteTableIds: ids(id), Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, routeTable := range output.RouteTables {
+		out = append(out, aws.StringValue(routeTable.RouteTableId))
+	}
+	return out, nil
+}
+
+func (o *operation) getRouteTable(ctx context.Context, id string) (*ec2.RouteTable, error) {
+	output, err := o.ec2.DescribeRouteTablesWithContext(ctx, &ec2.DescribeRouteTablesInput{RouteTableIds: []*string{aws.String(id)}})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.RouteTables) == 0 {
+		return nil, fmt.Errorf("route table %s not found", id)
+	}
+	return output.RouteTables[0], nil
+}
+
+// ensureRoute ensures the default route of the given route table. The target of the route is taken from <target>.
+func (o *operation) ensureRoute(ctx context.Context, routeTableID string, target *ec2.CreateRouteInput) error {
+	routeTable, err := o.getRouteTable(ctx, routeTableID)
+	if err != nil {
+		return err
+	}
+
+	for _, route := range routeTable.Routes {
+		if aws.StringValue(route.DestinationCidrBlock) != allCIDR {
+			continue
+		}
+		if aws.StringValue(route.GatewayId) == aws.StringValue(target.GatewayId) && aws.StringValue(route.NatGatewayId) == aws.StringValue(target.NatGatewayId) {
+			return nil
+		}
+		_, err := o.ec2.ReplaceRouteWithContext(ctx, &ec2.ReplaceRouteInput{
+			RouteTableId:         aws.String(routeTableID),
+			DestinationCidrBlock: aws.String(allCIDR),
+			GatewayId:            target.GatewayId,
+			NatGatewayId:         target.NatGatewayId,
+		})
+		return err
+	}
+
+	_, err = o.ec2.CreateRouteWithContext(ctx, &ec2.CreateRouteInput{
+		RouteTableId:         aws.String(routeTableID),
+		DestinationCidrBlock: aws.String(allCIDR),
+		GatewayId:            target.GatewayId,
+		NatGatewayId:         target.NatGatewayId,
+	})
+	return err
+}
+
+// ensureRouteTableAssociation ensures that the given subnet is associated with the given route table.
+func (o *operation) ensureRouteTableAssociation(ctx context.Context, routeTableID, subnetID string) error {
+	routeTable, err := o.getRouteTable(ctx, routeTableID)
+	if err != nil {
+		return err
+	}
+
+	for _, association := range routeTable.Associations {
+		if aws.StringValue(association.SubnetId) == subnetID {
+			return nil
+		}
+	}
+
+	_, err = o.ec2.AssociateRouteTableWithContext(ctx, &ec2.AssociateRouteTableInput{
+		RouteTableId: aws.String(routeTableID),
+		SubnetId:     aws.String(subnetID),
+	})
+	return err
+}
+
+// ensureZone ensures the subnets, the NAT gateway and the private route table of the zone with the given index.
+// It returns the ids of the nodes and the public subnet.
+func (o *operation) ensureZone(ctx context.Context, index int, zone awsapi.Zone, vpcID, mainRouteTableID string) (string, string, error) {
+	nodesSubnetID, err := o.ensureSubnet(ctx, fmt.Sprintf("aws_subnet.nodes_z%d", index), vpcID, zone.Workers, zone.Name, o.tags(o.zoneName("nodes", index)))
+	if err != nil {
+		return "", "", err
+	}
+
+	privateSubnetID, err := o.ensureSubnet(ctx, fmt.Sprintf("aws_subnet.private_utility_z%d", index), vpcID, zone.Internal, zone.Name, o.tags(o.zoneName("private-utility", index),
+		&ec2.Tag{Key: aws.String("kubernetes.io/role/internal-elb"), Value: aws.String("use")}))
+	if err != nil {
+		return "", "", err
+	}
+
+	publicSubnetID, err := o.ensureSubnet(ctx, fmt.Sprintf("aws_subnet.public_utility_z%d", index), vpcID, zone.Public, zone.Name, o.tags(o.zoneName("public-utility", index),
+		&ec2.Tag{Key: aws.String("kubernetes.io/role/elb"), Value: aws.String("use")}))
+	if err != nil {
+		return "", "", err
+	}
+
+	allocationID, err := o.ensureElasticIP(ctx, index)
+	if err != nil {
+		return "", "", err
+	}
+
+	natGatewayID, err := o.ensureNATGateway(ctx, index, allocationID, publicSubnetID)
+	if err != nil {
+		return "", "", err
+	}
+
+	privateRouteTableID, err := o.ensureRouteTable(ctx, fmt.Sprintf("aws_route_table.routetable_private_utility_z%d", index), o.name("private-"+zone.Name), vpcID)
+	if err != nil {
+		return "", "", err
+	}
+	if err := o.ensureRoute(ctx, privateRouteTableID, &ec2.CreateRouteInput{NatGatewayId: aws.String(natGatewayID)}); err != nil {
+		return "", "", err
+	}
+
+	for _, association := range []struct{ routeTableID, subnetID string }{
+		{privateRouteTableID, privateSubnetID},
+		{mainRouteTableID, publicSubnetID},
+		{privateRouteTableID, nodesSubnetID},
+	} {
+		if err := o.ensureRouteTableAssociation(ctx, association.routeTableID, association.subnetID); err != nil {
+			return "", "", err
+		}
+	}
+
+	return nodesSubnetID, publicSubnetID, nil
+}
+
+func (o *operation) ensureSubnet(ctx context.Context, address, vpcID, cidr, zone string, tags []*ec2.Tag) (string, error) {
+	id, err := o.find(ctx, address, tags, o.describeSubnets, filter("vpc-id", vpcID))
+	if err != nil || id != "" {
+		return id, err
+	}
+
+	output, err := o.ec2.CreateSubnetWithContext(ctx, &ec2.CreateSubnetInput{
+		VpcId:            aws.String(vpcID),
+		CidrBlock:        aws.String(cidr),
+		AvailabilityZone: aws.String(zone),
+	})
+	if err != nil {
+		return "", err
+	}
+	id = aws.StringValue(output.Subnet.SubnetId)
+	o.logger.Info("Created subnet", "cidr", cidr, "zone", zone, "id", id)
+	return id, o.tagCreated(ctx, id, tags, func(ctx context.Context) error {
+		_, err := o.ec2.DeleteSubnetWithContext(ctx, &ec2.DeleteSubnetInput{SubnetId: aws.String(id)})
+		return err
+	})
+}
+
+func (o *operation) describeSubnets(ctx context.Context, id *string, filters []*ec2.Filter) ([]string, error) {
+	output, err := o.ec2.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{SubnetIds: ids(id), Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, subnet := range output.Subnets {
+		out = append(out, aws.StringValue(subnet.SubnetId))
+	}
+	return out, nil
+}
+
+func (o *operation) ensureElasticIP(ctx context.Context, index int) (string, error) {
+	tags := o.tags(o.zoneName("eip-natgw", index))
+	id, err := o.find(ctx, fmt.Sprintf("aws_eip.eip_natgw_z%d", index), tags, o.describeAddresses)
+	if err != nil || id != "" {
+		return id, err
+	}
+
+	output, err := o.ec2.AllocateAddressWithContext(ctx, &ec2.AllocateAddressInput{Domain: aws.String(ec2.DomainTypeVpc)})
+	if err != nil {
+		return "", err
+	}
+	id = aws.StringValue(output.AllocationId)
+	o.logger.Info("Allocated elastic ip", "id", id)
+	return id, o.tagCreated(ctx, id, tags, func(ctx context.Context) error {
+		_, err := o.ec2.ReleaseAddressWithContext(ctx, &ec2.ReleaseAddressInput{AllocationId: aws.String(id)})
+		return err
+	})
+}
+
+func (o *operation) describeAddresses(ctx context.Context, id *string, filters []*ec2.Filter) ([]string, error) {
+	output, err := o.ec2.DescribeAddressesWithContext(ctx, &ec2.DescribeAddressesInput{AllocationIds: ids(id), Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, address := range output.Addresses {
+		out = append(out, aws.StringValue(address.AllocationId))
+	}
+	return out, nil
+}
+
+func (o *operation) ensureNATGateway(ctx context.Context, index int, allocationID, subnetID string) (string, error) {
+	tags := o.tags(o.zoneName("natgw", index))
+	id, err := o.find(ctx, fmt.Sprintf("aws_nat_gateway.natgw_z%d", index), tags, o.describeNATGateways)
+	if err != nil {
+		return "", err
+	}
+	if id == "" {
+		// NAT gateways take minutes to be deleted, hence one that cannot be tagged is not rolled back. Instead, the
+		// client token makes the creation idempotent, i.e. the next reconciliation gets the same NAT gateway again.
+		output, err := o.ec2.CreateNatGatewayWithContext(ctx, &ec2.CreateNatGatewayInput{
+			AllocationId: aws.String(allocationID),
+			SubnetId:     aws.String(subnetID),
+			ClientToken:  aws.String(natGatewayClientToken(o.clusterName, index, allocationID, subnetID)),
+		})
+		if err != nil {
+			return "", err
+		}
+		id = aws.StringValue(output.NatGateway.NatGatewayId)
+		o.logger.Info("Created nat gateway", "id", id)
+		if err := o.createTags(ctx, id, tags); err != nil {
+			return "", err
+		}
+	}
+
+	return id, o.ec2.WaitUntilNatGatewayAvailableWithContext(ctx, &ec2.DescribeNatGatewaysInput{NatGatewayIds: []*string{aws.String(id)}})
+}
+
+// natGatewayClientToken returns the client token for creating the NAT gateway of the zone with the given index. It
+// contains the ids of the elastic ip and the subnet, so that a NAT gateway of a deleted and recreated zone is not
+// returned again.
+func natGatewayClientToken(clusterName string, index int, allocationID, subnetID string) string {
+	return utils.ComputeSHA256Hex([]byte(fmt.Sprintf("%s-%d-%s-%s", clusterName, index, allocationID, subnetID)))
+}
+
+// describeNATGateways describes NAT gateways that are not being deleted or have been deleted.
+func (o *operation) describeNATGateways(ctx context.Context, id *string, filters []*ec2.Filter) ([]string, error) {
+	output, err := o.ec2.DescribeNatGatewaysWithContext(ctx, &ec2.DescribeNatGatewaysInput{
+		NatGatewayIds: ids(id),
+		Filter:        append(filters, filter("state", ec2.NatGatewayStatePending, ec2.NatGatewayStateAvailable)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, natGateway := range output.NatGateways {
+		out = append(out, aws.StringValue(natGateway.NatGatewayId))
+	}
+	return out, nil
+}
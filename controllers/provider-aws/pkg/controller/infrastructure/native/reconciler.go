@@ -0,0 +1,190 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package native contains a reconciler for the AWS infrastructure of a Shoot that talks to the AWS APIs directly
+// instead of applying a Terraform configuration.
+package native
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	awsv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/v1alpha1"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Reconciler creates, updates and deletes the AWS resources of a Shoot infrastructure. It creates the same
+// resources as the Terraform configuration of the aws-infra chart and finds them again by their tags, so that it
+// is idempotent and can take over resources that have been created by Terraform.
+type Reconciler struct {
+	logger logr.Logger
+	ec2    ec2iface.EC2API
+	iam    iamiface.IAMAPI
+
+	// pollInterval is the interval in which the deletion of NAT gateways is checked.
+	pollInterval time.Duration
+}
+
+// NewReconciler creates a new Reconciler that uses the given EC2 and IAM clients.
+func NewReconciler(logger logr.Logger, ec2Client ec2iface.EC2API, iamClient iamiface.IAMAPI) *Reconciler {
+	return &Reconciler{
+		logger:       logger,
+		ec2:          ec2Client,
+		iam:          iamClient,
+		pollInterval: 10 * time.Second,
+	}
+}
+
+// operation holds the data of a single reconciliation or deletion of an Infrastructure.
+type operation struct {
+	*Reconciler
+
+	clusterName string
+	region      string
+	config      *awsapi.InfrastructureConfig
+	imported    ImportedIDs
+}
+
+func (r *Reconciler) newOperation(infrastructure *extensionsv1alpha1.Infrastructure, config *awsapi.InfrastructureConfig, imported ImportedIDs) *operation {
+	if imported == nil {
+		imported = ImportedIDs{}
+	}
+	return &operation{
+		Reconciler:  r,
+		clusterName: infrastructure.Namespace,
+		region:      infrastructure.Spec.Region,
+		config:      config,
+		imported:    imported,
+	}
+}
+
+// Reconcile creates or updates all AWS resources of the given Infrastructure and returns its provider status.
+// Resources with an id in <imported> are taken over instead of being looked up by their tags.
+func (r *Reconciler) Reconcile(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, config *awsapi.InfrastructureConfig, imported ImportedIDs) (*awsv1alpha1.InfrastructureStatus, error) {
+	o := r.newOperation(infrastructure, config, imported)
+
+	vpcID, internetGatewayID, err := o.ensureVPC(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mainRouteTableID, err := o.ensureRouteTable(ctx, "aws_route_table.routetable_main", o.clusterName, vpcID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.ensureRoute(ctx, mainRouteTableID, &ec2.CreateRouteInput{GatewayId: aws.String(internetGatewayID)}); err != nil {
+		return nil, err
+	}
+
+	bastionsSecurityGroupID, nodesSecurityGroupID, err := o.ensureSecurityGroups(ctx, vpcID)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodesSubnets, publicSubnets []awsv1alpha1.Subnet
+	for index, zone := range config.Networks.Zones {
+		nodesSubnetID, publicSubnetID, err := o.ensureZone(ctx, index, zone, vpcID, mainRouteTableID)
+		if err != nil {
+			return nil, err
+		}
+		nodesSubnets = append(nodesSubnets, awsv1alpha1.Subnet{ID: nodesSubnetID, Purpose: awsv1alpha1.PurposeNodes, Zone: zone.Name})
+		publicSubnets = append(publicSubnets, awsv1alpha1.Subnet{ID: publicSubnetID, Purpose: awsapi.PurposePublic, Zone: zone.Name})
+	}
+
+	if err := o.ensureSecurityGroupRules(ctx, bastionsSecurityGroupID, nodesSecurityGroupID); err != nil {
+		return nil, err
+	}
+
+	if _, _, err := o.ensureIAM(ctx, purposeBastions, bastionsRolePolicy); err != nil {
+		return nil, err
+	}
+	nodesInstanceProfileName, nodesRoleARN, err := o.ensureIAM(ctx, awsapi.PurposeNodes, nodesRolePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	keyName, err := o.ensureKeyPair(ctx, infrastructure.Spec.SSHPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &awsv1alpha1.InfrastructureStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "InfrastructureStatus",
+		},
+		VPC: awsv1alpha1.VPCStatus{
+			ID:      vpcID,
+			Subnets: append(nodesSubnets, publicSubnets...),
+			SecurityGroups: []awsv1alpha1.SecurityGroup{
+				{
+					Purpose: awsapi.PurposeNodes,
+					ID:      nodesSecurityGroupID,
+				},
+			},
+		},
+		EC2: awsv1alpha1.EC2{
+			KeyName: keyName,
+		},
+		IAM: awsv1alpha1.IAM{
+			InstanceProfiles: []awsv1alpha1.InstanceProfile{
+				{
+					Purpose: awsapi.PurposeNodes,
+					Name:    nodesInstanceProfileName,
+				},
+			},
+			Roles: []awsv1alpha1.Role{
+				{
+					Purpose: awsapi.PurposeNodes,
+					ARN:     nodesRoleARN,
+				},
+			},
+		},
+	}, nil
+}
+
+// VPCID returns the id of the VPC of the given Infrastructure. It returns an empty string if the VPC does not exist.
+func (r *Reconciler) VPCID(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, config *awsapi.InfrastructureConfig) (string, error) {
+	o := r.newOperation(infrastructure, config, nil)
+	if config.Networks.VPC.ID != nil {
+		return *config.Networks.VPC.ID, nil
+	}
+	return o.findVPC(ctx)
+}
+
+// name returns the value of the Name tag of a resource with the given suffix.
+func (o *operation) name(suffix string) string {
+	return fmt.Sprintf("%s-%s", o.clusterName, suffix)
+}
+
+// zoneName returns the value of the Name tag of a zone resource with the given prefix.
+func (o *operation) zoneName(prefix string, index int) string {
+	return o.name(fmt.Sprintf("%s-z%d", prefix, index))
+}
+
+func dhcpDomainName(region string) string {
+	if region == "us-east-1" {
+		return "ec2.internal"
+	}
+	return fmt.Sprintf("%s.compute.internal", region)
+}
@@ -0,0 +1,305 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	awsv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/v1alpha1"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/controller/infrastructure/native"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		vpcCIDR   = "10.250.0.0/16"
+	)
+
+	var (
+		ctx = context.TODO()
+
+		ec2Client *fakeEC2
+		iamClient *fakeIAM
+
+		reconciler *Reconciler
+		infra      *extensionsv1alpha1.Infrastructure
+		config     *awsapi.InfrastructureConfig
+
+		clusterTag = "kubernetes.io/cluster/" + namespace
+	)
+
+	BeforeEach(func() {
+		ec2Client = newFakeEC2()
+		iamClient = newFakeIAM()
+		reconciler = NewReconciler(log.Log.WithName("test"), ec2Client, iamClient)
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "infrastructure"},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				Region:       "eu-west-1",
+				SSHPublicKey: []byte("ssh-rsa AAAA"),
+			},
+		}
+		config = &awsapi.InfrastructureConfig{
+			Networks: awsapi.Networks{
+				VPC: awsapi.VPC{CIDR: stringPtr(vpcCIDR)},
+				Zones: []awsapi.Zone{
+					{Name: "eu-west-1a", Workers: "10.250.0.0/19", Internal: "10.250.112.0/22", Public: "10.250.96.0/22"},
+					{Name: "eu-west-1b", Workers: "10.250.32.0/19", Internal: "10.250.116.0/22", Public: "10.250.100.0/22"},
+				},
+			},
+		}
+	})
+
+	Describe("#Reconcile", func() {
+		It("should create all resources and return the infrastructure status", func() {
+			status, err := reconciler.Reconcile(ctx, infra, config, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			vpcIDs := ec2Client.ids("vpc")
+			Expect(vpcIDs).To(HaveLen(1))
+			vpc := ec2Client.resources[vpcIDs[0]]
+			Expect(vpc.attributes).To(HaveKeyWithValue("cidr", vpcCIDR))
+			Expect(vpc.tags).To(Equal(map[string]string{"Name": namespace, clusterTag: "1"}))
+
+			Expect(ec2Client.ids("dopt")).To(HaveLen(1))
+			Expect(ec2Client.ids("igw")).To(HaveLen(1))
+			Expect(ec2Client.ids("subnet")).To(HaveLen(6))
+			Expect(ec2Client.ids("eipalloc")).To(HaveLen(2))
+			Expect(ec2Client.ids("nat")).To(HaveLen(2))
+			Expect(ec2Client.ids("rtb")).To(HaveLen(3))
+			Expect(ec2Client.ids("sg")).To(HaveLen(2))
+			Expect(ec2Client.keyPairs).To(HaveKeyWithValue(namespace+"-ssh-publickey", infra.Spec.SSHPublicKey))
+			Expect(iamClient.roles).To(HaveLen(2))
+			Expect(iamClient.instanceProfiles).To(HaveLen(2))
+
+			nodesSubnets := findByName(ec2Client, "subnet", namespace+"-nodes-z0", namespace+"-nodes-z1")
+			publicSubnets := findByName(ec2Client, "subnet", namespace+"-public-utility-z0", namespace+"-public-utility-z1")
+			nodesSecurityGroup := findByName(ec2Client, "sg", namespace+"-nodes")[0]
+			bastionsSecurityGroup := findByName(ec2Client, "sg", namespace+"-bastions")[0]
+
+			Expect(ec2Client.resources[nodesSecurityGroup].permissions).To(HaveKey("ingress tcp 22-22 " + bastionsSecurityGroup))
+			Expect(ec2Client.resources[publicSubnets[0]].tags).To(HaveKeyWithValue("kubernetes.io/role/elb", "use"))
+
+			mainRouteTable := ec2Client.resources[findByName(ec2Client, "rtb", namespace)[0]]
+			Expect(mainRouteTable.routes).To(HaveLen(1))
+			Expect(*mainRouteTable.routes[0].GatewayId).To(Equal(ec2Client.ids("igw")[0]))
+			Expect(mainRouteTable.associations).To(HaveLen(2))
+
+			Expect(status).To(Equal(&awsv1alpha1.InfrastructureStatus{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "InfrastructureStatus",
+				},
+				VPC: awsv1alpha1.VPCStatus{
+					ID: vpcIDs[0],
+					Subnets: []awsv1alpha1.Subnet{
+						{Purpose: awsapi.PurposeNodes, ID: nodesSubnets[0], Zone: "eu-west-1a"},
+						{Purpose: awsapi.PurposeNodes, ID: nodesSubnets[1], Zone: "eu-west-1b"},
+						{Purpose: awsapi.PurposePublic, ID: publicSubnets[0], Zone: "eu-west-1a"},
+						{Purpose: awsapi.PurposePublic, ID: publicSubnets[1], Zone: "eu-west-1b"},
+					},
+					SecurityGroups: []awsv1alpha1.SecurityGroup{
+						{Purpose: awsapi.PurposeNodes, ID: nodesSecurityGroup},
+					},
+				},
+				EC2: awsv1alpha1.EC2{
+					KeyName: namespace + "-ssh-publickey",
+				},
+				IAM: awsv1alpha1.IAM{
+					InstanceProfiles: []awsv1alpha1.InstanceProfile{
+						{Purpose: awsapi.PurposeNodes, Name: namespace + "-nodes"},
+					},
+					Roles: []awsv1alpha1.Role{
+						{Purpose: awsapi.PurposeNodes, ARN: "arn:aws:iam::123456789012:role/" + namespace + "-nodes"},
+					},
+				},
+			}))
+		})
+
+		It("should be idempotent", func() {
+			status, err := reconciler.Reconcile(ctx, infra, config, nil)
+			Expect(err).NotTo(HaveOccurred())
+			resources := len(ec2Client.resources)
+
+			secondStatus, err := reconciler.Reconcile(ctx, infra, config, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secondStatus).To(Equal(status))
+			Expect(ec2Client.resources).To(HaveLen(resources))
+		})
+
+		It("should delete a created resource if it cannot be tagged", func() {
+			ec2Client.createTagsError = func(id string) error {
+				if strings.HasPrefix(id, "vpc-") {
+					return fmt.Errorf("error")
+				}
+				return nil
+			}
+
+			_, err := reconciler.Reconcile(ctx, infra, config, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(ec2Client.ids("vpc")).To(BeEmpty())
+
+			ec2Client.createTagsError = nil
+			_, err = reconciler.Reconcile(ctx, infra, config, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ec2Client.ids("vpc")).To(HaveLen(1))
+		})
+
+		It("should not create a second NAT gateway if a NAT gateway cannot be tagged", func() {
+			ec2Client.createTagsError = func(id string) error {
+				if strings.HasPrefix(id, "nat-") {
+					return fmt.Errorf("error")
+				}
+				return nil
+			}
+
+			_, err := reconciler.Reconcile(ctx, infra, config, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(ec2Client.ids("nat")).To(HaveLen(1))
+
+			ec2Client.createTagsError = nil
+			_, err = reconciler.Reconcile(ctx, infra, config, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ec2Client.ids("nat")).To(HaveLen(2))
+			Expect(findByName(ec2Client, "nat", namespace+"-natgw-z0", namespace+"-natgw-z1")).To(HaveLen(2))
+		})
+
+		It("should take over resources imported from the Terraform state", func() {
+			vpcID := ec2Client.add("vpc", map[string]string{"cidr": vpcCIDR})
+			subnetID := ec2Client.add("subnet", map[string]string{"vpc-id": vpcID})
+
+			status, err := reconciler.Reconcile(ctx, infra, config, ImportedIDs{
+				"aws_vpc.vpc":         vpcID,
+				"aws_subnet.nodes_z0": subnetID,
+				"aws_subnet.nodes_z1": "subnet-does-not-exist",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ec2Client.ids("vpc")).To(ConsistOf(vpcID))
+			Expect(ec2Client.resources[vpcID].tags).To(Equal(map[string]string{"Name": namespace, clusterTag: "1"}))
+			Expect(ec2Client.resources[subnetID].tags).To(Equal(map[string]string{"Name": namespace + "-nodes-z0", clusterTag: "1"}))
+			Expect(ec2Client.ids("subnet")).To(HaveLen(6))
+			Expect(status.VPC.ID).To(Equal(vpcID))
+			Expect(status.VPC.Subnets[0].ID).To(Equal(subnetID))
+		})
+
+		It("should use an existing VPC", func() {
+			vpcID := ec2Client.add("vpc", nil)
+			internetGatewayID := ec2Client.add("igw", map[string]string{"attachment.vpc-id": vpcID})
+			config.Networks.VPC = awsapi.VPC{ID: &vpcID}
+
+			status, err := reconciler.Reconcile(ctx, infra, config, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status.VPC.ID).To(Equal(vpcID))
+			Expect(ec2Client.ids("vpc")).To(ConsistOf(vpcID))
+			Expect(ec2Client.ids("igw")).To(ConsistOf(internetGatewayID))
+			Expect(ec2Client.ids("dopt")).To(BeEmpty())
+			Expect(*ec2Client.resources[findByName(ec2Client, "rtb", namespace)[0]].routes[0].GatewayId).To(Equal(internetGatewayID))
+		})
+
+		It("should fail if the existing VPC has no internet gateway", func() {
+			vpcID := ec2Client.add("vpc", nil)
+			config.Networks.VPC = awsapi.VPC{ID: &vpcID}
+
+			_, err := reconciler.Reconcile(ctx, infra, config, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete all resources", func() {
+			_, err := reconciler.Reconcile(ctx, infra, config, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.Delete(ctx, infra, config)).To(Succeed())
+
+			for _, kind := range []string{"vpc", "dopt", "igw", "subnet", "eipalloc", "nat", "rtb", "rtbassoc", "sg"} {
+				Expect(ec2Client.ids(kind)).To(BeEmpty(), kind)
+			}
+			Expect(ec2Client.keyPairs).To(BeEmpty())
+			Expect(iamClient.roles).To(BeEmpty())
+			Expect(iamClient.rolePolicies).To(BeEmpty())
+			Expect(iamClient.instanceProfiles).To(BeEmpty())
+
+			Expect(reconciler.Delete(ctx, infra, config)).To(Succeed())
+		})
+
+		It("should keep an existing VPC", func() {
+			vpcID := ec2Client.add("vpc", nil)
+			internetGatewayID := ec2Client.add("igw", map[string]string{"attachment.vpc-id": vpcID})
+			config.Networks.VPC = awsapi.VPC{ID: &vpcID}
+
+			_, err := reconciler.Reconcile(ctx, infra, config, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.Delete(ctx, infra, config)).To(Succeed())
+
+			Expect(ec2Client.ids("vpc")).To(ConsistOf(vpcID))
+			Expect(ec2Client.ids("igw")).To(ConsistOf(internetGatewayID))
+			Expect(ec2Client.ids("subnet")).To(BeEmpty())
+			Expect(ec2Client.ids("sg")).To(BeEmpty())
+			Expect(ec2Client.ids("rtb")).To(BeEmpty())
+		})
+	})
+
+	Describe("#ImportFromTerraformState", func() {
+		It("should import the ids of the managed resources of the root module", func() {
+			state := &extensionsterraformer.State{
+				Resources: []extensionsterraformer.Resource{
+					{Mode: extensionsterraformer.ResourceModeManaged, Type: "aws_vpc", Name: "vpc", ID: "vpc-1"},
+					{Mode: extensionsterraformer.ResourceModeManaged, Type: "aws_subnet", Name: "nodes_z0", ID: "subnet-1"},
+					{Mode: extensionsterraformer.ResourceModeData, Type: "aws_vpc", Name: "existing", ID: "vpc-2"},
+					{Module: "module.foo", Mode: extensionsterraformer.ResourceModeManaged, Type: "aws_subnet", Name: "bar", ID: "subnet-2"},
+					{Mode: extensionsterraformer.ResourceModeManaged, Type: "aws_eip", Name: "eip_natgw_z0"},
+				},
+			}
+
+			Expect(ImportFromTerraformState(state)).To(Equal(ImportedIDs{
+				"aws_vpc.vpc":         "vpc-1",
+				"aws_subnet.nodes_z0": "subnet-1",
+			}))
+		})
+	})
+})
+
+// findByName returns the ids of the resources of the given kind with the given Name tags, in the given order.
+func findByName(f *fakeEC2, kind string, names ...string) []string {
+	var out []string
+	for _, name := range names {
+		for _, id := range f.ids(kind) {
+			if f.resources[id].tags["Name"] == name {
+				out = append(out, id)
+			}
+		}
+	}
+	Expect(out).To(HaveLen(len(names)))
+	return out
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+const (
+	// purposeBastions is the purpose of the bastion resources.
+	purposeBastions = "bastions"
+
+	// errCodeDuplicatePermission is the error code AWS returns if a security group rule already exists.
+	errCodeDuplicatePermission = "InvalidPermission.Duplicate"
+
+	nodePortsFrom int64 = 30000
+	nodePortsTo   int64 = 32767
+)
+
+// ensureSecurityGroups ensures the security groups of the bastions and the nodes and returns their ids.
+func (o *operation) ensureSecurityGroups(ctx context.Context, vpcID string) (string, string, error) {
+	bastionsSecurityGroupID, err := o.ensureSecurityGroup(ctx, purposeBastions, "Security group for bastions", vpcID)
+	if err != nil {
+		return "", "", err
+	}
+	nodesSecurityGroupID, err := o.ensureSecurityGroup(ctx, "nodes", "Security group for nodes", vpcID)
+	if err != nil {
+		return "", "", err
+	}
+	return bastionsSecurityGroupID, nodesSecurityGroupID, nil
+}
+
+func (o *operation) ensureSecurityGroup(ctx context.Context, purpose, description, vpcID string) (string, error) {
+	var (
+		name = o.name(purpose)
+		tags = o.tags(name)
+	)
+
+	id, err := o.find(ctx, "aws_security_group."+purpose, tags, o.describeSecurityGroups, filter("vpc-id", vpcID))
+	if err != nil || id != "" {
+		return id, err
+	}
+
+	// The group name is unique per VPC, hence a group that has lost its tags is found by its name.
+	id, err = o.find(ctx, "", nil, o.describeSecurityGroups, filter("vpc-id", vpcID), filter("group-name", name))
+	if err != nil {
+		return "", err
+	}
+	if id == "" {
+		output, err := o.ec2.CreateSecurityGroupWithContext(ctx, &ec2.CreateSecurityGroupInput{
+			GroupName:   aws.String(name),
+			Description: aws.String(description),
+			VpcId:       aws.String(vpcID),
+		})
+		if err != nil {
+			return "", err
+		}
+		id = aws.StringValue(output.GroupId)
+		o.logger.Info("Created security group", "name", name, "id", id)
+	}
+	return id, o.createTags(ctx, id, tags)
+}
+
+func (o *operation) describeSecurityGroups(ctx context.Context, id *string, filters []*ec2.Filter) ([]string, error) {
+	output, err := o.ec2.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{GroupIds: ids(id), Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, securityGroup := range output.SecurityGroups {
+		out = append(out, aws.StringValue(securityGroup.GroupId))
+	}
+	return out, nil
+}
+
+// ensureSecurityGroupRules ensures the rules of the security groups of the bastions and the nodes. Rules are only
+// added, rules that are not desired are kept.
+func (o *operation) ensureSecurityGroupRules(ctx context.Context, bastionsSecurityGroupID, nodesSecurityGroupID string) error {
+	nodesIngress := []*ec2.IpPermission{
+		{IpProtocol: aws.String("-1"), UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String(nodesSecurityGroupID)}}},
+		ipPermission("tcp", nodePortsFrom, nodePortsTo, allCIDR),
+		ipPermission("udp", nodePortsFrom, nodePortsTo, allCIDR),
+		{IpProtocol: aws.String("tcp"), FromPort: aws.Int64(22), ToPort: aws.Int64(22), UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String(bastionsSecurityGroupID)}}},
+	}
+	for _, zone := range o.config.Networks.Zones {
+		for _, cidr := range []string{zone.Internal, zone.Public} {
+			nodesIngress = append(nodesIngress,
+				ipPermission("tcp", nodePortsFrom, nodePortsTo, cidr),
+				ipPermission("udp", nodePortsFrom, nodePortsTo, cidr),
+			)
+		}
+	}
+
+	for _, rules := range []struct {
+		securityGroupID string
+		ingress         []*ec2.IpPermission
+	}{
+		{bastionsSecurityGroupID, []*ec2.IpPermission{ipPermission("tcp", 22, 22, allCIDR)}},
+		{nodesSecurityGroupID, nodesIngress},
+	} {
+		for _, permission := range rules.ingress {
+			_, err := o.ec2.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+				GroupId:       aws.String(rules.securityGroupID),
+				IpPermissions: []*ec2.IpPermission{permission},
+			})
+			if err != nil && !isErrorCode(err, errCodeDuplicatePermission) {
+				return err
+			}
+		}
+
+		// New security groups already allow all egress traffic, hence the rule usually exists.
+		_, err := o.ec2.AuthorizeSecurityGroupEgressWithContext(ctx, &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       aws.String(rules.securityGroupID),
+			IpPermissions: []*ec2.IpPermission{{IpProtocol: aws.String("-1"), IpRanges: []*ec2.IpRange{{CidrIp: aws.String(allCIDR)}}}},
+		})
+		if err != nil && !isErrorCode(err, errCodeDuplicatePermission) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ipPermission(protocol string, fromPort, toPort int64, cidr string) *ec2.IpPermission {
+	return &ec2.IpPermission{
+		IpProtocol: aws.String(protocol),
+		FromPort:   aws.Int64(fromPort),
+		ToPort:     aws.Int64(toPort),
+		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String(cidr)}},
+	}
+}
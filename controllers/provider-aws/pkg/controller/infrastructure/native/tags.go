@@ -0,0 +1,143 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// tagKeyName is the key of the Name tag.
+	tagKeyName = "Name"
+	// tagKeyClusterPrefix is the prefix of the key of the tag that marks a resource as belonging to a cluster.
+	tagKeyClusterPrefix = "kubernetes.io/cluster/"
+)
+
+// tags returns the tags of a resource with the given name, i.e. the Name tag, the cluster tag and the given
+// additional tags. They are the same as those of the resources of the Terraform configuration.
+func (o *operation) tags(name string, additional ...*ec2.Tag) []*ec2.Tag {
+	return append([]*ec2.Tag{
+		{Key: aws.String(tagKeyName), Value: aws.String(name)},
+		{Key: aws.String(tagKeyClusterPrefix + o.clusterName), Value: aws.String("1")},
+	}, additional...)
+}
+
+// tagFilters returns filters that match resources having all of the given tags.
+func tagFilters(tags []*ec2.Tag) []*ec2.Filter {
+	filters := make([]*ec2.Filter, 0, len(tags))
+	for _, tag := range tags {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("tag:" + aws.StringValue(tag.Key)),
+			Values: []*string{tag.Value},
+		})
+	}
+	return filters
+}
+
+// filter returns a filter with the given name and values.
+func filter(name string, values ...string) *ec2.Filter {
+	return &ec2.Filter{Name: aws.String(name), Values: aws.StringSlice(values)}
+}
+
+// describeFunc describes resources and returns their ids. If <id> is set, only the resource with this id is
+// described, otherwise all resources matching <filters>.
+type describeFunc func(ctx context.Context, id *string, filters []*ec2.Filter) ([]string, error)
+
+// find returns the id of an existing resource. A resource that has been imported with the given Terraform
+// <address> is preferred and tagged with <tags> in order to find it by its tags in the future. Otherwise, the
+// first resource that has all <tags> and matches the additional <filters> is returned. If no resource is found,
+// an empty string is returned.
+func (o *operation) find(ctx context.Context, address string, tags []*ec2.Tag, describe describeFunc, filters ...*ec2.Filter) (string, error) {
+	if id, ok := o.imported[address]; ok {
+		ids, err := describe(ctx, aws.String(id), nil)
+		if err != nil && !isNotFoundError(err) {
+			return "", err
+		}
+		if len(ids) > 0 {
+			o.logger.Info("Taking over resource created by Terraform", "address", address, "id", ids[0])
+			return ids[0], o.createTags(ctx, ids[0], tags)
+		}
+	}
+
+	ids, err := describe(ctx, nil, append(tagFilters(tags), filters...))
+	if err != nil || len(ids) == 0 {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// createTags adds the given tags to the resource with the given id. Existing tags are overwritten.
+func (o *operation) createTags(ctx context.Context, id string, tags []*ec2.Tag) error {
+	_, err := o.ec2.CreateTagsWithContext(ctx, &ec2.CreateTagsInput{
+		Resources: []*string{aws.String(id)},
+		Tags:      tags,
+	})
+	return err
+}
+
+// tagBackoff is the backoff for tagging a resource that has just been created and may not be visible yet.
+var tagBackoff = wait.Backoff{Duration: time.Second, Factor: 2, Steps: 4}
+
+// tagCreated adds the given tags to the resource with the given id that has just been created. Resources are only
+// found by their tags, hence an untagged resource would be leaked and created again by the next reconciliation.
+// Tagging is retried as long as the resource is not visible yet. If it still fails, <rollback> deletes the resource.
+func (o *operation) tagCreated(ctx context.Context, id string, tags []*ec2.Tag, rollback func(ctx context.Context) error) error {
+	var err error
+	if waitErr := wait.ExponentialBackoff(tagBackoff, func() (bool, error) {
+		err = o.createTags(ctx, id, tags)
+		return err == nil || !isNotFoundError(err), nil
+	}); waitErr != nil && err == nil {
+		err = waitErr
+	}
+	if err == nil {
+		return nil
+	}
+
+	if rollbackErr := rollback(ctx); rollbackErr != nil {
+		return fmt.Errorf("could not tag %s: %v, rolling it back failed: %v", id, err, rollbackErr)
+	}
+	o.logger.Info("Deleted resource that could not be tagged", "id", id)
+	return fmt.Errorf("could not tag %s: %v", id, err)
+}
+
+// ids returns a list containing the given id if it is set.
+func ids(id *string) []*string {
+	if id == nil {
+		return nil
+	}
+	return []*string{id}
+}
+
+// isNotFoundError checks whether the given error reports that an EC2 resource does not exist.
+func isNotFoundError(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return strings.HasSuffix(aerr.Code(), "NotFound")
+	}
+	return false
+}
+
+// isErrorCode checks whether the given error is an AWS error with the given code.
+func isErrorCode(err error, code string) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == code
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	// ReconcilerFlag is the name of the command line flag to specify which reconciler is used for the
+	// infrastructure.
+	ReconcilerFlag = "infrastructure-reconciler"
+
+	// ReconcilerTerraform reconciles the infrastructure by applying a Terraform configuration.
+	ReconcilerTerraform = "terraform"
+	// ReconcilerNative reconciles the infrastructure by using the AWS APIs directly.
+	ReconcilerNative = "native"
+)
+
+// Options are command line options that can be set for the AWS infrastructure controller.
+type Options struct {
+	// Reconciler is the reconciler that is used for the infrastructure.
+	Reconciler string
+
+	config *Config
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Reconciler, ReconcilerFlag, o.Reconciler, fmt.Sprintf("The reconciler that is used for the infrastructure, either %q or %q.", ReconcilerTerraform, ReconcilerNative))
+}
+
+// Complete implements Completer.Complete.
+func (o *Options) Complete() error {
+	if o.Reconciler != ReconcilerTerraform && o.Reconciler != ReconcilerNative {
+		return fmt.Errorf("unknown infrastructure reconciler %q, must be %q or %q", o.Reconciler, ReconcilerTerraform, ReconcilerNative)
+	}
+	o.config = &Config{o.Reconciler}
+	return nil
+}
+
+// Completed returns the completed Config. Only call this if `Complete` was successful.
+func (o *Options) Completed() *Config {
+	return o.config
+}
+
+// Config is a completed AWS infrastructure controller configuration.
+type Config struct {
+	// Reconciler is the reconciler that is used for the infrastructure.
+	Reconciler string
+}
+
+// Apply sets the values of this Config in the given reconciler.
+func (c *Config) Apply(reconciler *string) {
+	*reconciler = c.Reconciler
+}
@@ -35,6 +35,8 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// DryRun specifies whether Worker resources are only dry-run instead of being reconciled.
+	DryRun bool
 	// MachineImagesToAMIMapping is the default mapping from machine images to AMIs.
 	MachineImagesToAMIMapping []config.MachineImage
 }
@@ -54,6 +56,7 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 		Actuator:          NewActuator(opts.MachineImagesToAMIMapping),
 		ControllerOptions: opts.Controller,
 		Predicates:        worker.DefaultPredicates(aws.Type, opts.IgnoreOperationAnnotation),
+		DryRun:            opts.DryRun,
 	})
 }
 
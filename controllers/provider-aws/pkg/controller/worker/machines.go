@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -123,7 +124,13 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			AMI:     ami,
 		})
 
-		volumeSize, err := worker.DiskSize(pool.Volume.Size)
+		workerConfig := &awsapi.WorkerConfig{}
+		providerConfig, err := worker.DecodeProviderConfig(w.decoder, pool, workerConfig)
+		if err != nil {
+			return err
+		}
+
+		blockDevices, err := computeBlockDevices(pool, workerConfig)
 		if err != nil {
 			return err
 		}
@@ -146,40 +153,38 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 						"securityGroupIDs": []string{nodesSecurityGroup.ID},
 					},
 				},
-				"tags": map[string]string{
+				"tags": worker.MergeTags(workerConfig.Tags, map[string]string{
 					fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace): "1",
 					"kubernetes.io/role/node":                                   "1",
-				},
+				}),
 				"secret": map[string]interface{}{
 					"cloudConfig": string(pool.UserData),
 				},
-				"blockDevices": []map[string]interface{}{
-					{
-						"ebs": map[string]interface{}{
-							"volumeSize": volumeSize,
-							"volumeType": pool.Volume.Type,
-						},
-					},
-				},
+				"blockDevices": blockDevices,
+			}
+
+			machineClassSpecHash, err := worker.MachineClassHashWithProviderConfig(machineClassSpec, shootVersionMajorMinor, providerConfig)
+			if err != nil {
+				return err
 			}
 
 			var (
-				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-				deploymentName       = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
-				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+				deploymentName = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
+				className      = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
-				Name:           deploymentName,
-				ClassName:      className,
-				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
-				Labels:         pool.Labels,
-				Annotations:    pool.Annotations,
-				Taints:         pool.Taints,
+				Name:                 deploymentName,
+				ClassName:            className,
+				SecretName:           className,
+				Minimum:              worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
+				Maximum:              worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
+				MaxSurge:             worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
+				MaxUnavailable:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
+				Labels:               pool.Labels,
+				Annotations:          pool.Annotations,
+				Taints:               pool.Taints,
+				RollingUpdateTimeout: worker.RollingUpdateTimeout(workerConfig.RollingUpdateTimeout),
 			})
 
 			machineClassSpec["name"] = className
@@ -199,3 +204,57 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// computeBlockDevices computes the block devices of the machines of the given worker pool, i.e. the root volume and
+// all data volumes of the given WorkerConfig.
+func computeBlockDevices(pool extensionsv1alpha1.WorkerPool, workerConfig *awsapi.WorkerConfig) ([]map[string]interface{}, error) {
+	volumeSize, err := worker.DiskSize(pool.Volume.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	blockDevices := []map[string]interface{}{
+		{
+			"ebs": computeEBS(volumeSize, &pool.Volume.Type, workerConfig.Volume),
+		},
+	}
+
+	for _, dataVolume := range workerConfig.DataVolumes {
+		dataVolumeSize, err := worker.DiskSize(dataVolume.Size)
+		if err != nil {
+			return nil, err
+		}
+
+		ebs := computeEBS(dataVolumeSize, dataVolume.Type, &dataVolume.Volume)
+		ebs["deleteOnTermination"] = true
+
+		blockDevices = append(blockDevices, map[string]interface{}{
+			"deviceName": dataVolume.DeviceName,
+			"ebs":        ebs,
+		})
+	}
+
+	return blockDevices, nil
+}
+
+// computeEBS computes the EBS configuration of a block device with the given size and type and the given optional
+// additional configuration.
+func computeEBS(size int, volumeType *string, volume *awsapi.Volume) map[string]interface{} {
+	ebs := map[string]interface{}{
+		"volumeSize": size,
+	}
+	if volumeType != nil {
+		ebs["volumeType"] = *volumeType
+	}
+
+	if volume != nil {
+		if volume.IOPS != nil {
+			ebs["iops"] = *volume.IOPS
+		}
+		if volume.Encrypted != nil {
+			ebs["encrypted"] = *volume.Encrypted
+		}
+	}
+
+	return ebs
+}
@@ -19,6 +19,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
+	"time"
 
 	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	awsv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/v1alpha1"
@@ -447,6 +449,201 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should render the worker config of the pools into the machine classes", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				var (
+					iops      = int64(1000)
+					encrypted = true
+					io1       = "io1"
+				)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&awsv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						Volume: &awsv1alpha1.Volume{
+							Encrypted: &encrypted,
+						},
+						DataVolumes: []awsv1alpha1.DataVolume{
+							{
+								DeviceName: "/dev/sdf",
+								Size:       "100Gi",
+								Type:       &io1,
+								Volume: awsv1alpha1.Volume{
+									IOPS: &iops,
+								},
+							},
+						},
+						Tags: map[string]string{
+							"team":                    "foo",
+							"kubernetes.io/role/node": "0",
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				var machineClasses []map[string]interface{}
+				chartApplier.
+					EXPECT().
+					ApplyChart(context.TODO(), filepath.Join(aws.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _, _, _ string, values, _ map[string]interface{}) error {
+						machineClasses = values["machineClasses"].([]map[string]interface{})
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+				Expect(machineClasses).To(HaveLen(4))
+
+				pool1Class, pool2Class := machineClasses[0], machineClasses[2]
+				Expect(pool1Class["blockDevices"]).To(Equal([]map[string]interface{}{
+					{
+						"ebs": map[string]interface{}{
+							"volumeSize": volumeSize,
+							"volumeType": volumeType,
+							"encrypted":  true,
+						},
+					},
+					{
+						"deviceName": "/dev/sdf",
+						"ebs": map[string]interface{}{
+							"volumeSize":          100,
+							"volumeType":          io1,
+							"iops":                iops,
+							"deleteOnTermination": true,
+						},
+					},
+				}))
+				Expect(pool1Class["tags"]).To(Equal(map[string]string{
+					"team": "foo",
+					fmt.Sprintf("kubernetes.io/cluster/%s", namespace): "1",
+					"kubernetes.io/role/node":                          "1",
+				}))
+				Expect(pool2Class["tags"]).To(Equal(map[string]string{
+					fmt.Sprintf("kubernetes.io/cluster/%s", namespace): "1",
+					"kubernetes.io/role/node":                          "1",
+				}))
+				Expect(strings.TrimPrefix(pool1Class["name"].(string), fmt.Sprintf("%s-%s-z1-", namespace, namePool1))).
+					NotTo(Equal(strings.TrimPrefix(pool2Class["name"].(string), fmt.Sprintf("%s-%s-z1-", namespace, namePool2))))
+			})
+
+			It("should roll the machines if only the iops of a volume change", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				workerConfigWithIOPS := func(iops int64) *runtime.RawExtension {
+					io1 := "io1"
+					return &runtime.RawExtension{
+						Raw: encode(&awsv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							DataVolumes: []awsv1alpha1.DataVolume{
+								{
+									DeviceName: "/dev/sdf",
+									Size:       "100Gi",
+									Type:       &io1,
+									Volume: awsv1alpha1.Volume{
+										IOPS: &iops,
+									},
+								},
+							},
+						}),
+					}
+				}
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithIOPS(1000)
+				w.Spec.Pools[1].ProviderConfig = workerConfigWithIOPS(2000)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(4))
+
+				var (
+					hashPool1 = strings.TrimPrefix(result[0].ClassName, fmt.Sprintf("%s-%s-z1-", namespace, namePool1))
+					hashPool2 = strings.TrimPrefix(result[2].ClassName, fmt.Sprintf("%s-%s-z1-", namespace, namePool2))
+				)
+				Expect(hashPool1).NotTo(Equal(hashPool2))
+
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				w.Spec.Pools[1].ProviderConfig = workerConfigWithIOPS(1000)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				result, err = workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(strings.TrimPrefix(result[2].ClassName, fmt.Sprintf("%s-%s-z1-", namespace, namePool2))).To(Equal(hashPool1))
+			})
+
+			It("should set the rolling update timeout of the pools without rolling the machines", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				workerConfigWithTimeout := func(timeout *metav1.Duration) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Raw: encode(&awsv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							RollingUpdateTimeout: timeout,
+						}),
+					}
+				}
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithTimeout(&metav1.Duration{Duration: time.Hour})
+				w.Spec.Pools[1].ProviderConfig = workerConfigWithTimeout(nil)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(4))
+				Expect(result[0].RollingUpdateTimeout).To(Equal(time.Hour))
+				Expect(result[2].RollingUpdateTimeout).To(BeZero())
+				Expect(strings.TrimPrefix(result[0].ClassName, fmt.Sprintf("%s-%s-z1-", namespace, namePool1))).
+					To(Equal(strings.TrimPrefix(result[2].ClassName, fmt.Sprintf("%s-%s-z1-", namespace, namePool2))))
+			})
+
+			It("should not roll the machines if only a rolling update timeout is added to a pool without worker config", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(4))
+				className := result[0].ClassName
+
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&awsv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						RollingUpdateTimeout: &metav1.Duration{Duration: time.Hour},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				result, err = workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].RollingUpdateTimeout).To(Equal(time.Hour))
+				Expect(result[0].ClassName).To(Equal(className))
+			})
+
+			It("should fail because the worker config cannot be decoded", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte("invalid")}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -21,7 +21,9 @@ import (
 
 	"github.com/coreos/go-systemd/unit"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	extensionscontext "github.com/gardener/gardener-extensions/pkg/webhook/context"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/genericmutator"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
@@ -53,11 +55,13 @@ func (e *ensurer) InjectClient(client client.Client) error {
 }
 
 // EnsureKubeAPIServerDeployment ensures that the kube-apiserver deployment conforms to the provider requirements.
-func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1.Deployment) error {
+func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, gctx extensionscontext.GardenContext, dep *appsv1.Deployment) error {
 	template := &dep.Spec.Template
 	ps := &template.Spec
 	if c := extensionswebhook.ContainerWithName(ps.Containers, "kube-apiserver"); c != nil {
-		ensureKubeAPIServerCommandLineArgs(c)
+		if err := ensureCommandLineArgs(ctx, gctx, c, kubeAPIServerFlags); err != nil {
+			return err
+		}
 		ensureEnvVars(c)
 		ensureVolumeMounts(c)
 	}
@@ -66,11 +70,13 @@ func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1
 }
 
 // EnsureKubeControllerManagerDeployment ensures that the kube-controller-manager deployment conforms to the provider requirements.
-func (e *ensurer) EnsureKubeControllerManagerDeployment(ctx context.Context, dep *appsv1.Deployment) error {
+func (e *ensurer) EnsureKubeControllerManagerDeployment(ctx context.Context, gctx extensionscontext.GardenContext, dep *appsv1.Deployment) error {
 	template := &dep.Spec.Template
 	ps := &template.Spec
 	if c := extensionswebhook.ContainerWithName(ps.Containers, "kube-controller-manager"); c != nil {
-		ensureKubeControllerManagerCommandLineArgs(c)
+		if err := ensureCommandLineArgs(ctx, gctx, c, kubeControllerManagerFlags); err != nil {
+			return err
+		}
 		ensureEnvVars(c)
 		ensureVolumeMounts(c)
 	}
@@ -79,21 +85,36 @@ func (e *ensurer) EnsureKubeControllerManagerDeployment(ctx context.Context, dep
 	return e.ensureChecksumAnnotations(ctx, &dep.Spec.Template, dep.Namespace)
 }
 
-func ensureKubeAPIServerCommandLineArgs(c *corev1.Container) {
-	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--cloud-provider=", "aws")
-	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--cloud-config=",
-		"/etc/kubernetes/cloudprovider/cloudprovider.conf")
-	c.Command = extensionswebhook.EnsureStringWithPrefixContains(c.Command, "--enable-admission-plugins=",
-		"PersistentVolumeLabel", ",")
-	c.Command = extensionswebhook.EnsureNoStringWithPrefixContains(c.Command, "--disable-admission-plugins=",
-		"PersistentVolumeLabel", ",")
-}
+var (
+	kubeAPIServerFlags = []extensionswebhook.VersionedFlag{
+		extensionswebhook.EnsureFlag("--cloud-provider", "aws"),
+		extensionswebhook.EnsureFlag("--cloud-config", "/etc/kubernetes/cloudprovider/cloudprovider.conf"),
+		extensionswebhook.EnsureFlagListItem("--enable-admission-plugins", "PersistentVolumeLabel"),
+		extensionswebhook.RemoveFlagListItem("--disable-admission-plugins", "PersistentVolumeLabel"),
+	}
+	kubeControllerManagerFlags = []extensionswebhook.VersionedFlag{
+		extensionswebhook.EnsureFlag("--cloud-provider", "external"),
+		extensionswebhook.EnsureFlag("--cloud-config", "/etc/kubernetes/cloudprovider/cloudprovider.conf"),
+		extensionswebhook.EnsureFlag("--external-cloud-volume-plugin", "aws").ForVersions(aws.InTreeVolumePluginVersionConstraint),
+		extensionswebhook.RemoveFlag("--external-cloud-volume-plugin").ForVersions(aws.CSIVersionConstraint),
+		extensionswebhook.RemoveFlagListItem("--feature-gates", "CSIMigration=false").ForVersions(aws.CSIVersionConstraint),
+		extensionswebhook.EnsureFlagListItem("--feature-gates", "CSIMigration=true").ForVersions(aws.CSIVersionConstraint),
+		extensionswebhook.RemoveFlagListItem("--feature-gates", "CSIMigrationAWS=false").ForVersions(aws.CSIVersionConstraint),
+		extensionswebhook.EnsureFlagListItem("--feature-gates", "CSIMigrationAWS=true").ForVersions(aws.CSIVersionConstraint),
+	}
+	kubeletFlags = []extensionswebhook.VersionedFlag{
+		extensionswebhook.EnsureFlag("--cloud-provider", "aws").ForVersions(aws.InTreeVolumePluginVersionConstraint),
+		extensionswebhook.EnsureFlag("--cloud-provider", "external").ForVersions(aws.CSIVersionConstraint),
+	}
+)
 
-func ensureKubeControllerManagerCommandLineArgs(c *corev1.Container) {
-	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--cloud-provider=", "external")
-	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--cloud-config=",
-		"/etc/kubernetes/cloudprovider/cloudprovider.conf")
-	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--external-cloud-volume-plugin=", "aws")
+func ensureCommandLineArgs(ctx context.Context, gctx extensionscontext.GardenContext, c *corev1.Container, flags []extensionswebhook.VersionedFlag) error {
+	command, err := controlplane.EnsureVersionedFlags(ctx, gctx, c.Command, flags...)
+	if err != nil {
+		return err
+	}
+	c.Command = command
+	return nil
 }
 
 func ensureKubeControllerManagerAnnotations(t *corev1.PodTemplateSpec) {
@@ -159,10 +180,13 @@ func (e *ensurer) ensureChecksumAnnotations(ctx context.Context, template *corev
 }
 
 // EnsureKubeletServiceUnitOptions ensures that the kubelet.service unit options conform to the provider requirements.
-func (e *ensurer) EnsureKubeletServiceUnitOptions(ctx context.Context, opts []*unit.UnitOption) ([]*unit.UnitOption, error) {
+func (e *ensurer) EnsureKubeletServiceUnitOptions(ctx context.Context, gctx extensionscontext.GardenContext, opts []*unit.UnitOption) ([]*unit.UnitOption, error) {
 	if opt := extensionswebhook.UnitOptionWithSectionAndName(opts, "Service", "ExecStart"); opt != nil {
 		command := extensionswebhook.DeserializeCommandLine(opt.Value)
-		command = ensureKubeletCommandLineArgs(command)
+		command, err := controlplane.EnsureVersionedFlags(ctx, gctx, command, kubeletFlags...)
+		if err != nil {
+			return nil, err
+		}
 		opt.Value = extensionswebhook.SerializeCommandLine(command, 1, " \\\n    ")
 	}
 	opts = extensionswebhook.EnsureUnitOption(opts, &unit.UnitOption{
@@ -173,13 +197,27 @@ func (e *ensurer) EnsureKubeletServiceUnitOptions(ctx context.Context, opts []*u
 	return opts, nil
 }
 
-func ensureKubeletCommandLineArgs(command []string) []string {
-	command = extensionswebhook.EnsureStringWithPrefix(command, "--cloud-provider=", "aws")
-	return command
-}
-
 // EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
+func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, gctx extensionscontext.GardenContext, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
+	version, err := gctx.GetKubernetesVersion(ctx)
+	if err != nil {
+		return err
+	}
+	csiEnabled, err := util.VersionMatchesConstraint(version, aws.CSIVersionConstraint)
+	if err != nil {
+		return err
+	}
+
+	if csiEnabled {
+		// Ensure in-tree EBS volumes are migrated to the CSI driver
+		if kubeletConfig.FeatureGates == nil {
+			kubeletConfig.FeatureGates = make(map[string]bool)
+		}
+		kubeletConfig.FeatureGates["CSIMigration"] = true
+		kubeletConfig.FeatureGates["CSIMigrationAWS"] = true
+		return nil
+	}
+
 	// Make sure CSI-related feature gates are not enabled
 	// TODO Leaving these enabled shouldn't do any harm, perhaps remove this code when properly tested?
 	delete(kubeletConfig.FeatureGates, "VolumeSnapshotDataSource")
@@ -191,7 +229,7 @@ func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, kubeletConfig
 var regexFindProperty = regexp.MustCompile("net.ipv4.neigh.default.gc_thresh1[[:space:]]*=[[:space:]]*([[:alnum:]]+)")
 
 // EnsureKubernetesGeneralConfiguration ensures that the kubernetes general configuration conforms to the provider requirements.
-func (e *ensurer) EnsureKubernetesGeneralConfiguration(ctx context.Context, data *string) error {
+func (e *ensurer) EnsureKubernetesGeneralConfiguration(ctx context.Context, gctx extensionscontext.GardenContext, data *string) error {
 	// If the needed property exists, ensure the correct value
 	if regexFindProperty.MatchString(*data) {
 		res := regexFindProperty.ReplaceAll([]byte(*data), []byte("net.ipv4.neigh.default.gc_thresh1 = 0"))
@@ -212,7 +250,7 @@ func (e *ensurer) EnsureKubernetesGeneralConfiguration(ctx context.Context, data
 }
 
 // EnsureAdditionalUnits ensures that additional required system units are added.
-func (e *ensurer) EnsureAdditionalUnits(ctx context.Context, units *[]extensionsv1alpha1.Unit) error {
+func (e *ensurer) EnsureAdditionalUnits(ctx context.Context, gctx extensionscontext.GardenContext, units *[]extensionsv1alpha1.Unit) error {
 	var (
 		command              = "start"
 		trueVar              = true
@@ -0,0 +1,40 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootservice
+
+import (
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	"github.com/gardener/gardener-extensions/pkg/webhook/shoot/service"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+// AnnotationConnectionIdleTimeout is the annotation for the idle timeout (in seconds) of the connections of an AWS
+// load balancer.
+const AnnotationConnectionIdleTimeout = "service.beta.kubernetes.io/aws-load-balancer-connection-idle-timeout"
+
+// defaultAnnotations are the annotations that are added to LoadBalancer services unless they are already set.
+var defaultAnnotations = map[string]string{
+	AnnotationConnectionIdleTimeout: "3600",
+}
+
+var logger = log.Log.WithName("aws-shoot-service-webhook")
+
+// AddToManager creates a webhook that adds the default AWS load balancer annotations to the LoadBalancer services in
+// the shoot and adds it to the manager.
+func AddToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	return service.Add(mgr, defaultAnnotations, logger)
+}
@@ -114,7 +114,7 @@ type SecurityGroup struct {
 
 // VNet contains information about the VNet and some related resources.
 type VNet struct {
-	// Name is the VNet name.
+	// Name is the VNet name. An existing VNet must reside in the resource group of the cluster.
 	Name *string
 	// CIDR is the VNet CIDR
 	CIDR *string
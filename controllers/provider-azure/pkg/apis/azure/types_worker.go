@@ -20,6 +20,27 @@ import (
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// Volume contains additional configuration for the OS disks of the machines.
+	Volume *Volume
+	// Tags are additional tags that are added to the machines.
+	Tags map[string]string
+	// RollingUpdateTimeout is the maximum duration of a rolling update of the machines. It is excluded from the
+	// machine class hash as it does not affect the machines.
+	RollingUpdateTimeout *metav1.Duration `json:"-"`
+}
+
+// Volume contains configuration for the OS disk.
+type Volume struct {
+	// SKU is the storage account type of the managed OS disk, e.g. `Premium_LRS`.
+	SKU *string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // WorkerStatus contains information about created worker resources.
 type WorkerStatus struct {
 	metav1.TypeMeta
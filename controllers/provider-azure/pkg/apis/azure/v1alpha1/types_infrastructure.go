@@ -116,7 +116,7 @@ type SecurityGroup struct {
 
 // VNet contains information about the VNet and some related resources.
 type VNet struct {
-	// Name is the VNet name.
+	// Name is the VNet name. An existing VNet must reside in the resource group of the cluster.
 	// +optional
 	Name *string `json:"name,omitempty"`
 	// CIDR is the VNet CIDR
@@ -20,6 +20,30 @@ import (
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Volume contains additional configuration for the OS disks of the machines.
+	// +optional
+	Volume *Volume `json:"volume,omitempty"`
+	// Tags are additional tags that are added to the machines.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+	// RollingUpdateTimeout is the maximum duration of a rolling update of the machines. Defaults to 30 minutes.
+	// +optional
+	RollingUpdateTimeout *metav1.Duration `json:"rollingUpdateTimeout,omitempty"`
+}
+
+// Volume contains configuration for the OS disk.
+type Volume struct {
+	// SKU is the storage account type of the managed OS disk, e.g. `Premium_LRS`.
+	// +optional
+	SKU *string `json:"sku,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // WorkerStatus contains information about created worker resources.
 type WorkerStatus struct {
 	metav1.TypeMeta `json:",inline"`
@@ -24,6 +24,7 @@ import (
 	unsafe "unsafe"
 
 	azure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -215,6 +216,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Volume)(nil), (*azure.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Volume_To_azure_Volume(a.(*Volume), b.(*azure.Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_Volume_To_v1alpha1_Volume(a.(*azure.Volume), b.(*Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*azure.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(a.(*WorkerConfig), b.(*azure.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*azure.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*azure.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_azure_WorkerStatus(a.(*WorkerStatus), b.(*azure.WorkerStatus), scope)
 	}); err != nil {
@@ -654,6 +675,50 @@ func Convert_azure_VNetStatus_To_v1alpha1_VNetStatus(in *azure.VNetStatus, out *
 	return autoConvert_azure_VNetStatus_To_v1alpha1_VNetStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_Volume_To_azure_Volume(in *Volume, out *azure.Volume, s conversion.Scope) error {
+	out.SKU = (*string)(unsafe.Pointer(in.SKU))
+	return nil
+}
+
+// Convert_v1alpha1_Volume_To_azure_Volume is an autogenerated conversion function.
+func Convert_v1alpha1_Volume_To_azure_Volume(in *Volume, out *azure.Volume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Volume_To_azure_Volume(in, out, s)
+}
+
+func autoConvert_azure_Volume_To_v1alpha1_Volume(in *azure.Volume, out *Volume, s conversion.Scope) error {
+	out.SKU = (*string)(unsafe.Pointer(in.SKU))
+	return nil
+}
+
+// Convert_azure_Volume_To_v1alpha1_Volume is an autogenerated conversion function.
+func Convert_azure_Volume_To_v1alpha1_Volume(in *azure.Volume, out *Volume, s conversion.Scope) error {
+	return autoConvert_azure_Volume_To_v1alpha1_Volume(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in *WorkerConfig, out *azure.WorkerConfig, s conversion.Scope) error {
+	out.Volume = (*azure.Volume)(unsafe.Pointer(in.Volume))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.RollingUpdateTimeout = (*v1.Duration)(unsafe.Pointer(in.RollingUpdateTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in *WorkerConfig, out *azure.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in, out, s)
+}
+
+func autoConvert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in *azure.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.Volume = (*Volume)(unsafe.Pointer(in.Volume))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.RollingUpdateTimeout = (*v1.Duration)(unsafe.Pointer(in.RollingUpdateTimeout))
+	return nil
+}
+
+// Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in *azure.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_azure_WorkerStatus(in *WorkerStatus, out *azure.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]azure.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	return nil
@@ -0,0 +1,61 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object.
+func ValidateInfrastructureConfig(infra *apisazure.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if infra.ResourceGroup != nil && len(infra.ResourceGroup.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resourceGroup", "name"), "must provide a resource group name"))
+	}
+
+	networksPath := fldPath.Child("networks")
+	vnetPath := networksPath.Child("vnet")
+
+	vnet := infra.Networks.VNet
+	if vnet.Name != nil && len(*vnet.Name) == 0 {
+		allErrs = append(allErrs, field.Required(vnetPath.Child("name"), "must provide a vnet name"))
+	}
+	if vnet.CIDR != nil {
+		allErrs = append(allErrs, extensionsvalidation.ValidateCIDR(*vnet.CIDR, vnetPath.Child("cidr"))...)
+	}
+
+	workersPath := networksPath.Child("workers")
+	allErrs = append(allErrs, extensionsvalidation.ValidateCIDR(infra.Networks.Workers, workersPath)...)
+	if vnet.CIDR != nil {
+		allErrs = append(allErrs, extensionsvalidation.ValidateCIDRIsSubset(infra.Networks.Workers, *vnet.CIDR, workersPath)...)
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update. The resource group
+// and the network configuration are immutable.
+func ValidateInfrastructureConfigUpdate(oldInfra, newInfra *apisazure.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, extensionsvalidation.ValidateImmutableField(newInfra.ResourceGroup, oldInfra.ResourceGroup, fldPath.Child("resourceGroup"))...)
+	allErrs = append(allErrs, extensionsvalidation.ValidateImmutableField(newInfra.Networks, oldInfra.Networks, fldPath.Child("networks"))...)
+
+	return allErrs
+}
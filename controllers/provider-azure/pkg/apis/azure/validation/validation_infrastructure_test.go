@@ -0,0 +1,88 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisazure.InfrastructureConfig
+		fldPath              = field.NewPath("spec", "providerConfig")
+		vnetCIDR             = "10.250.0.0/16"
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisazure.InfrastructureConfig{
+			Networks: apisazure.NetworkConfig{
+				VNet: apisazure.VNet{
+					CIDR: &vnetCIDR,
+				},
+				Workers: "10.250.0.0/19",
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid a workers cidr that is not a subset of the vnet cidr", func() {
+			infrastructureConfig.Networks.Workers = "10.251.0.0/19"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.workers"),
+			}))))
+		})
+
+		It("should forbid an empty resource group name", func() {
+			infrastructureConfig.ResourceGroup = &apisazure.ResourceGroup{}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.resourceGroup.name"),
+			}))))
+		})
+
+		It("should allow an existing vnet", func() {
+			name := "existing-vnet"
+			infrastructureConfig.ResourceGroup = &apisazure.ResourceGroup{Name: "existing-rg"}
+			infrastructureConfig.Networks.VNet.Name = &name
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should forbid changing the networks", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Workers = "10.250.0.0/20"
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks"),
+			}))))
+		})
+	})
+})
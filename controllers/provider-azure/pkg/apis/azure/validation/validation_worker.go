@@ -0,0 +1,56 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	maxTagKeyLength   = 512
+	maxTagValueLength = 256
+)
+
+var (
+	reservedTagKeyPrefixes = []string{"kubernetes.io-cluster-", "kubernetes.io-role-"}
+	reservedTagKeys        = sets.NewString("Name")
+
+	availableOSDiskSKUs = sets.NewString("Standard_LRS", "StandardSSD_LRS", "Premium_LRS")
+)
+
+// ValidateWorkerConfig validates a WorkerConfig object.
+func ValidateWorkerConfig(workerConfig *apisazure.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if volume := workerConfig.Volume; volume != nil && volume.SKU != nil && !availableOSDiskSKUs.Has(*volume.SKU) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("volume", "sku"), *volume.SKU, availableOSDiskSKUs.List()))
+	}
+
+	tagsPath := fldPath.Child("tags")
+	allErrs = append(allErrs, extensionsvalidation.ValidateTags(workerConfig.Tags, reservedTagKeyPrefixes, maxTagKeyLength, maxTagValueLength, tagsPath)...)
+	for key := range workerConfig.Tags {
+		if reservedTagKeys.Has(key) {
+			allErrs = append(allErrs, field.Forbidden(tagsPath.Key(key), fmt.Sprintf("must not use a reserved key %v", reservedTagKeys.List())))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+)
+
+const vnetResourceType = "Microsoft.Network/virtualNetworks"
+
+// NewResourceClientFromClientAuth creates an Azure client to look up existing resources based on the given client auth.
+func NewResourceClientFromClientAuth(clientAuth *internal.ClientAuth) (*ResourceClient, error) {
+	clientCredConfig := auth.NewClientCredentialsConfig(clientAuth.ClientID, clientAuth.ClientSecret, clientAuth.TenantID)
+	authorizer, err := clientCredConfig.Authorizer()
+	if err != nil {
+		return nil, err
+	}
+
+	groupsClient := resources.NewGroupsClient(clientAuth.SubscriptionID)
+	groupsClient.Authorizer = authorizer
+
+	resourcesClient := resources.NewClient(clientAuth.SubscriptionID)
+	resourcesClient.Authorizer = authorizer
+
+	return &ResourceClient{
+		groupsClient:    groupsClient,
+		resourcesClient: resourcesClient,
+	}, nil
+}
+
+// ResourceGroupExists checks whether the resource group with the given name exists.
+func (r *ResourceClient) ResourceGroupExists(ctx context.Context, resourceGroupName string) (bool, error) {
+	response, err := r.groupsClient.CheckExistence(ctx, resourceGroupName)
+	if err != nil {
+		return false, err
+	}
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// VNetExists checks whether a virtual network with the given name exists in the given resource group.
+// The resource group has to exist.
+func (r *ResourceClient) VNetExists(ctx context.Context, resourceGroupName, vnetName string) (bool, error) {
+	filter := fmt.Sprintf("resourceType eq '%s'", vnetResourceType)
+	iter, err := r.resourcesClient.ListByResourceGroupComplete(ctx, resourceGroupName, filter, "", nil)
+	if err != nil {
+		return false, err
+	}
+
+	for iter.NotDone() {
+		if resource := iter.Value(); resource.Name != nil && *resource.Name == vnetName {
+			return true, nil
+		}
+		if err := iter.NextWithContext(ctx); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
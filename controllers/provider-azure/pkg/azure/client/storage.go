@@ -176,6 +176,9 @@ func (c *StorageClient) DeleteObjectsWithPrefix(ctx context.Context, container,
 		// Get a result segment starting with the blob indicated by the current Marker.
 		listBlob, err := containerURL.ListBlobsFlatSegment(ctx, marker, opts)
 		if err != nil {
+			if stgErr, ok := err.(azblob.StorageError); ok && stgErr.ServiceCode() == azblob.ServiceCodeContainerNotFound {
+				return nil
+			}
 			return fmt.Errorf("failed to list the blobs, error: %v", err)
 		}
 		marker = listBlob.NextMarker
@@ -17,6 +17,7 @@ package client
 import (
 	"context"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	"github.com/Azure/azure-storage-blob-go/azblob"
 )
 
@@ -40,3 +41,15 @@ type Storage interface {
 	CreateContainerIfNotExists(ctx context.Context, container string) error
 	DeleteContainerIfExists(ctx context.Context, container string) error
 }
+
+// ResourceClient represents an Azure client to look up existing resources.
+type ResourceClient struct {
+	groupsClient    resources.GroupsClient
+	resourcesClient resources.Client
+}
+
+// Resource represents an Azure client to look up existing resources.
+type Resource interface {
+	ResourceGroupExists(ctx context.Context, resourceGroupName string) (bool, error)
+	VNetExists(ctx context.Context, resourceGroupName, vnetName string) (bool, error)
+}
@@ -33,6 +33,14 @@ const (
 	MachineControllerManagerName = "machine-controller-manager"
 	// HyperkubeImageName is the name of the hyperkube image
 	HyperkubeImageName = "hyperkube"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSINodeDriverRegistrarImageName is the name of the CSI driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSIPluginImageName is the name of the CSI plugin image.
+	CSIPluginImageName = "azuredisk-csi"
 
 	// SubscriptionIDKey is the key for the subscription ID
 	SubscriptionIDKey = "subscriptionID"
@@ -68,6 +76,13 @@ const (
 	MachineControllerManagerVpaName = "machine-controller-manager-vpa"
 	// MachineControllerManagerMonitoringConfigName is the name of the ConfigMap containing monitoring stack configurations for machine-controller-manager.
 	MachineControllerManagerMonitoringConfigName = "machine-controller-manager-monitoring-config"
+
+	// CSIVersionConstraint is the constraint for the shoot Kubernetes versions for which the Azure Disk CSI driver is
+	// deployed and in-tree Azure Disk volumes are migrated to it.
+	CSIVersionConstraint = ">= 1.19"
+	// InTreeVolumePluginVersionConstraint is the constraint for the shoot Kubernetes versions for which the in-tree
+	// Azure Disk volume plugin is used.
+	InTreeVolumePluginVersionConstraint = "< 1.19"
 )
 
 var (
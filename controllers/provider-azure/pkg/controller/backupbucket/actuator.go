@@ -20,29 +20,22 @@ import (
 
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
 	azureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure/client"
-	extensioncontroller "github.com/gardener/gardener-extensions/pkg/controller"
-	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/go-logr/logr"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
-	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
 type actuator struct {
-	backupbucket.Actuator
 	client client.Client
 	logger logr.Logger
 }
 
-func newActuator() backupbucket.Actuator {
+func newActuator() genericactuator.BackupBucketDelegate {
 	return &actuator{
-		logger: log.Log.WithName("azure-backupbucket-actuator"),
+		logger: logger,
 	}
 }
 
@@ -51,85 +44,70 @@ func (a *actuator) InjectClient(client client.Client) error {
 	return nil
 }
 
-func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
-	azureClient, err := a.getAzureClient(ctx, bb)
+// GetGeneratedSecretData creates the storage account for the given BackupBucket and returns its credentials.
+func (a *actuator) GetGeneratedSecretData(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) (map[string][]byte, error) {
+	backupBucketNameSha := utils.ComputeSHA1Hex([]byte(bb.Name))
+	storageAccountName := fmt.Sprintf("bkp%s", backupBucketNameSha[:15])
+	storageAuth, err := azureclient.NewStorageClientAuthFromSubscriptionSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Name, storageAccountName, bb.Spec.Region)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return azureClient.CreateContainerIfNotExists(ctx, bb.Name)
+	return map[string][]byte{
+		azure.StorageAccount: storageAuth.StorageAccount,
+		azure.StorageKey:     storageAuth.StorageKey,
+	}, nil
 }
 
-func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+func (a *actuator) CreateBucketIfNotExists(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
 	azureClient, err := a.getAzureClient(ctx, bb)
 	if err != nil {
 		return err
 	}
 
-	if err := azureClient.DeleteContainerIfExists(ctx, bb.Name); err != nil {
-		return err
-	}
+	return azureClient.CreateContainerIfNotExists(ctx, bb.Name)
+}
 
-	return a.deleteGenerateBackupBucketSecret(ctx, bb)
+// EnsureLifecyclePolicy does nothing as the lifecycle of the blobs is managed by etcd-backup-restore.
+func (a *actuator) EnsureLifecyclePolicy(_ context.Context, _ *extensionsv1alpha1.BackupBucket) error {
+	return nil
 }
 
-func (a *actuator) getAzureClient(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) (*azureclient.StorageClient, error) {
-	if bb.Status.GeneratedSecretRef != nil {
-		return azureclient.NewStorageClientFromSecretRef(ctx, a.client, bb.Status.GeneratedSecretRef)
+func (a *actuator) DeleteObjectsWithPrefix(ctx context.Context, bb *extensionsv1alpha1.BackupBucket, prefix string) error {
+	if bb.Status.GeneratedSecretRef == nil {
+		return nil
 	}
-	backupBucketNameSha := utils.ComputeSHA1Hex([]byte(bb.Name))
-	storageAccountName := fmt.Sprintf("bkp%s", backupBucketNameSha[:15])
-	storageAuth, err := azureclient.NewStorageClientAuthFromSubscriptionSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Name, storageAccountName, bb.Spec.Region)
+
+	azureClient, err := a.getAzureClient(ctx, bb)
 	if err != nil {
-		return nil, err
-	}
-	generatedSecret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      generateGeneratedBackupBucketSecretName(bb.Name),
-			Namespace: "garden",
-		},
+		return err
 	}
 
-	if _, err := controllerutil.CreateOrUpdate(ctx, a.client, generatedSecret, func() error {
-		generatedSecret.Data = map[string][]byte{
-			azure.StorageAccount: storageAuth.StorageAccount,
-			azure.StorageKey:     storageAuth.StorageKey,
-		}
+	return azureClient.DeleteObjectsWithPrefix(ctx, bb.Name, prefix)
+}
+
+// DeleteBucketIfExists deletes the container as well as the resource group holding the storage account of the
+// given BackupBucket.
+func (a *actuator) DeleteBucketIfExists(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	if bb.Status.GeneratedSecretRef == nil {
 		return nil
-	}); err != nil {
-		return nil, err
 	}
 
-	if err := extensioncontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, bb, func() error {
-		bb.Status.GeneratedSecretRef = &corev1.SecretReference{
-			Name:      generatedSecret.Name,
-			Namespace: generatedSecret.Namespace,
-		}
-		return nil
-	}); err != nil {
-		return nil, err
+	azureClient, err := a.getAzureClient(ctx, bb)
+	if err != nil {
+		return err
 	}
 
-	return azureclient.NewStorageClientFromStorageAuth(storageAuth)
-}
+	if err := azureClient.DeleteContainerIfExists(ctx, bb.Name); err != nil {
+		return err
+	}
 
-func generateGeneratedBackupBucketSecretName(backupBucketName string) string {
-	return fmt.Sprintf("generated-bucket-%s", backupBucketName)
+	return azureclient.DeleteResourceGroupFromSubscriptionSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Name)
 }
 
-// deleteGenerateBackupBucketSecret deletes generated secret referred by core BackupBucket resource in garden.
-func (a *actuator) deleteGenerateBackupBucketSecret(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
-	if bb.Status.GeneratedSecretRef != nil {
-		if err := azureclient.DeleteResourceGroupFromSubscriptionSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Name); err != nil {
-			return err
-		}
-		secret := &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      bb.Status.GeneratedSecretRef.Name,
-				Namespace: bb.Status.GeneratedSecretRef.Namespace,
-			},
-		}
-		return client.IgnoreNotFound(a.client.Delete(ctx, secret))
+func (a *actuator) getAzureClient(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) (*azureclient.StorageClient, error) {
+	if bb.Status.GeneratedSecretRef == nil {
+		return nil, fmt.Errorf("generated secret of backupbucket '%s' is not referenced in its status yet", bb.Name)
 	}
-	return nil
+	return azureclient.NewStorageClientFromSecretRef(ctx, a.client, bb.Status.GeneratedSecretRef)
 }
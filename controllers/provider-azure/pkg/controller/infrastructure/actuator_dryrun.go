@@ -0,0 +1,67 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/infrastructure"
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	infrastructurecontroller "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// DryRun implements infrastructure.DryRunner.
+func (a *actuator) DryRun(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) (*controller.DryRunResult, error) {
+	config, err := internal.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, err
+	}
+
+	clientAuth, err := infrastructure.GetClientAuthFromInfrastructure(ctx, a.client, infra)
+	if err != nil {
+		return nil, err
+	}
+
+	terraformFiles, err := infrastructure.RenderTerraformerChart(a.chartRenderer, infra, clientAuth, config, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := internal.NewTerraformer(a.terraformerFactory, a.restConfig, clientAuth, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := infrastructurecontroller.CurrentTerraformState(infra, tf)
+	if err != nil {
+		return nil, err
+	}
+
+	planTF, err := internal.NewPlanTerraformer(a.terraformerFactory, a.restConfig, clientAuth, infrastructurecontroller.TerraformPlanPurpose(infrastructure.TerraformerPurpose), infra.Namespace, infra.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return infrastructurecontroller.PlanTerraform(
+		ctx,
+		a.client,
+		planTF,
+		a.terraformerFactory.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars),
+		state,
+	)
+}
@@ -18,13 +18,15 @@ import (
 	"context"
 	"time"
 
+	azureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure/client"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/infrastructure"
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	infrastructurecontroller "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 )
 
 // Reconcile implements infrastructure.Actuator.
@@ -39,23 +41,48 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 		return err
 	}
 
+	resourceClient, err := azureclient.NewResourceClientFromClientAuth(clientAuth)
+	if err != nil {
+		return err
+	}
+
+	if err := infrastructure.CheckExistingResources(ctx, resourceClient, infra, config); err != nil {
+		return err
+	}
+
 	terraformFiles, err := infrastructure.RenderTerraformerChart(a.chartRenderer, infra, clientAuth, config, cluster)
 	if err != nil {
 		return err
 	}
 
-	tf, err := internal.NewTerraformer(a.restConfig, clientAuth, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
+	tf, err := internal.NewTerraformer(a.terraformerFactory, a.restConfig, clientAuth, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
 	if err != nil {
 		return err
 	}
 
-	if err := tf.
-		InitializeWith(terraformer.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars)).
-		Apply(); err != nil {
+	state, err := infrastructurecontroller.GetTerraformState(infra)
+	if err != nil {
+		return err
+	}
+
+	applyErr := tf.
+		InitializeWith(extensionsterraformer.WithState(
+			a.client,
+			a.terraformerFactory.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars),
+			state),
+		).
+		Apply()
+
+	// The Terraform state is persisted even if the apply failed as it might already reference created resources.
+	if err := infrastructurecontroller.SaveTerraformState(ctx, a.client, tf, infra); err != nil {
+		return err
+	}
 
-		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infra.Name)
+	infrastructurecontroller.ReportTerraformApplyResult(ctx, a.logger, infra, applyErr)
+	if applyErr != nil {
+		a.logger.Error(applyErr, "failed to apply the terraform config", "infrastructure", infra.Name)
 		return &controllererrors.RequeueAfterError{
-			Cause:        err,
+			Cause:        applyErr,
 			RequeueAfter: 30 * time.Second,
 		}
 	}
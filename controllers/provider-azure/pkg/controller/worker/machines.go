@@ -120,6 +120,12 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			URN:       urn,
 		})
 
+		workerConfig := &azureapi.WorkerConfig{}
+		providerConfig, err := worker.DecodeProviderConfig(w.decoder, pool, workerConfig)
+		if err != nil {
+			return err
+		}
+
 		volumeSize, err := worker.DiskSize(pool.Volume.Size)
 		if err != nil {
 			return err
@@ -141,11 +147,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			"vnetName":          infrastructureStatus.Networks.VNet.Name,
 			"subnetName":        nodesSubnet.Name,
 			"availabilitySetID": nodesAvailabilitySet.ID,
-			"tags": map[string]interface{}{
-				"Name": w.worker.Namespace,
-				fmt.Sprintf("kubernetes.io-cluster-%s", w.worker.Namespace): "1",
-				"kubernetes.io-role-node":                                   "1",
-			},
+			"tags":              computeTags(w.worker.Namespace, workerConfig),
 			"secret": map[string]interface{}{
 				"cloudConfig": string(pool.UserData),
 			},
@@ -155,23 +157,32 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			"sshPublicKey": string(w.worker.Spec.SSHPublicKey),
 		}
 
+		if workerConfig.Volume != nil && workerConfig.Volume.SKU != nil {
+			machineClassSpec["volumeType"] = *workerConfig.Volume.SKU
+		}
+
+		machineClassSpecHash, err := worker.MachineClassHashWithProviderConfig(machineClassSpec, shootVersionMajorMinor, providerConfig)
+		if err != nil {
+			return err
+		}
+
 		var (
-			machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-			deploymentName       = fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name)
-			className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+			deploymentName = fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name)
+			className      = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 		)
 
 		machineDeployments = append(machineDeployments, worker.MachineDeployment{
-			Name:           deploymentName,
-			ClassName:      className,
-			SecretName:     className,
-			Minimum:        pool.Minimum,
-			Maximum:        pool.Maximum,
-			MaxSurge:       pool.MaxSurge,
-			MaxUnavailable: pool.MaxUnavailable,
-			Labels:         pool.Labels,
-			Annotations:    pool.Annotations,
-			Taints:         pool.Taints,
+			Name:                 deploymentName,
+			ClassName:            className,
+			SecretName:           className,
+			Minimum:              pool.Minimum,
+			Maximum:              pool.Maximum,
+			MaxSurge:             pool.MaxSurge,
+			MaxUnavailable:       pool.MaxUnavailable,
+			Labels:               pool.Labels,
+			Annotations:          pool.Annotations,
+			Taints:               pool.Taints,
+			RollingUpdateTimeout: worker.RollingUpdateTimeout(workerConfig.RollingUpdateTimeout),
 		})
 
 		machineClassSpec["name"] = className
@@ -189,3 +200,20 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// computeTags computes the tags of the machines, i.e. the additional tags of the given WorkerConfig and the tags that
+// are required by Kubernetes.
+func computeTags(clusterName string, workerConfig *azureapi.WorkerConfig) map[string]interface{} {
+	requiredTags := map[string]string{
+		"Name": clusterName,
+		fmt.Sprintf("kubernetes.io-cluster-%s", clusterName): "1",
+		"kubernetes.io-role-node":                            "1",
+	}
+
+	// The tags have always been rendered as map[string]interface{}, which keeps the names of existing machine classes.
+	tags := map[string]interface{}{}
+	for key, value := range worker.MergeTags(workerConfig.Tags, requiredTags) {
+		tags[key] = value
+	}
+	return tags
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
 	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
@@ -377,6 +378,93 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should render the worker config of the pools into the machine classes", func() {
+				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+				premium := "Premium_LRS"
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&azurev1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: azurev1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						Volume: &azurev1alpha1.Volume{
+							SKU: &premium,
+						},
+						Tags: map[string]string{
+							"team": "foo",
+							"Name": "bar",
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				var machineClasses []map[string]interface{}
+				chartApplier.
+					EXPECT().
+					ApplyChart(context.TODO(), filepath.Join(azure.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _, _, _ string, values, _ map[string]interface{}) error {
+						machineClasses = values["machineClasses"].([]map[string]interface{})
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+				Expect(machineClasses).To(HaveLen(2))
+
+				pool1Class, pool2Class := machineClasses[0], machineClasses[1]
+				Expect(pool1Class["volumeType"]).To(Equal(premium))
+				Expect(pool1Class["tags"]).To(Equal(map[string]interface{}{
+					"team": "foo",
+					"Name": namespace,
+					fmt.Sprintf("kubernetes.io-cluster-%s", namespace): "1",
+					"kubernetes.io-role-node":                          "1",
+				}))
+				Expect(pool2Class).NotTo(HaveKey("volumeType"))
+			})
+
+			It("should roll the machines if only the tags of a pool change", func() {
+				workerConfigWithTags := func(tags map[string]string) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Raw: encode(&azurev1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: azurev1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							Tags: tags,
+						}),
+					}
+				}
+				classHash := func(index int, name string) string {
+					expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+					workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					return strings.TrimPrefix(result[index].ClassName, fmt.Sprintf("%s-%s-", namespace, name))
+				}
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithTags(map[string]string{"team": "foo"})
+				hashFoo := classHash(0, namePool1)
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithTags(map[string]string{"team": "bar"})
+				Expect(classHash(0, namePool1)).NotTo(Equal(hashFoo))
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithTags(map[string]string{"team": "foo"})
+				Expect(classHash(0, namePool1)).To(Equal(hashFoo))
+			})
+
+			It("should fail because the worker config cannot be decoded", func() {
+				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte("invalid")}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -16,10 +16,15 @@ package infrastructure
 
 import (
 	"context"
+	"fmt"
 
+	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
+	azureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure/client"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
@@ -27,3 +32,48 @@ import (
 func GetClientAuthFromInfrastructure(ctx context.Context, c client.Client, config *extensionsv1alpha1.Infrastructure) (*internal.ClientAuth, error) {
 	return internal.GetClientAuthData(ctx, c, config.Spec.SecretRef)
 }
+
+// CheckExistingResources verifies that the resource group and the VNet the given InfrastructureConfig refers to
+// exist. If one of them is missing, an error with the gardencorev1alpha1.ErrorInfraDependencies code is returned.
+func CheckExistingResources(ctx context.Context, c azureclient.Resource, infra *extensionsv1alpha1.Infrastructure, config *azurev1alpha1.InfrastructureConfig) error {
+	resourceGroupName := infra.Namespace
+	if config.ResourceGroup != nil {
+		resourceGroupName = config.ResourceGroup.Name
+		if err := checkResourceGroupExists(ctx, c, resourceGroupName); err != nil {
+			return err
+		}
+	}
+
+	vnet := config.Networks.VNet
+	if vnet.Name == nil {
+		return nil
+	}
+
+	// An existing VNet resides in the resource group of the cluster, see the validation of the InfrastructureConfig.
+	// A pre-existing resource group of the cluster has already been checked above.
+	if config.ResourceGroup == nil {
+		if err := checkResourceGroupExists(ctx, c, resourceGroupName); err != nil {
+			return err
+		}
+	}
+
+	exists, err := c.VNetExists(ctx, resourceGroupName, *vnet.Name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraDependencies, fmt.Sprintf("vnet %s does not exist in resource group %s", *vnet.Name, resourceGroupName))
+	}
+	return nil
+}
+
+func checkResourceGroupExists(ctx context.Context, c azureclient.Resource, resourceGroupName string) error {
+	exists, err := c.ResourceGroupExists(ctx, resourceGroupName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraDependencies, fmt.Sprintf("resource group %s does not exist", resourceGroupName))
+	}
+	return nil
+}
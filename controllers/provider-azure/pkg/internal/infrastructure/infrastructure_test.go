@@ -0,0 +1,117 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+
+	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
+	mockazureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure/mock/client"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Infrastructure", func() {
+	var (
+		ctrl *gomock.Controller
+	)
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+	})
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#CheckExistingResources", func() {
+		var (
+			ctx               = context.TODO()
+			resourceGroupName = "existing-rg"
+			vnetName          = "existing-vnet"
+
+			infra  *extensionsv1alpha1.Infrastructure
+			config *azurev1alpha1.InfrastructureConfig
+			client *mockazureclient.MockResource
+		)
+
+		BeforeEach(func() {
+			infra = &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "infra"},
+			}
+			config = &azurev1alpha1.InfrastructureConfig{
+				Networks: azurev1alpha1.NetworkConfig{
+					Workers: "10.250.0.0/19",
+				},
+			}
+			client = mockazureclient.NewMockResource(ctrl)
+		})
+
+		It("should not look up anything if no existing resources are referenced", func() {
+			Expect(CheckExistingResources(ctx, client, infra, config)).To(Succeed())
+		})
+
+		It("should succeed if the resource group and the vnet exist", func() {
+			config.ResourceGroup = &azurev1alpha1.ResourceGroup{Name: resourceGroupName}
+			config.Networks.VNet.Name = &vnetName
+
+			gomock.InOrder(
+				client.EXPECT().ResourceGroupExists(ctx, resourceGroupName).Return(true, nil),
+				client.EXPECT().VNetExists(ctx, resourceGroupName, vnetName).Return(true, nil),
+			)
+
+			Expect(CheckExistingResources(ctx, client, infra, config)).To(Succeed())
+		})
+
+		It("should check the resource group of the vnet if the resource group of the cluster is not pre-existing", func() {
+			config.Networks.VNet.Name = &vnetName
+
+			gomock.InOrder(
+				client.EXPECT().ResourceGroupExists(ctx, infra.Namespace).Return(true, nil),
+				client.EXPECT().VNetExists(ctx, infra.Namespace, vnetName).Return(true, nil),
+			)
+
+			Expect(CheckExistingResources(ctx, client, infra, config)).To(Succeed())
+		})
+
+		It("should fail with a dependency error if the resource group does not exist", func() {
+			config.ResourceGroup = &azurev1alpha1.ResourceGroup{Name: resourceGroupName}
+
+			client.EXPECT().ResourceGroupExists(ctx, resourceGroupName).Return(false, nil)
+
+			err := CheckExistingResources(ctx, client, infra, config)
+			Expect(err).To(HaveOccurred())
+			Expect(gardencorev1alpha1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1alpha1.ErrorInfraDependencies))
+		})
+
+		It("should fail with a dependency error if the vnet does not exist", func() {
+			config.ResourceGroup = &azurev1alpha1.ResourceGroup{Name: resourceGroupName}
+			config.Networks.VNet.Name = &vnetName
+
+			gomock.InOrder(
+				client.EXPECT().ResourceGroupExists(ctx, resourceGroupName).Return(true, nil),
+				client.EXPECT().VNetExists(ctx, resourceGroupName, vnetName).Return(false, nil),
+			)
+
+			err := CheckExistingResources(ctx, client, infra, config)
+			Expect(err).To(HaveOccurred())
+			Expect(gardencorev1alpha1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1alpha1.ErrorInfraDependencies))
+		})
+	})
+})
@@ -21,11 +21,11 @@ import (
 	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -88,7 +88,7 @@ func ComputeTerraformerChartValues(infra *extensionsv1alpha1.Infrastructure, cli
 		resourceGroupName = config.ResourceGroup.Name
 	}
 
-	// check if we should use an existing ResourceGroup or create a new one
+	// check if we should use an existing VNet or create a new one
 	if config.Networks.VNet.Name != nil {
 		createVNet = false
 		vnetName = *config.Networks.VNet.Name
@@ -194,31 +194,47 @@ type TerraformState struct {
 }
 
 // ExtractTerraformState extracts the TerraformState from the given Terraformer.
-func ExtractTerraformState(tf *terraformer.Terraformer, config *azurev1alpha1.InfrastructureConfig) (*TerraformState, error) {
-	outputKeys := []string{
-		TerraformerOutputKeyAvailabilitySetID,
-		TerraformerOutputKeyAvailabilitySetName,
-		TerraformerOutputKeyResourceGroupName,
-		TerraformerOutputKeyRouteTableName,
-		TerraformerOutputKeySecurityGroupName,
-		TerraformerOutputKeySubnetName,
-		TerraformerOutputKeyVNetName,
-	}
-
-	vars, err := tf.GetStateOutputVariables(outputKeys...)
+func ExtractTerraformState(tf extensionsterraformer.StateGetter, config *azurev1alpha1.InfrastructureConfig) (*TerraformState, error) {
+	state, err := extensionsterraformer.ReadState(tf)
 	if err != nil {
 		return nil, err
 	}
 
-	return &TerraformState{
-		AvailabilitySetID:   vars[TerraformerOutputKeyAvailabilitySetID],
-		AvailabilitySetName: vars[TerraformerOutputKeyAvailabilitySetName],
-		VNetName:            vars[TerraformerOutputKeyVNetName],
-		ResourceGroupName:   vars[TerraformerOutputKeyResourceGroupName],
-		RouteTableName:      vars[TerraformerOutputKeyRouteTableName],
-		SecurityGroupName:   vars[TerraformerOutputKeySecurityGroupName],
-		SubnetName:          vars[TerraformerOutputKeySubnetName],
-	}, nil
+	return TerraformStateFromState(state)
+}
+
+// TerraformStateFromState computes the TerraformState from the resources of the given typed Terraform state.
+func TerraformStateFromState(state *extensionsterraformer.State) (*TerraformState, error) {
+	var (
+		out    = &TerraformState{}
+		fields = []struct {
+			resourceType, resourceName, attribute string
+			value                                 *string
+		}{
+			{"azurerm_subnet", "workers", "name", &out.SubnetName},
+			{"azurerm_subnet", "workers", "virtual_network_name", &out.VNetName},
+			{"azurerm_availability_set", "workers", "id", &out.AvailabilitySetID},
+			{"azurerm_availability_set", "workers", "name", &out.AvailabilitySetName},
+			{"azurerm_route_table", "workers", "name", &out.RouteTableName},
+			{"azurerm_route_table", "workers", "resource_group_name", &out.ResourceGroupName},
+			{"azurerm_network_security_group", "workers", "name", &out.SecurityGroupName},
+		}
+	)
+
+	for _, field := range fields {
+		resource := state.Resource(field.resourceType, field.resourceName)
+		if resource == nil {
+			return nil, fmt.Errorf("resource %s.%s not found in Terraform state", field.resourceType, field.resourceName)
+		}
+
+		value, err := resource.StringAttribute(field.attribute)
+		if err != nil {
+			return nil, err
+		}
+		*field.value = value
+	}
+
+	return out, nil
 }
 
 // StatusFromTerraformState computes an InfrastructureStatus from the given
@@ -253,7 +269,7 @@ func StatusFromTerraformState(state *TerraformState) *azurev1alpha1.Infrastructu
 }
 
 // ComputeStatus computes the status based on the Terraformer and the given InfrastructureConfig.
-func ComputeStatus(tf *terraformer.Terraformer, config *azurev1alpha1.InfrastructureConfig) (*azurev1alpha1.InfrastructureStatus, error) {
+func ComputeStatus(tf extensionsterraformer.StateGetter, config *azurev1alpha1.InfrastructureConfig) (*azurev1alpha1.InfrastructureStatus, error) {
 	state, err := ExtractTerraformState(tf, config)
 	if err != nil {
 		return nil, err
@@ -18,6 +18,7 @@ import (
 	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -156,6 +157,58 @@ var _ = Describe("Terraform", func() {
 			}
 			Expect(values).To(BeEquivalentTo(expectedValues))
 		})
+
+		It("should use an existing vnet in the existing resource group", func() {
+			config.ResourceGroup = &azurev1alpha1.ResourceGroup{Name: "rg"}
+
+			values, err := ComputeTerraformerChartValues(infra, clientAuth, config, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(values["create"]).To(Equal(map[string]interface{}{
+				"resourceGroup": false,
+				"vnet":          false,
+			}))
+			Expect(values["resourceGroup"]).To(Equal(map[string]interface{}{
+				"name": "rg",
+				"vnet": map[string]interface{}{
+					"name": *config.Networks.VNet.Name,
+					"cidr": config.Networks.Workers,
+				},
+			}))
+		})
+	})
+
+	Describe("#TerraformStateFromState", func() {
+		It("should read the names and ids from the resources of the state", func() {
+			state, err := extensionsterraformer.ParseState([]byte(`{
+  "version": 4,
+  "resources": [
+    {"mode": "managed", "type": "azurerm_subnet", "name": "workers", "instances": [{"attributes": {"name": "subnet_name", "virtual_network_name": "vnet_name"}}]},
+    {"mode": "managed", "type": "azurerm_availability_set", "name": "workers", "instances": [{"attributes": {"id": "as_id", "name": "as_name"}}]},
+    {"mode": "managed", "type": "azurerm_route_table", "name": "workers", "instances": [{"attributes": {"name": "routeTable_name", "resource_group_name": "rg_name"}}]},
+    {"mode": "managed", "type": "azurerm_network_security_group", "name": "workers", "instances": [{"attributes": {"name": "sg_name"}}]}
+  ]
+}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(TerraformStateFromState(state)).To(Equal(&TerraformState{
+				VNetName:            "vnet_name",
+				ResourceGroupName:   "rg_name",
+				AvailabilitySetID:   "as_id",
+				AvailabilitySetName: "as_name",
+				SubnetName:          "subnet_name",
+				RouteTableName:      "routeTable_name",
+				SecurityGroupName:   "sg_name",
+			}))
+		})
+
+		It("should fail if a resource is missing", func() {
+			state, err := extensionsterraformer.ParseState([]byte(`{"version": 4, "resources": []}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = TerraformStateFromState(state)
+			Expect(err).To(HaveOccurred())
+		})
 	})
 
 	Describe("#StatusFromTerraformState", func() {
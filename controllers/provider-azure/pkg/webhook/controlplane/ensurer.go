@@ -18,7 +18,9 @@ import (
 	"context"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	extensionscontext "github.com/gardener/gardener-extensions/pkg/webhook/context"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/genericmutator"
 
@@ -54,7 +56,7 @@ func (e *ensurer) InjectClient(client client.Client) error {
 }
 
 // EnsureKubeAPIServerDeployment ensures that the kube-apiserver deployment conforms to the provider requirements.
-func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1.Deployment) error {
+func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, gctx extensionscontext.GardenContext, dep *appsv1.Deployment) error {
 	template := &dep.Spec.Template
 	ps := &template.Spec
 	if c := extensionswebhook.ContainerWithName(ps.Containers, "kube-apiserver"); c != nil {
@@ -66,11 +68,13 @@ func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1
 }
 
 // EnsureKubeControllerManagerDeployment ensures that the kube-controller-manager deployment conforms to the provider requirements.
-func (e *ensurer) EnsureKubeControllerManagerDeployment(ctx context.Context, dep *appsv1.Deployment) error {
+func (e *ensurer) EnsureKubeControllerManagerDeployment(ctx context.Context, gctx extensionscontext.GardenContext, dep *appsv1.Deployment) error {
 	template := &dep.Spec.Template
 	ps := &template.Spec
 	if c := extensionswebhook.ContainerWithName(ps.Containers, "kube-controller-manager"); c != nil {
-		ensureKubeControllerManagerCommandLineArgs(c)
+		if err := ensureCommandLineArgs(ctx, gctx, c, kubeControllerManagerFlags); err != nil {
+			return err
+		}
 		ensureVolumeMounts(c)
 	}
 	ensureKubeControllerManagerAnnotations(template)
@@ -88,11 +92,32 @@ func ensureKubeAPIServerCommandLineArgs(c *corev1.Container) {
 		"PersistentVolumeLabel", ",")
 }
 
-func ensureKubeControllerManagerCommandLineArgs(c *corev1.Container) {
-	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--cloud-provider=", "external")
-	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--cloud-config=",
-		"/etc/kubernetes/cloudprovider/cloudprovider.conf")
-	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--external-cloud-volume-plugin=", "azure")
+var (
+	kubeControllerManagerFlags = []extensionswebhook.VersionedFlag{
+		extensionswebhook.EnsureFlag("--cloud-provider", "external"),
+		extensionswebhook.EnsureFlag("--cloud-config", "/etc/kubernetes/cloudprovider/cloudprovider.conf"),
+		// The in-tree Azure File volume plugin is not migrated to CSI, hence it is kept for all versions
+		extensionswebhook.EnsureFlag("--external-cloud-volume-plugin", "azure"),
+		extensionswebhook.RemoveFlagListItem("--feature-gates", "CSIMigration=false").ForVersions(azure.CSIVersionConstraint),
+		extensionswebhook.EnsureFlagListItem("--feature-gates", "CSIMigration=true").ForVersions(azure.CSIVersionConstraint),
+		extensionswebhook.RemoveFlagListItem("--feature-gates", "CSIMigrationAzureDisk=false").ForVersions(azure.CSIVersionConstraint),
+		extensionswebhook.EnsureFlagListItem("--feature-gates", "CSIMigrationAzureDisk=true").ForVersions(azure.CSIVersionConstraint),
+	}
+	kubeletFlags = []extensionswebhook.VersionedFlag{
+		extensionswebhook.EnsureFlag("--cloud-provider", "azure").ForVersions(azure.InTreeVolumePluginVersionConstraint),
+		extensionswebhook.EnsureFlag("--cloud-config", "/var/lib/kubelet/cloudprovider.conf").ForVersions(azure.InTreeVolumePluginVersionConstraint),
+		extensionswebhook.EnsureFlag("--cloud-provider", "external").ForVersions(azure.CSIVersionConstraint),
+		extensionswebhook.RemoveFlag("--cloud-config").ForVersions(azure.CSIVersionConstraint),
+	}
+)
+
+func ensureCommandLineArgs(ctx context.Context, gctx extensionscontext.GardenContext, c *corev1.Container, flags []extensionswebhook.VersionedFlag) error {
+	command, err := controlplane.EnsureVersionedFlags(ctx, gctx, c.Command, flags...)
+	if err != nil {
+		return err
+	}
+	c.Command = command
+	return nil
 }
 
 func ensureKubeControllerManagerAnnotations(t *corev1.PodTemplateSpec) {
@@ -129,23 +154,39 @@ func (e *ensurer) ensureChecksumAnnotations(ctx context.Context, template *corev
 }
 
 // EnsureKubeletServiceUnitOptions ensures that the kubelet.service unit options conform to the provider requirements.
-func (e *ensurer) EnsureKubeletServiceUnitOptions(ctx context.Context, opts []*unit.UnitOption) ([]*unit.UnitOption, error) {
+func (e *ensurer) EnsureKubeletServiceUnitOptions(ctx context.Context, gctx extensionscontext.GardenContext, opts []*unit.UnitOption) ([]*unit.UnitOption, error) {
 	if opt := extensionswebhook.UnitOptionWithSectionAndName(opts, "Service", "ExecStart"); opt != nil {
 		command := extensionswebhook.DeserializeCommandLine(opt.Value)
-		command = ensureKubeletCommandLineArgs(command)
+		command, err := controlplane.EnsureVersionedFlags(ctx, gctx, command, kubeletFlags...)
+		if err != nil {
+			return nil, err
+		}
 		opt.Value = extensionswebhook.SerializeCommandLine(command, 1, " \\\n    ")
 	}
 	return opts, nil
 }
 
-func ensureKubeletCommandLineArgs(command []string) []string {
-	command = extensionswebhook.EnsureStringWithPrefix(command, "--cloud-provider=", "azure")
-	command = extensionswebhook.EnsureStringWithPrefix(command, "--cloud-config=", "/var/lib/kubelet/cloudprovider.conf")
-	return command
-}
-
 // EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
+func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, gctx extensionscontext.GardenContext, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
+	version, err := gctx.GetKubernetesVersion(ctx)
+	if err != nil {
+		return err
+	}
+	csiEnabled, err := util.VersionMatchesConstraint(version, azure.CSIVersionConstraint)
+	if err != nil {
+		return err
+	}
+
+	if csiEnabled {
+		// Ensure in-tree Azure Disk volumes are migrated to the CSI driver
+		if kubeletConfig.FeatureGates == nil {
+			kubeletConfig.FeatureGates = make(map[string]bool)
+		}
+		kubeletConfig.FeatureGates["CSIMigration"] = true
+		kubeletConfig.FeatureGates["CSIMigrationAzureDisk"] = true
+		return nil
+	}
+
 	// Make sure CSI-related feature gates are not enabled
 	// TODO Leaving these enabled shouldn't do any harm, perhaps remove this code when properly tested?
 	delete(kubeletConfig.FeatureGates, "VolumeSnapshotDataSource")
@@ -160,7 +201,7 @@ func (e *ensurer) ShouldProvisionKubeletCloudProviderConfig() bool {
 }
 
 // EnsureKubeletCloudProviderConfig ensures that the cloud provider config file conforms to the provider requirements.
-func (e *ensurer) EnsureKubeletCloudProviderConfig(ctx context.Context, data *string, namespace string) error {
+func (e *ensurer) EnsureKubeletCloudProviderConfig(ctx context.Context, gctx extensionscontext.GardenContext, data *string, namespace string) error {
 	// Get `cloud-provider-config` ConfigMap
 	var cm corev1.ConfigMap
 	err := e.client.Get(ctx, kutil.Key(namespace, azure.CloudProviderKubeletConfigName), &cm)
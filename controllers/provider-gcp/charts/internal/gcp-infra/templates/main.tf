@@ -39,6 +39,22 @@ resource "google_compute_subnetwork" "subnetwork-internal" {
   region        = "{{ required "google.region is required" .Values.google.region }}"
 }
 {{- end}}
+
+{{ if .Values.vpc.cloudRouter -}}
+// The Cloud Router of an existing VPC is reused, only the NAT for the nodes subnet is created.
+resource "google_compute_router_nat" "nat" {
+  name                               = "{{ required "clusterName is required" .Values.clusterName }}-cloud-nat"
+  router                             = "{{ required "vpc.cloudRouter.name is required" .Values.vpc.cloudRouter.name }}"
+  region                             = "{{ required "google.region is required" .Values.google.region }}"
+  nat_ip_allocate_option             = "AUTO_ONLY"
+  source_subnetwork_ip_ranges_to_nat = "LIST_OF_SUBNETWORKS"
+
+  subnetwork {
+    name                    = "${google_compute_subnetwork.subnetwork-nodes.self_link}"
+    source_ip_ranges_to_nat = ["ALL_IP_RANGES"]
+  }
+}
+{{- end}}
 //=====================================================================
 //= Firewall
 //=====================================================================
@@ -133,3 +149,8 @@ output "{{ .Values.outputKeys.subnetInternal }}" {
   value = "${google_compute_subnetwork.subnetwork-internal.name}"
 }
 {{- end}}
+{{ if .Values.vpc.cloudRouter -}}
+output "{{ .Values.outputKeys.cloudRouter }}" {
+  value = "{{ required "vpc.cloudRouter.name is required" .Values.vpc.cloudRouter.name }}"
+}
+{{- end}}
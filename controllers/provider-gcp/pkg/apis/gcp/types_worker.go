@@ -20,6 +20,38 @@ import (
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// ServiceAccountScopes are the OAuth scopes of the service account attached to the machines. They replace the
+	// default scope `https://www.googleapis.com/auth/compute`.
+	ServiceAccountScopes []string
+	// Preemptible indicates whether preemptible VMs are used for the machines.
+	Preemptible *bool
+	// DataDisks contains configuration for additional persistent disks attached to the machines.
+	DataDisks []DataDisk
+	// Labels are additional labels that are added to the machines and their disks.
+	Labels map[string]string
+	// Tags are additional network tags that are added to the machines.
+	Tags []string
+	// RollingUpdateTimeout is the maximum duration of a rolling update of the machines. It is excluded from the
+	// machine class hash as it does not affect the machines.
+	RollingUpdateTimeout *metav1.Duration `json:"-"`
+}
+
+// DataDisk contains configuration for an additional persistent disk.
+type DataDisk struct {
+	// Size is the size of the data disk, e.g. `50Gi`.
+	Size string
+	// Type is the type of the data disk, e.g. `pd-ssd`.
+	Type *string
+	// AutoDelete indicates whether the data disk is deleted together with the machine.
+	AutoDelete *bool
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // WorkerStatus contains information about created worker resources.
 type WorkerStatus struct {
 	metav1.TypeMeta
@@ -21,3 +21,15 @@ import (
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_DataDisk sets defaults for additional persistent disks.
+func SetDefaults_DataDisk(obj *DataDisk) {
+	if obj.Type == nil {
+		diskType := "pd-standard"
+		obj.Type = &diskType
+	}
+	if obj.AutoDelete == nil {
+		autoDelete := true
+		obj.AutoDelete = &autoDelete
+	}
+}
@@ -20,6 +20,45 @@ import (
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// ServiceAccountScopes are the OAuth scopes of the service account attached to the machines. They replace the
+	// default scope `https://www.googleapis.com/auth/compute`.
+	// +optional
+	ServiceAccountScopes []string `json:"serviceAccountScopes,omitempty"`
+	// Preemptible indicates whether preemptible VMs are used for the machines.
+	// +optional
+	Preemptible *bool `json:"preemptible,omitempty"`
+	// DataDisks contains configuration for additional persistent disks attached to the machines.
+	// +optional
+	DataDisks []DataDisk `json:"dataDisks,omitempty"`
+	// Labels are additional labels that are added to the machines and their disks.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Tags are additional network tags that are added to the machines.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+	// RollingUpdateTimeout is the maximum duration of a rolling update of the machines. Defaults to 30 minutes.
+	// +optional
+	RollingUpdateTimeout *metav1.Duration `json:"rollingUpdateTimeout,omitempty"`
+}
+
+// DataDisk contains configuration for an additional persistent disk.
+type DataDisk struct {
+	// Size is the size of the data disk, e.g. `50Gi`.
+	Size string `json:"size"`
+	// Type is the type of the data disk, e.g. `pd-ssd`. Defaults to `pd-standard`.
+	// +optional
+	Type *string `json:"type,omitempty"`
+	// AutoDelete indicates whether the data disk is deleted together with the machine. Defaults to `true`.
+	// +optional
+	AutoDelete *bool `json:"autoDelete,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // WorkerStatus contains information about created worker resources.
 type WorkerStatus struct {
 	metav1.TypeMeta `json:",inline"`
@@ -24,6 +24,7 @@ import (
 	unsafe "unsafe"
 
 	gcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -55,6 +56,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudRouter)(nil), (*gcp.CloudRouter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudRouter_To_gcp_CloudRouter(a.(*CloudRouter), b.(*gcp.CloudRouter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CloudRouter)(nil), (*CloudRouter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CloudRouter_To_v1alpha1_CloudRouter(a.(*gcp.CloudRouter), b.(*CloudRouter), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControlPlaneConfig)(nil), (*gcp.ControlPlaneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(a.(*ControlPlaneConfig), b.(*gcp.ControlPlaneConfig), scope)
 	}); err != nil {
@@ -65,6 +76,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DataDisk)(nil), (*gcp.DataDisk)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataDisk_To_gcp_DataDisk(a.(*DataDisk), b.(*gcp.DataDisk), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DataDisk)(nil), (*DataDisk)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DataDisk_To_v1alpha1_DataDisk(a.(*gcp.DataDisk), b.(*DataDisk), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InfrastructureConfig)(nil), (*gcp.InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(a.(*InfrastructureConfig), b.(*gcp.InfrastructureConfig), scope)
 	}); err != nil {
@@ -155,6 +176,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*gcp.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(a.(*WorkerConfig), b.(*gcp.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*gcp.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*gcp.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_gcp_WorkerStatus(a.(*WorkerStatus), b.(*gcp.WorkerStatus), scope)
 	}); err != nil {
@@ -208,6 +239,26 @@ func Convert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *gcp.Cloud
 	return autoConvert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_CloudRouter_To_gcp_CloudRouter(in *CloudRouter, out *gcp.CloudRouter, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_CloudRouter_To_gcp_CloudRouter is an autogenerated conversion function.
+func Convert_v1alpha1_CloudRouter_To_gcp_CloudRouter(in *CloudRouter, out *gcp.CloudRouter, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudRouter_To_gcp_CloudRouter(in, out, s)
+}
+
+func autoConvert_gcp_CloudRouter_To_v1alpha1_CloudRouter(in *gcp.CloudRouter, out *CloudRouter, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_gcp_CloudRouter_To_v1alpha1_CloudRouter is an autogenerated conversion function.
+func Convert_gcp_CloudRouter_To_v1alpha1_CloudRouter(in *gcp.CloudRouter, out *CloudRouter, s conversion.Scope) error {
+	return autoConvert_gcp_CloudRouter_To_v1alpha1_CloudRouter(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in *ControlPlaneConfig, out *gcp.ControlPlaneConfig, s conversion.Scope) error {
 	out.Zone = in.Zone
 	out.CloudControllerManager = (*gcp.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
@@ -230,6 +281,30 @@ func Convert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.Contr
 	return autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_DataDisk_To_gcp_DataDisk(in *DataDisk, out *gcp.DataDisk, s conversion.Scope) error {
+	out.Size = in.Size
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.AutoDelete = (*bool)(unsafe.Pointer(in.AutoDelete))
+	return nil
+}
+
+// Convert_v1alpha1_DataDisk_To_gcp_DataDisk is an autogenerated conversion function.
+func Convert_v1alpha1_DataDisk_To_gcp_DataDisk(in *DataDisk, out *gcp.DataDisk, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataDisk_To_gcp_DataDisk(in, out, s)
+}
+
+func autoConvert_gcp_DataDisk_To_v1alpha1_DataDisk(in *gcp.DataDisk, out *DataDisk, s conversion.Scope) error {
+	out.Size = in.Size
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.AutoDelete = (*bool)(unsafe.Pointer(in.AutoDelete))
+	return nil
+}
+
+// Convert_gcp_DataDisk_To_v1alpha1_DataDisk is an autogenerated conversion function.
+func Convert_gcp_DataDisk_To_v1alpha1_DataDisk(in *gcp.DataDisk, out *DataDisk, s conversion.Scope) error {
+	return autoConvert_gcp_DataDisk_To_v1alpha1_DataDisk(in, out, s)
+}
+
 func autoConvert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(in *InfrastructureConfig, out *gcp.InfrastructureConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
 		return err
@@ -422,6 +497,7 @@ func Convert_gcp_Subnet_To_v1alpha1_Subnet(in *gcp.Subnet, out *Subnet, s conver
 
 func autoConvert_v1alpha1_VPC_To_gcp_VPC(in *VPC, out *gcp.VPC, s conversion.Scope) error {
 	out.Name = in.Name
+	out.CloudRouter = (*gcp.CloudRouter)(unsafe.Pointer(in.CloudRouter))
 	return nil
 }
 
@@ -432,6 +508,7 @@ func Convert_v1alpha1_VPC_To_gcp_VPC(in *VPC, out *gcp.VPC, s conversion.Scope)
 
 func autoConvert_gcp_VPC_To_v1alpha1_VPC(in *gcp.VPC, out *VPC, s conversion.Scope) error {
 	out.Name = in.Name
+	out.CloudRouter = (*CloudRouter)(unsafe.Pointer(in.CloudRouter))
 	return nil
 }
 
@@ -440,6 +517,36 @@ func Convert_gcp_VPC_To_v1alpha1_VPC(in *gcp.VPC, out *VPC, s conversion.Scope)
 	return autoConvert_gcp_VPC_To_v1alpha1_VPC(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(in *WorkerConfig, out *gcp.WorkerConfig, s conversion.Scope) error {
+	out.ServiceAccountScopes = *(*[]string)(unsafe.Pointer(&in.ServiceAccountScopes))
+	out.Preemptible = (*bool)(unsafe.Pointer(in.Preemptible))
+	out.DataDisks = *(*[]gcp.DataDisk)(unsafe.Pointer(&in.DataDisks))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Tags = *(*[]string)(unsafe.Pointer(&in.Tags))
+	out.RollingUpdateTimeout = (*v1.Duration)(unsafe.Pointer(in.RollingUpdateTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(in *WorkerConfig, out *gcp.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(in, out, s)
+}
+
+func autoConvert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in *gcp.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.ServiceAccountScopes = *(*[]string)(unsafe.Pointer(&in.ServiceAccountScopes))
+	out.Preemptible = (*bool)(unsafe.Pointer(in.Preemptible))
+	out.DataDisks = *(*[]DataDisk)(unsafe.Pointer(&in.DataDisks))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Tags = *(*[]string)(unsafe.Pointer(&in.Tags))
+	out.RollingUpdateTimeout = (*v1.Duration)(unsafe.Pointer(in.RollingUpdateTimeout))
+	return nil
+}
+
+// Convert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in *gcp.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_gcp_WorkerStatus(in *WorkerStatus, out *gcp.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]gcp.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	return nil
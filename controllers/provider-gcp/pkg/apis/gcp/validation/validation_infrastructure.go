@@ -0,0 +1,52 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object.
+func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := fldPath.Child("networks")
+
+	if infra.Networks.VPC != nil {
+		vpcPath := networksPath.Child("vpc")
+		if len(infra.Networks.VPC.Name) == 0 {
+			allErrs = append(allErrs, field.Required(vpcPath.Child("name"), "must provide a vpc name"))
+		}
+		if infra.Networks.VPC.CloudRouter != nil && len(infra.Networks.VPC.CloudRouter.Name) == 0 {
+			allErrs = append(allErrs, field.Required(vpcPath.Child("cloudRouter", "name"), "must provide a cloud router name"))
+		}
+	}
+
+	allErrs = append(allErrs, extensionsvalidation.ValidateCIDR(infra.Networks.Worker, networksPath.Child("worker"))...)
+	if infra.Networks.Internal != nil {
+		allErrs = append(allErrs, extensionsvalidation.ValidateCIDR(*infra.Networks.Internal, networksPath.Child("internal"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update. The network
+// configuration is immutable.
+func ValidateInfrastructureConfigUpdate(oldInfra, newInfra *apisgcp.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	return extensionsvalidation.ValidateImmutableField(newInfra.Networks, oldInfra.Networks, fldPath.Child("networks"))
+}
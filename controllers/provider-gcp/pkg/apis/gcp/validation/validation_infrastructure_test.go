@@ -0,0 +1,91 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisgcp.InfrastructureConfig
+		fldPath              = field.NewPath("spec", "providerConfig")
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisgcp.InfrastructureConfig{
+			Networks: apisgcp.NetworkConfig{
+				VPC: &apisgcp.VPC{
+					Name: "existing-vpc",
+					CloudRouter: &apisgcp.CloudRouter{
+						Name: "existing-router",
+					},
+				},
+				Worker: "10.250.0.0/19",
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an empty vpc name", func() {
+			infrastructureConfig.Networks.VPC.Name = ""
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.networks.vpc.name"),
+			}))))
+		})
+
+		It("should forbid an empty cloud router name", func() {
+			infrastructureConfig.Networks.VPC.CloudRouter.Name = ""
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.networks.vpc.cloudRouter.name"),
+			}))))
+		})
+
+		It("should forbid an invalid worker cidr", func() {
+			infrastructureConfig.Networks.Worker = "invalid-cidr"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.worker"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should forbid changing the networks", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC.CloudRouter.Name = "other-router"
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks"),
+			}))))
+		})
+	})
+})
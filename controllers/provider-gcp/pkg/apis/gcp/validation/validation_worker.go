@@ -0,0 +1,97 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	labelKeyRegex   = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+	labelValueRegex = regexp.MustCompile(`^[a-z0-9_-]{0,63}$`)
+
+	reservedLabelKeys = sets.NewString("name")
+
+	availableDataDiskTypes = sets.NewString("pd-standard", "pd-ssd")
+)
+
+// ValidateWorkerConfig validates a WorkerConfig object.
+func ValidateWorkerConfig(workerConfig *apisgcp.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	scopesPath := fldPath.Child("serviceAccountScopes")
+	scopes := sets.NewString()
+	for i, scope := range workerConfig.ServiceAccountScopes {
+		idxPath := scopesPath.Index(i)
+
+		if len(scope) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must not be empty"))
+		} else if scopes.Has(scope) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, scope))
+		}
+		scopes.Insert(scope)
+	}
+
+	dataDisksPath := fldPath.Child("dataDisks")
+	for i, dataDisk := range workerConfig.DataDisks {
+		idxPath := dataDisksPath.Index(i)
+
+		allErrs = append(allErrs, extensionsvalidation.ValidateDiskSize(dataDisk.Size, idxPath.Child("size"))...)
+
+		if dataDisk.Type != nil && !availableDataDiskTypes.Has(*dataDisk.Type) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), *dataDisk.Type, availableDataDiskTypes.List()))
+		}
+	}
+
+	labelsPath := fldPath.Child("labels")
+	for key, value := range workerConfig.Labels {
+		keyPath := labelsPath.Key(key)
+
+		switch {
+		case reservedLabelKeys.Has(key):
+			allErrs = append(allErrs, field.Forbidden(keyPath, fmt.Sprintf("must not use a reserved key %v", reservedLabelKeys.List())))
+		case !labelKeyRegex.MatchString(key):
+			allErrs = append(allErrs, field.Invalid(keyPath, key, fmt.Sprintf("must match %q", labelKeyRegex.String())))
+		}
+
+		if !labelValueRegex.MatchString(value) {
+			allErrs = append(allErrs, field.Invalid(keyPath, value, fmt.Sprintf("must match %q", labelValueRegex.String())))
+		}
+	}
+
+	tagsPath := fldPath.Child("tags")
+	tags := sets.NewString()
+	for i, tag := range workerConfig.Tags {
+		idxPath := tagsPath.Index(i)
+
+		for _, msg := range validation.IsDNS1035Label(tag) {
+			allErrs = append(allErrs, field.Invalid(idxPath, tag, msg))
+		}
+		if tags.Has(tag) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, tag))
+		}
+		tags.Insert(tag)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("WorkerConfig validation", func() {
+	var (
+		workerConfig *apisgcp.WorkerConfig
+		fldPath      = field.NewPath("spec", "pools").Index(0).Child("providerConfig")
+
+		pdSSD = "pd-ssd"
+	)
+
+	BeforeEach(func() {
+		preemptible := true
+
+		workerConfig = &apisgcp.WorkerConfig{
+			ServiceAccountScopes: []string{
+				"https://www.googleapis.com/auth/compute",
+				"https://www.googleapis.com/auth/devstorage.read_only",
+			},
+			Preemptible: &preemptible,
+			DataDisks: []apisgcp.DataDisk{
+				{
+					Size: "100Gi",
+					Type: &pdSSD,
+				},
+			},
+			Labels: map[string]string{
+				"team": "foo",
+			},
+			Tags: []string{"foo"},
+		}
+	})
+
+	Describe("#ValidateWorkerConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid empty and duplicate service account scopes", func() {
+			workerConfig.ServiceAccountScopes = append(workerConfig.ServiceAccountScopes, "", "https://www.googleapis.com/auth/compute")
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.pools[0].providerConfig.serviceAccountScopes[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.pools[0].providerConfig.serviceAccountScopes[3]"),
+				})),
+			))
+		})
+
+		It("should forbid invalid data disks", func() {
+			foo := "foo"
+			workerConfig.DataDisks = append(workerConfig.DataDisks, apisgcp.DataDisk{Size: "0", Type: &foo})
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.pools[0].providerConfig.dataDisks[1].size"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.pools[0].providerConfig.dataDisks[1].type"),
+				})),
+			))
+		})
+
+		It("should forbid invalid and reserved labels", func() {
+			workerConfig.Labels["name"] = "foo"
+			workerConfig.Labels["Foo"] = "bar"
+			workerConfig.Labels["bar"] = "Baz"
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.pools[0].providerConfig.labels[name]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.pools[0].providerConfig.labels[Foo]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.pools[0].providerConfig.labels[bar]"),
+				})),
+			))
+		})
+
+		It("should forbid invalid and duplicate tags", func() {
+			workerConfig.Tags = append(workerConfig.Tags, "Foo_bar", "foo")
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.pools[0].providerConfig.tags[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.pools[0].providerConfig.tags[2]"),
+				})),
+			))
+		})
+	})
+})
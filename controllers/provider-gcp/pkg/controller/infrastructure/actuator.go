@@ -21,12 +21,13 @@ import (
 	infrainternal "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/infrastructure"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionschartrenderer "github.com/gardener/gardener-extensions/pkg/gardener/chartrenderer"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	"github.com/go-logr/logr"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
@@ -36,16 +37,25 @@ import (
 )
 
 type actuator struct {
-	logger        logr.Logger
-	client        client.Client
-	restConfig    *rest.Config
-	chartRenderer chartrenderer.Interface
+	logger               logr.Logger
+	client               client.Client
+	restConfig           *rest.Config
+	chartRenderer        chartrenderer.Interface
+	terraformerFactory   extensionsterraformer.Factory
+	chartRendererFactory extensionschartrenderer.Factory
 }
 
-// NewActuator creates a new infrastructure.Actuator.
-func NewActuator() infrastructure.Actuator {
+// NewActuator creates a new infrastructure.Actuator that uses the Terraformers of the given factory.
+func NewActuator(terraformerFactory extensionsterraformer.Factory) infrastructure.Actuator {
+	return NewActuatorWithDeps(log.Log.WithName("infrastructure-actuator"), terraformerFactory, extensionschartrenderer.DefaultFactory())
+}
+
+// NewActuatorWithDeps creates a new infrastructure.Actuator with the given dependencies.
+func NewActuatorWithDeps(logger logr.Logger, terraformerFactory extensionsterraformer.Factory, chartRendererFactory extensionschartrenderer.Factory) infrastructure.Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		logger:               logger,
+		terraformerFactory:   terraformerFactory,
+		chartRendererFactory: chartRendererFactory,
 	}
 }
 
@@ -59,7 +69,7 @@ func (a *actuator) InjectClient(client client.Client) error {
 func (a *actuator) InjectConfig(config *rest.Config) error {
 	a.restConfig = config
 
-	chartRenderer, err := chartrenderer.NewForConfig(config)
+	chartRenderer, err := a.chartRendererFactory.NewForConfig(config)
 	if err != nil {
 		return err
 	}
@@ -70,7 +80,7 @@ func (a *actuator) InjectConfig(config *rest.Config) error {
 
 func (a *actuator) updateProviderStatus(
 	ctx context.Context,
-	tf *terraformer.Terraformer,
+	tf extensionsterraformer.Interface,
 	infra *extensionsv1alpha1.Infrastructure,
 	config *gcpv1alpha1.InfrastructureConfig,
 ) error {
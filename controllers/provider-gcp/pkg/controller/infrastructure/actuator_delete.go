@@ -18,52 +18,34 @@ import (
 	"context"
 	"time"
 
-	gcpv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
 	gcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/infrastructure"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	infrastructurecontroller "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
 func (a *actuator) cleanupKubernetesFirewallRules(
 	ctx context.Context,
-	config *gcpv1alpha1.InfrastructureConfig,
 	client gcpclient.Interface,
-	tf *terraformer.Terraformer,
+	vpcName string,
 	account *internal.ServiceAccount,
 	shootSeedNamespace string,
 ) error {
-	state, err := infrastructure.ExtractTerraformState(tf, config)
-	if err != nil {
-		if terraformer.IsVariablesNotFoundError(err) {
-			return nil
-		}
-		return err
-	}
-
-	return infrastructure.CleanupKubernetesFirewalls(ctx, client, account.ProjectID, state.VPCName, shootSeedNamespace)
+	return infrastructure.CleanupKubernetesFirewalls(ctx, client, account.ProjectID, vpcName, shootSeedNamespace)
 }
 
 func (a *actuator) cleanupKubernetesRoutes(
 	ctx context.Context,
-	config *gcpv1alpha1.InfrastructureConfig,
 	client gcpclient.Interface,
-	tf *terraformer.Terraformer,
+	vpcName string,
 	account *internal.ServiceAccount,
 	shootSeedNamespace string,
 ) error {
-	state, err := infrastructure.ExtractTerraformState(tf, config)
-	if err != nil {
-		if terraformer.IsVariablesNotFoundError(err) {
-			return nil
-		}
-		return err
-	}
-
-	return infrastructure.CleanupKubernetesRoutes(ctx, client, account.ProjectID, state.VPCName, shootSeedNamespace)
+	return infrastructure.CleanupKubernetesRoutes(ctx, client, account.ProjectID, vpcName, shootSeedNamespace)
 }
 
 // Delete implements infrastructure.Actuator.
@@ -83,7 +65,12 @@ func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrast
 		return err
 	}
 
-	tf, err := internal.NewTerraformer(a.restConfig, serviceAccount, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
+	terraformFiles, err := infrastructure.RenderTerraformerChart(a.chartRenderer, infra, serviceAccount, config, cluster)
+	if err != nil {
+		return err
+	}
+
+	tf, err := internal.NewTerraformer(a.terraformerFactory, a.restConfig, serviceAccount, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
 	if err != nil {
 		return err
 	}
@@ -93,24 +80,50 @@ func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrast
 		return err
 	}
 
+	state, err := infrastructurecontroller.GetTerraformState(infra)
+	if err != nil {
+		return err
+	}
+
+	tfState, err := infrastructurecontroller.ReadTerraformState(tf, configExists, infra)
+	if err != nil {
+		return err
+	}
+
+	// The VPC name is not known yet if the Terraform configuration has never been applied successfully.
+	var vpcName string
+	if _, ok := tfState.Outputs[infrastructure.TerraformerOutputKeyVPCName]; ok {
+		if vpcName, err = tfState.OutputString(infrastructure.TerraformerOutputKeyVPCName); err != nil {
+			return err
+		}
+	}
+
+	// A persisted state means that there are resources to clean up even if the seed lost the Terraform configuration.
+	tf = tf.InitializeWith(extensionsterraformer.WithState(
+		a.client,
+		a.terraformerFactory.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars),
+		state),
+	)
+	configExists = configExists || len(state) > 0
+
 	var (
 		g                              = flow.NewGraph("GCP infrastructure destruction")
 		destroyKubernetesFirewallRules = g.Add(flow.Task{
 			Name: "Destroying Kubernetes firewall rules",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return a.cleanupKubernetesFirewallRules(ctx, config, gcpClient, tf, serviceAccount, infra.Namespace)
+				return a.cleanupKubernetesFirewallRules(ctx, gcpClient, vpcName, serviceAccount, infra.Namespace)
 			}).
 				RetryUntilTimeout(10*time.Second, 5*time.Minute).
-				DoIf(configExists),
+				DoIf(configExists && len(vpcName) > 0),
 		})
 
 		destroyKubernetesRoutes = g.Add(flow.Task{
 			Name: "Destroying Kubernetes route entries",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return a.cleanupKubernetesRoutes(ctx, config, gcpClient, tf, serviceAccount, infra.Namespace)
+				return a.cleanupKubernetesRoutes(ctx, gcpClient, vpcName, serviceAccount, infra.Namespace)
 			}).
 				RetryUntilTimeout(10*time.Second, 5*time.Minute).
-				DoIf(configExists),
+				DoIf(configExists && len(vpcName) > 0),
 		})
 
 		_ = g.Add(flow.Task{
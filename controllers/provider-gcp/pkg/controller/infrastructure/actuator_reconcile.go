@@ -19,12 +19,14 @@ import (
 	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
+	gcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/infrastructure"
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	infrastructurecontroller "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 )
 
 // Reconcile implements infrastructure.Actuator.
@@ -39,23 +41,48 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 		return err
 	}
 
+	gcpClient, err := gcpclient.NewFromServiceAccount(ctx, serviceAccount.Raw)
+	if err != nil {
+		return err
+	}
+
+	if err := infrastructure.CheckExistingResources(ctx, gcpClient, serviceAccount.ProjectID, infra.Spec.Region, config); err != nil {
+		return err
+	}
+
 	terraformFiles, err := infrastructure.RenderTerraformerChart(a.chartRenderer, infra, serviceAccount, config, cluster)
 	if err != nil {
 		return err
 	}
 
-	tf, err := internal.NewTerraformer(a.restConfig, serviceAccount, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
+	tf, err := internal.NewTerraformer(a.terraformerFactory, a.restConfig, serviceAccount, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
 	if err != nil {
 		return err
 	}
 
-	if err := tf.
-		InitializeWith(terraformer.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars)).
-		Apply(); err != nil {
+	state, err := infrastructurecontroller.GetTerraformState(infra)
+	if err != nil {
+		return err
+	}
+
+	applyErr := tf.
+		InitializeWith(extensionsterraformer.WithState(
+			a.client,
+			a.terraformerFactory.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars),
+			state),
+		).
+		Apply()
+
+	// The Terraform state is persisted even if the apply failed as it might already reference created resources.
+	if err := infrastructurecontroller.SaveTerraformState(ctx, a.client, tf, infra); err != nil {
+		return err
+	}
 
-		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infra.Name)
+	infrastructurecontroller.ReportTerraformApplyResult(ctx, a.logger, infra, applyErr)
+	if applyErr != nil {
+		a.logger.Error(applyErr, "failed to apply the terraform config", "infrastructure", infra.Name)
 		return &controllererrors.RequeueAfterError{
-			Cause:        err,
+			Cause:        applyErr,
 			RequeueAfter: 30 * time.Second,
 		}
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -112,79 +113,78 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			Image:   machineImage,
 		})
 
-		volumeSize, err := worker.DiskSize(pool.Volume.Size)
+		workerConfig := &gcpapi.WorkerConfig{}
+		providerConfig, err := worker.DecodeProviderConfig(w.decoder, pool, workerConfig)
 		if err != nil {
 			return err
 		}
 
 		for zoneIndex, zone := range pool.Zones {
+			disks, err := computeDisks(w.worker.Name, pool, machineImage, workerConfig)
+			if err != nil {
+				return err
+			}
+
+			serviceAccountScopes := []string{
+				"https://www.googleapis.com/auth/compute",
+			}
+			if len(workerConfig.ServiceAccountScopes) > 0 {
+				serviceAccountScopes = workerConfig.ServiceAccountScopes
+			}
+
 			machineClassSpec := map[string]interface{}{
 				"region":             w.worker.Spec.Region,
 				"zone":               zone,
 				"canIpForward":       true,
 				"deletionProtection": false,
 				"description":        fmt.Sprintf("Machine of Shoot %s created by machine-controller-manager.", w.worker.Name),
-				"disks": []map[string]interface{}{
-					{
-						"autoDelete": true,
-						"boot":       true,
-						"sizeGb":     volumeSize,
-						"type":       pool.Volume.Type,
-						"image":      machineImage,
-						"labels": map[string]interface{}{
-							"name": w.worker.Name,
-						},
-					},
-				},
-				"labels": map[string]interface{}{
-					"name": w.worker.Name,
-				},
-				"machineType": pool.MachineType,
+				"disks":              disks,
+				"labels":             computeLabels(w.worker.Name, workerConfig),
+				"machineType":        pool.MachineType,
 				"networkInterfaces": []map[string]interface{}{
 					{
 						"subnetwork": nodesSubnet.Name,
 					},
 				},
-				"scheduling": map[string]interface{}{
-					"automaticRestart":  true,
-					"onHostMaintenance": "MIGRATE",
-					"preemptible":       false,
-				},
+				"scheduling": computeScheduling(workerConfig),
 				"secret": map[string]interface{}{
 					"cloudConfig": string(pool.UserData),
 				},
 				"serviceAccounts": []map[string]interface{}{
 					{
-						"email": infrastructureStatus.ServiceAccountEmail,
-						"scopes": []string{
-							"https://www.googleapis.com/auth/compute",
-						},
+						"email":  infrastructureStatus.ServiceAccountEmail,
+						"scopes": serviceAccountScopes,
 					},
 				},
-				"tags": []string{
+				"tags": append([]string{
 					w.worker.Namespace,
 					fmt.Sprintf("kubernetes-io-cluster-%s", w.worker.Namespace),
 					"kubernetes-io-role-node",
-				},
+				}, workerConfig.Tags...),
+			}
+
+			machineClassSpecHash, err := worker.MachineClassHashWithProviderConfig(machineClassSpec, shootVersionMajorMinor, providerConfig)
+			if err != nil {
+				return err
 			}
 
 			var (
-				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-				deploymentName       = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
-				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+				deploymentName = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
+				className      = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
-				Name:           deploymentName,
-				ClassName:      className,
-				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
-				Labels:         pool.Labels,
-				Annotations:    pool.Annotations,
-				Taints:         pool.Taints,
+				Name:                 deploymentName,
+				ClassName:            className,
+				SecretName:           className,
+				Minimum:              worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
+				Maximum:              worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
+				MaxSurge:             worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
+				MaxUnavailable:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
+				Labels:               pool.Labels,
+				Annotations:          pool.Annotations,
+				Taints:               pool.Taints,
+				RollingUpdateTimeout: worker.RollingUpdateTimeout(workerConfig.RollingUpdateTimeout),
 			})
 
 			machineClassSpec["name"] = className
@@ -200,3 +200,75 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// computeDisks computes the disks of the machines of the given worker pool, i.e. the boot disk and all data disks of
+// the given WorkerConfig.
+func computeDisks(name string, pool extensionsv1alpha1.WorkerPool, machineImage string, workerConfig *gcpapi.WorkerConfig) ([]map[string]interface{}, error) {
+	volumeSize, err := worker.DiskSize(pool.Volume.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	disks := []map[string]interface{}{
+		{
+			"autoDelete": true,
+			"boot":       true,
+			"sizeGb":     volumeSize,
+			"type":       pool.Volume.Type,
+			"image":      machineImage,
+			"labels":     computeLabels(name, workerConfig),
+		},
+	}
+
+	for _, dataDisk := range workerConfig.DataDisks {
+		dataDiskSize, err := worker.DiskSize(dataDisk.Size)
+		if err != nil {
+			return nil, err
+		}
+
+		disk := map[string]interface{}{
+			"autoDelete": true,
+			"boot":       false,
+			"sizeGb":     dataDiskSize,
+			"labels":     computeLabels(name, workerConfig),
+		}
+		if dataDisk.Type != nil {
+			disk["type"] = *dataDisk.Type
+		}
+		if dataDisk.AutoDelete != nil {
+			disk["autoDelete"] = *dataDisk.AutoDelete
+		}
+
+		disks = append(disks, disk)
+	}
+
+	return disks, nil
+}
+
+// computeLabels computes the labels of the machines and their disks, i.e. the additional labels of the given
+// WorkerConfig and the name label.
+func computeLabels(name string, workerConfig *gcpapi.WorkerConfig) map[string]interface{} {
+	labels := map[string]interface{}{}
+	for key, value := range worker.MergeTags(workerConfig.Labels, map[string]string{"name": name}) {
+		labels[key] = value
+	}
+	return labels
+}
+
+// computeScheduling computes the scheduling options of the machines. Preemptible VMs can neither be restarted
+// automatically nor be migrated on host maintenance.
+func computeScheduling(workerConfig *gcpapi.WorkerConfig) map[string]interface{} {
+	if workerConfig.Preemptible != nil && *workerConfig.Preemptible {
+		return map[string]interface{}{
+			"automaticRestart":  false,
+			"onHostMaintenance": "TERMINATE",
+			"preemptible":       true,
+		}
+	}
+
+	return map[string]interface{}{
+		"automaticRestart":  true,
+		"onHostMaintenance": "MIGRATE",
+		"preemptible":       false,
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/config"
 	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
@@ -417,6 +418,151 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should render the worker config of the pools into the machine classes", func() {
+				expectGetSecretCallToWork(c, serviceAccountJSON)
+
+				var (
+					preemptible = true
+					autoDelete  = false
+					pdSSD       = "pd-ssd"
+				)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&gcpv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: gcpv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						ServiceAccountScopes: []string{"https://www.googleapis.com/auth/cloud-platform"},
+						Preemptible:          &preemptible,
+						DataDisks: []gcpv1alpha1.DataDisk{
+							{
+								Size: "100Gi",
+								Type: &pdSSD,
+							},
+							{
+								Size:       "10Gi",
+								AutoDelete: &autoDelete,
+							},
+						},
+						Labels: map[string]string{
+							"team": "foo",
+							"name": "bar",
+						},
+						Tags: []string{"foo"},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				var machineClasses []map[string]interface{}
+				chartApplier.
+					EXPECT().
+					ApplyChart(context.TODO(), filepath.Join(gcp.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _, _, _ string, values, _ map[string]interface{}) error {
+						machineClasses = values["machineClasses"].([]map[string]interface{})
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+				Expect(machineClasses).To(HaveLen(4))
+
+				var (
+					pool1Class, pool2Class = machineClasses[0], machineClasses[2]
+					labels                 = map[string]interface{}{"team": "foo", "name": name}
+				)
+				Expect(pool1Class["disks"]).To(Equal([]map[string]interface{}{
+					{
+						"autoDelete": true,
+						"boot":       true,
+						"sizeGb":     volumeSize,
+						"type":       volumeType,
+						"image":      machineImage,
+						"labels":     labels,
+					},
+					{
+						"autoDelete": true,
+						"boot":       false,
+						"sizeGb":     100,
+						"type":       pdSSD,
+						"labels":     labels,
+					},
+					{
+						"autoDelete": false,
+						"boot":       false,
+						"sizeGb":     10,
+						"type":       "pd-standard",
+						"labels":     labels,
+					},
+				}))
+				Expect(pool1Class["labels"]).To(Equal(labels))
+				Expect(pool1Class["scheduling"]).To(Equal(map[string]interface{}{
+					"automaticRestart":  false,
+					"onHostMaintenance": "TERMINATE",
+					"preemptible":       true,
+				}))
+				Expect(pool1Class["serviceAccounts"]).To(Equal([]map[string]interface{}{
+					{
+						"email":  serviceAccountEmail,
+						"scopes": []string{"https://www.googleapis.com/auth/cloud-platform"},
+					},
+				}))
+				Expect(pool1Class["tags"]).To(Equal([]string{
+					namespace,
+					fmt.Sprintf("kubernetes-io-cluster-%s", namespace),
+					"kubernetes-io-role-node",
+					"foo",
+				}))
+
+				Expect(pool2Class["disks"]).To(HaveLen(1))
+				Expect(pool2Class["scheduling"]).To(Equal(map[string]interface{}{
+					"automaticRestart":  true,
+					"onHostMaintenance": "MIGRATE",
+					"preemptible":       false,
+				}))
+			})
+
+			It("should roll the machines if only the labels of a pool change", func() {
+				workerConfigWithLabels := func(labels map[string]string) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Raw: encode(&gcpv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: gcpv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							Labels: labels,
+						}),
+					}
+				}
+				classHash := func() string {
+					expectGetSecretCallToWork(c, serviceAccountJSON)
+					workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					return strings.TrimPrefix(result[0].ClassName, fmt.Sprintf("%s-%s-z1-", namespace, namePool1))
+				}
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithLabels(map[string]string{"team": "foo"})
+				hashFoo := classHash()
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithLabels(map[string]string{"team": "bar"})
+				Expect(classHash()).NotTo(Equal(hashFoo))
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithLabels(map[string]string{"team": "foo"})
+				Expect(classHash()).To(Equal(hashFoo))
+			})
+
+			It("should fail because the worker config cannot be decoded", func() {
+				expectGetSecretCallToWork(c, serviceAccountJSON)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte("invalid")}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -93,7 +93,7 @@ func (s *storageClient) DeleteObjectsWithPrefix(ctx context.Context, bucketName,
 	for {
 		attr, err := itr.Next()
 		if err != nil {
-			if err == iterator.Done {
+			if err == iterator.Done || err == storage.ErrBucketNotExist {
 				return nil
 			}
 			return err
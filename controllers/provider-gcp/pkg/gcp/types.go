@@ -28,6 +28,14 @@ const (
 	MachineControllerManagerImageName = "machine-controller-manager"
 	// ETCDBackupRestoreImageName is the name of the etcd backup and restore image.
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSINodeDriverRegistrarImageName is the name of the CSI driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSIPluginImageName is the name of the CSI plugin image.
+	CSIPluginImageName = "gcp-compute-persistent-disk-csi-driver"
 
 	// ServiceAccountJSONField is the field in a secret where the service account JSON is stored at.
 	ServiceAccountJSONField = "serviceaccount.json"
@@ -48,6 +56,13 @@ const (
 	MachineControllerManagerMonitoringConfigName = "machine-controller-manager-monitoring-config"
 	// BackupSecretName is the name of the secret containing the credentials for storing the backups of Shoot clusters.
 	BackupSecretName = "etcd-backup"
+
+	// CSIVersionConstraint is the constraint for the shoot Kubernetes versions for which the GCE PD CSI driver is
+	// deployed and in-tree GCE PD volumes are migrated to it.
+	CSIVersionConstraint = ">= 1.18"
+	// InTreeVolumePluginVersionConstraint is the constraint for the shoot Kubernetes versions for which the in-tree GCE
+	// PD volume plugin is used.
+	InTreeVolumePluginVersionConstraint = "< 1.18"
 )
 
 var (
@@ -36,6 +36,14 @@ type routesService struct {
 	routesService *compute.RoutesService
 }
 
+type networksService struct {
+	networksService *compute.NetworksService
+}
+
+type routersService struct {
+	routersService *compute.RoutersService
+}
+
 type firewallsListCall struct {
 	firewallsListCall *compute.FirewallsListCall
 }
@@ -52,6 +60,14 @@ type routesDeleteCall struct {
 	routesDeleteCall *compute.RoutesDeleteCall
 }
 
+type networksGetCall struct {
+	networksGetCall *compute.NetworksGetCall
+}
+
+type routersGetCall struct {
+	routersGetCall *compute.RoutersGetCall
+}
+
 // NewFromServiceAccount creates a new client from the given service account.
 func NewFromServiceAccount(ctx context.Context, serviceAccount []byte) (Interface, error) {
 	jwt, err := google.JWTConfigFromJSON(serviceAccount, compute.CloudPlatformScope)
@@ -83,6 +99,16 @@ func (c *client) Routes() RoutesService {
 	return &routesService{c.service.Routes}
 }
 
+// Networks implements Interface.
+func (c *client) Networks() NetworksService {
+	return &networksService{c.service.Networks}
+}
+
+// Routers implements Interface.
+func (c *client) Routers() RoutersService {
+	return &routersService{c.service.Routers}
+}
+
 // List implements FirewallsService.
 func (f *firewallsService) List(projectID string) FirewallsListCall {
 	return &firewallsListCall{f.firewallsService.List(projectID)}
@@ -132,3 +158,33 @@ func (c *firewallsDeleteCall) Do(opts ...googleapi.CallOption) (*compute.Operati
 func (c *routesDeleteCall) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
 	return c.routesDeleteCall.Do(opts...)
 }
+
+// Get implements NetworksService.
+func (n *networksService) Get(projectID, network string) NetworksGetCall {
+	return &networksGetCall{n.networksService.Get(projectID, network)}
+}
+
+// Get implements RoutersService.
+func (r *routersService) Get(projectID, region, router string) RoutersGetCall {
+	return &routersGetCall{r.routersService.Get(projectID, region, router)}
+}
+
+// Context implements NetworksGetCall.
+func (c *networksGetCall) Context(ctx context.Context) NetworksGetCall {
+	return &networksGetCall{c.networksGetCall.Context(ctx)}
+}
+
+// Context implements RoutersGetCall.
+func (c *routersGetCall) Context(ctx context.Context) RoutersGetCall {
+	return &routersGetCall{c.routersGetCall.Context(ctx)}
+}
+
+// Do implements NetworksGetCall.
+func (c *networksGetCall) Do(opts ...googleapi.CallOption) (*compute.Network, error) {
+	return c.networksGetCall.Do(opts...)
+}
+
+// Do implements RoutersGetCall.
+func (c *routersGetCall) Do(opts ...googleapi.CallOption) (*compute.Router, error) {
+	return c.routersGetCall.Do(opts...)
+}
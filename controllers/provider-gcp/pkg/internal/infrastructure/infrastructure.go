@@ -16,12 +16,18 @@ package infrastructure
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"strings"
 
+	gcpv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
 	gcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -124,6 +130,39 @@ func CleanupKubernetesRoutes(ctx context.Context, client gcpclient.Interface, pr
 	return DeleteRoutes(ctx, client, projectID, routeNames)
 }
 
+// CheckExistingResources verifies that the VPC and the Cloud Router the given InfrastructureConfig refers to exist.
+// If one of them is missing, an error with the gardencorev1alpha1.ErrorInfraDependencies code is returned.
+func CheckExistingResources(ctx context.Context, client gcpclient.Interface, projectID, region string, config *gcpv1alpha1.InfrastructureConfig) error {
+	vpc := config.Networks.VPC
+	if vpc == nil {
+		return nil
+	}
+
+	if _, err := client.Networks().Get(projectID, vpc.Name).Context(ctx).Do(); err != nil {
+		if isNotFoundError(err) {
+			return gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraDependencies, fmt.Sprintf("vpc %s does not exist in project %s", vpc.Name, projectID))
+		}
+		return err
+	}
+
+	if vpc.CloudRouter == nil {
+		return nil
+	}
+
+	if _, err := client.Routers().Get(projectID, region, vpc.CloudRouter.Name).Context(ctx).Do(); err != nil {
+		if isNotFoundError(err) {
+			return gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraDependencies, fmt.Sprintf("cloud router %s does not exist in region %s of project %s", vpc.CloudRouter.Name, region, projectID))
+		}
+		return err
+	}
+	return nil
+}
+
+func isNotFoundError(err error) bool {
+	apiErr, ok := err.(*googleapi.Error)
+	return ok && apiErr.Code == http.StatusNotFound
+}
+
 // GetServiceAccountFromInfrastructure retrieves the ServiceAccount from the Secret referenced in the given Infrastructure.
 func GetServiceAccountFromInfrastructure(ctx context.Context, c client.Client, config *extensionsv1alpha1.Infrastructure) (*internal.ServiceAccount, error) {
 	return internal.GetServiceAccount(ctx, c, config.Spec.SecretRef)
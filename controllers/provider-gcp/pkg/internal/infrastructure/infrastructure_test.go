@@ -17,12 +17,17 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"net/http"
 
+	gcpv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/v1alpha1"
 	mockgcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/mock/client"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 var _ = Describe("Infrastructure", func() {
@@ -180,4 +185,104 @@ var _ = Describe("Infrastructure", func() {
 			Expect(DeleteRoutes(ctx, client, projectID, routeNames)).To(Succeed())
 		})
 	})
+
+	Describe("#CheckExistingResources", func() {
+		var (
+			ctx        = context.TODO()
+			projectID  = "foo"
+			region     = "europe-west1"
+			vpcName    = "existing-vpc"
+			routerName = "existing-router"
+
+			config          *gcpv1alpha1.InfrastructureConfig
+			client          *mockgcpclient.MockInterface
+			networks        *mockgcpclient.MockNetworksService
+			networksGetCall *mockgcpclient.MockNetworksGetCall
+			routers         *mockgcpclient.MockRoutersService
+			routersGetCall  *mockgcpclient.MockRoutersGetCall
+		)
+
+		BeforeEach(func() {
+			config = &gcpv1alpha1.InfrastructureConfig{
+				Networks: gcpv1alpha1.NetworkConfig{
+					VPC: &gcpv1alpha1.VPC{
+						Name: vpcName,
+						CloudRouter: &gcpv1alpha1.CloudRouter{
+							Name: routerName,
+						},
+					},
+					Worker: "10.250.0.0/19",
+				},
+			}
+
+			client = mockgcpclient.NewMockInterface(ctrl)
+			networks = mockgcpclient.NewMockNetworksService(ctrl)
+			networksGetCall = mockgcpclient.NewMockNetworksGetCall(ctrl)
+			routers = mockgcpclient.NewMockRoutersService(ctrl)
+			routersGetCall = mockgcpclient.NewMockRoutersGetCall(ctrl)
+		})
+
+		It("should not look up anything if no existing vpc is referenced", func() {
+			config.Networks.VPC = nil
+
+			Expect(CheckExistingResources(ctx, client, projectID, region, config)).To(Succeed())
+		})
+
+		It("should succeed if the vpc and the cloud router exist", func() {
+			gomock.InOrder(
+				client.EXPECT().Networks().Return(networks),
+				networks.EXPECT().Get(projectID, vpcName).Return(networksGetCall),
+				networksGetCall.EXPECT().Context(ctx).Return(networksGetCall),
+				networksGetCall.EXPECT().Do().Return(&compute.Network{Name: vpcName}, nil),
+				client.EXPECT().Routers().Return(routers),
+				routers.EXPECT().Get(projectID, region, routerName).Return(routersGetCall),
+				routersGetCall.EXPECT().Context(ctx).Return(routersGetCall),
+				routersGetCall.EXPECT().Do().Return(&compute.Router{Name: routerName}, nil),
+			)
+
+			Expect(CheckExistingResources(ctx, client, projectID, region, config)).To(Succeed())
+		})
+
+		It("should fail with a dependency error if the vpc does not exist", func() {
+			gomock.InOrder(
+				client.EXPECT().Networks().Return(networks),
+				networks.EXPECT().Get(projectID, vpcName).Return(networksGetCall),
+				networksGetCall.EXPECT().Context(ctx).Return(networksGetCall),
+				networksGetCall.EXPECT().Do().Return(nil, &googleapi.Error{Code: http.StatusNotFound}),
+			)
+
+			err := CheckExistingResources(ctx, client, projectID, region, config)
+			Expect(err).To(HaveOccurred())
+			Expect(gardencorev1alpha1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1alpha1.ErrorInfraDependencies))
+		})
+
+		It("should fail with a dependency error if the cloud router does not exist", func() {
+			gomock.InOrder(
+				client.EXPECT().Networks().Return(networks),
+				networks.EXPECT().Get(projectID, vpcName).Return(networksGetCall),
+				networksGetCall.EXPECT().Context(ctx).Return(networksGetCall),
+				networksGetCall.EXPECT().Do().Return(&compute.Network{Name: vpcName}, nil),
+				client.EXPECT().Routers().Return(routers),
+				routers.EXPECT().Get(projectID, region, routerName).Return(routersGetCall),
+				routersGetCall.EXPECT().Context(ctx).Return(routersGetCall),
+				routersGetCall.EXPECT().Do().Return(nil, &googleapi.Error{Code: http.StatusNotFound}),
+			)
+
+			err := CheckExistingResources(ctx, client, projectID, region, config)
+			Expect(err).To(HaveOccurred())
+			Expect(gardencorev1alpha1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1alpha1.ErrorInfraDependencies))
+		})
+
+		It("should return other errors unchanged", func() {
+			apiErr := &googleapi.Error{Code: http.StatusForbidden}
+			gomock.InOrder(
+				client.EXPECT().Networks().Return(networks),
+				networks.EXPECT().Get(projectID, vpcName).Return(networksGetCall),
+				networksGetCall.EXPECT().Context(ctx).Return(networksGetCall),
+				networksGetCall.EXPECT().Do().Return(nil, apiErr),
+			)
+
+			Expect(CheckExistingResources(ctx, client, projectID, region, config)).To(BeIdenticalTo(apiErr))
+		})
+	})
 })
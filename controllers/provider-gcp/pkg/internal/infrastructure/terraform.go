@@ -15,16 +15,17 @@
 package infrastructure
 
 import (
+	"fmt"
 	"path/filepath"
 
 	gcpv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -44,6 +45,8 @@ const (
 	TerraformerOutputKeySubnetNodes = "subnet_nodes"
 	// TerraformerOutputKeySubnetInternal is the name of the subnet_internal terraform output variable.
 	TerraformerOutputKeySubnetInternal = "subnet_internal"
+	// TerraformerOutputKeyCloudRouter is the name of the cloud_router terraform output variable.
+	TerraformerOutputKeyCloudRouter = "cloud_router"
 )
 
 var (
@@ -69,6 +72,7 @@ func ComputeTerraformerChartValues(
 	var (
 		vpcName   = DefaultVPCName
 		createVPC = true
+		vpc       = map[string]interface{}{}
 	)
 
 	networks := getK8SNetworks(cluster)
@@ -76,7 +80,14 @@ func ComputeTerraformerChartValues(
 	if config.Networks.VPC != nil {
 		createVPC = false
 		vpcName = config.Networks.VPC.Name
+
+		if config.Networks.VPC.CloudRouter != nil {
+			vpc["cloudRouter"] = map[string]interface{}{
+				"name": config.Networks.VPC.CloudRouter.Name,
+			}
+		}
 	}
+	vpc["name"] = vpcName
 
 	return map[string]interface{}{
 		"google": map[string]interface{}{
@@ -86,9 +97,7 @@ func ComputeTerraformerChartValues(
 		"create": map[string]interface{}{
 			"vpc": createVPC,
 		},
-		"vpc": map[string]interface{}{
-			"name": vpcName,
-		},
+		"vpc":         vpc,
 		"clusterName": infra.Namespace,
 		"networks": map[string]interface{}{
 			"pods":     networks.Pods,
@@ -101,6 +110,7 @@ func ComputeTerraformerChartValues(
 			"serviceAccountEmail": TerraformerOutputKeyServiceAccountEmail,
 			"subnetNodes":         TerraformerOutputKeySubnetNodes,
 			"subnetInternal":      TerraformerOutputKeySubnetInternal,
+			"cloudRouter":         TerraformerOutputKeyCloudRouter,
 		},
 	}
 }
@@ -144,36 +154,59 @@ type TerraformState struct {
 	SubnetNodes string
 	// SubnetInternal is the CIDR of the internal subnet of an infrastructure.
 	SubnetInternal *string
+	// CloudRouterName is the name of the existing Cloud Router of an infrastructure.
+	CloudRouterName *string
 }
 
-// ExtractTerraformState extracts the TerraformState from the given Terraformer.
-func ExtractTerraformState(tf *terraformer.Terraformer, config *gcpv1alpha1.InfrastructureConfig) (*TerraformState, error) {
-	outputKeys := []string{
-		TerraformerOutputKeyVPCName,
-		TerraformerOutputKeySubnetNodes,
-		TerraformerOutputKeyServiceAccountEmail,
+// ExtractTerraformState extracts the TerraformState from the resources and output variables of the given typed
+// Terraform state.
+func ExtractTerraformState(state *extensionsterraformer.State, config *gcpv1alpha1.InfrastructureConfig) (*TerraformState, error) {
+	vpcName, err := state.OutputString(TerraformerOutputKeyVPCName)
+	if err != nil {
+		return nil, err
 	}
 
-	hasInternal := config.Networks.Internal != nil
-	if hasInternal {
-		outputKeys = append(outputKeys, TerraformerOutputKeySubnetInternal)
+	serviceAccountEmail, err := resourceStringAttribute(state, "google_service_account", "serviceaccount", "email")
+	if err != nil {
+		return nil, err
 	}
 
-	vars, err := tf.GetStateOutputVariables(outputKeys...)
+	subnetNodes, err := resourceStringAttribute(state, "google_compute_subnetwork", "subnetwork-nodes", "name")
 	if err != nil {
 		return nil, err
 	}
 
-	state := &TerraformState{
-		VPCName:             vars[TerraformerOutputKeyVPCName],
-		SubnetNodes:         vars[TerraformerOutputKeySubnetNodes],
-		ServiceAccountEmail: vars[TerraformerOutputKeyServiceAccountEmail],
+	out := &TerraformState{
+		VPCName:             vpcName,
+		SubnetNodes:         subnetNodes,
+		ServiceAccountEmail: serviceAccountEmail,
+	}
+
+	if config.Networks.Internal != nil {
+		subnetInternal, err := resourceStringAttribute(state, "google_compute_subnetwork", "subnetwork-internal", "name")
+		if err != nil {
+			return nil, err
+		}
+		out.SubnetInternal = &subnetInternal
+	}
+
+	if config.Networks.VPC != nil && config.Networks.VPC.CloudRouter != nil {
+		cloudRouterName, err := resourceStringAttribute(state, "google_compute_router_nat", "nat", "router")
+		if err != nil {
+			return nil, err
+		}
+		out.CloudRouterName = &cloudRouterName
 	}
-	if hasInternal {
-		subnetInternal := vars[TerraformerOutputKeySubnetInternal]
-		state.SubnetInternal = &subnetInternal
+
+	return out, nil
+}
+
+func resourceStringAttribute(state *extensionsterraformer.State, resourceType, name, attribute string) (string, error) {
+	resource := state.Resource(resourceType, name)
+	if resource == nil {
+		return "", fmt.Errorf("resource %s.%s not found in Terraform state", resourceType, name)
 	}
-	return state, nil
+	return resource.StringAttribute(attribute)
 }
 
 // StatusFromTerraformState computes an InfrastructureStatus from the given
@@ -203,12 +236,22 @@ func StatusFromTerraformState(state *TerraformState) *gcpv1alpha1.Infrastructure
 			Name:    *state.SubnetInternal,
 		})
 	}
+	if state.CloudRouterName != nil {
+		status.Networks.VPC.CloudRouter = &gcpv1alpha1.CloudRouter{
+			Name: *state.CloudRouterName,
+		}
+	}
 	return status
 }
 
 // ComputeStatus computes the status based on the Terraformer and the given InfrastructureConfig.
-func ComputeStatus(tf *terraformer.Terraformer, config *gcpv1alpha1.InfrastructureConfig) (*gcpv1alpha1.InfrastructureStatus, error) {
-	state, err := ExtractTerraformState(tf, config)
+func ComputeStatus(tf extensionsterraformer.StateGetter, config *gcpv1alpha1.InfrastructureConfig) (*gcpv1alpha1.InfrastructureStatus, error) {
+	tfState, err := extensionsterraformer.ReadState(tf)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := ExtractTerraformState(tfState, config)
 	if err != nil {
 		return nil, err
 	}
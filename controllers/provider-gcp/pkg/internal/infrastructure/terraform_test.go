@@ -20,6 +20,7 @@ import (
 	gcpv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -123,6 +124,24 @@ var _ = Describe("Terraform", func() {
 					"serviceAccountEmail": TerraformerOutputKeyServiceAccountEmail,
 					"subnetNodes":         TerraformerOutputKeySubnetNodes,
 					"subnetInternal":      TerraformerOutputKeySubnetInternal,
+					"cloudRouter":         TerraformerOutputKeyCloudRouter,
+				},
+			}))
+		})
+
+		It("should correctly compute the terraformer chart values with an existing cloud router", func() {
+			config.Networks.VPC.CloudRouter = &gcpv1alpha1.CloudRouter{
+				Name: "cloudrouter",
+			}
+			values := ComputeTerraformerChartValues(infra, serviceAccount, config, cluster)
+
+			Expect(values["create"]).To(Equal(map[string]interface{}{
+				"vpc": false,
+			}))
+			Expect(values["vpc"]).To(Equal(map[string]interface{}{
+				"name": config.Networks.VPC.Name,
+				"cloudRouter": map[string]interface{}{
+					"name": "cloudrouter",
 				},
 			}))
 		})
@@ -154,11 +173,47 @@ var _ = Describe("Terraform", func() {
 					"serviceAccountEmail": TerraformerOutputKeyServiceAccountEmail,
 					"subnetNodes":         TerraformerOutputKeySubnetNodes,
 					"subnetInternal":      TerraformerOutputKeySubnetInternal,
+					"cloudRouter":         TerraformerOutputKeyCloudRouter,
 				},
 			}))
 		})
 	})
 
+	Describe("#ExtractTerraformState", func() {
+		It("should read the names from the resources and output variables of the state", func() {
+			config.Networks.VPC.CloudRouter = &gcpv1alpha1.CloudRouter{Name: "cloudrouter"}
+
+			state, err := extensionsterraformer.ParseState([]byte(`{
+  "version": 4,
+  "outputs": {"vpc_name": {"value": "vpc"}},
+  "resources": [
+    {"mode": "managed", "type": "google_service_account", "name": "serviceaccount", "instances": [{"attributes": {"email": "gardener@cloud"}}]},
+    {"mode": "managed", "type": "google_compute_subnetwork", "name": "subnetwork-nodes", "instances": [{"attributes": {"name": "foo-nodes"}}]},
+    {"mode": "managed", "type": "google_compute_subnetwork", "name": "subnetwork-internal", "instances": [{"attributes": {"name": "foo-internal"}}]},
+    {"mode": "managed", "type": "google_compute_router_nat", "name": "nat", "instances": [{"attributes": {"router": "cloudrouter"}}]}
+  ]
+}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			subnetInternal, cloudRouterName := "foo-internal", "cloudrouter"
+			Expect(ExtractTerraformState(state, config)).To(Equal(&TerraformState{
+				VPCName:             "vpc",
+				ServiceAccountEmail: "gardener@cloud",
+				SubnetNodes:         "foo-nodes",
+				SubnetInternal:      &subnetInternal,
+				CloudRouterName:     &cloudRouterName,
+			}))
+		})
+
+		It("should fail if a resource is missing in the state", func() {
+			state, err := extensionsterraformer.ParseState([]byte(`{"version": 4, "outputs": {"vpc_name": {"value": "vpc"}}}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = ExtractTerraformState(state, config)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("#StatusFromTerraformState", func() {
 		var (
 			serviceAccountEmail string
@@ -207,6 +262,19 @@ var _ = Describe("Terraform", func() {
 			}))
 		})
 
+		It("should correctly compute the status with an existing cloud router", func() {
+			cloudRouterName := "cloudrouter"
+			state.CloudRouterName = &cloudRouterName
+			status := StatusFromTerraformState(state)
+
+			Expect(status.Networks.VPC).To(Equal(gcpv1alpha1.VPC{
+				Name: vpcName,
+				CloudRouter: &gcpv1alpha1.CloudRouter{
+					Name: cloudRouterName,
+				},
+			}))
+		})
+
 		It("should correctly compute the status without internal subnet", func() {
 			state.SubnetInternal = nil
 			status := StatusFromTerraformState(state)
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client (interfaces: Interface,FirewallsService,RoutesService,FirewallsListCall,RoutesListCall,FirewallsDeleteCall,RoutesDeleteCall)
+// Source: github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client (interfaces: Interface,FirewallsService,RoutesService,FirewallsListCall,RoutesListCall,FirewallsDeleteCall,RoutesDeleteCall,NetworksService,RoutersService,NetworksGetCall,RoutersGetCall)
 
 // Package client is a generated GoMock package.
 package client
@@ -50,6 +50,34 @@ func (mr *MockInterfaceMockRecorder) Firewalls() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Firewalls", reflect.TypeOf((*MockInterface)(nil).Firewalls))
 }
 
+// Networks mocks base method
+func (m *MockInterface) Networks() client.NetworksService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Networks")
+	ret0, _ := ret[0].(client.NetworksService)
+	return ret0
+}
+
+// Networks indicates an expected call of Networks
+func (mr *MockInterfaceMockRecorder) Networks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Networks", reflect.TypeOf((*MockInterface)(nil).Networks))
+}
+
+// Routers mocks base method
+func (m *MockInterface) Routers() client.RoutersService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Routers")
+	ret0, _ := ret[0].(client.RoutersService)
+	return ret0
+}
+
+// Routers indicates an expected call of Routers
+func (mr *MockInterfaceMockRecorder) Routers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Routers", reflect.TypeOf((*MockInterface)(nil).Routers))
+}
+
 // Routes mocks base method
 func (m *MockInterface) Routes() client.RoutesService {
 	m.ctrl.T.Helper()
@@ -351,3 +379,189 @@ func (mr *MockRoutesDeleteCallMockRecorder) Do(arg0 ...interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockRoutesDeleteCall)(nil).Do), arg0...)
 }
+
+// MockNetworksService is a mock of NetworksService interface
+type MockNetworksService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworksServiceMockRecorder
+}
+
+// MockNetworksServiceMockRecorder is the mock recorder for MockNetworksService
+type MockNetworksServiceMockRecorder struct {
+	mock *MockNetworksService
+}
+
+// NewMockNetworksService creates a new mock instance
+func NewMockNetworksService(ctrl *gomock.Controller) *MockNetworksService {
+	mock := &MockNetworksService{ctrl: ctrl}
+	mock.recorder = &MockNetworksServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNetworksService) EXPECT() *MockNetworksServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockNetworksService) Get(arg0, arg1 string) client.NetworksGetCall {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(client.NetworksGetCall)
+	return ret0
+}
+
+// Get indicates an expected call of Get
+func (mr *MockNetworksServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNetworksService)(nil).Get), arg0, arg1)
+}
+
+// MockRoutersService is a mock of RoutersService interface
+type MockRoutersService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoutersServiceMockRecorder
+}
+
+// MockRoutersServiceMockRecorder is the mock recorder for MockRoutersService
+type MockRoutersServiceMockRecorder struct {
+	mock *MockRoutersService
+}
+
+// NewMockRoutersService creates a new mock instance
+func NewMockRoutersService(ctrl *gomock.Controller) *MockRoutersService {
+	mock := &MockRoutersService{ctrl: ctrl}
+	mock.recorder = &MockRoutersServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRoutersService) EXPECT() *MockRoutersServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockRoutersService) Get(arg0, arg1, arg2 string) client.RoutersGetCall {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(client.RoutersGetCall)
+	return ret0
+}
+
+// Get indicates an expected call of Get
+func (mr *MockRoutersServiceMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRoutersService)(nil).Get), arg0, arg1, arg2)
+}
+
+// MockNetworksGetCall is a mock of NetworksGetCall interface
+type MockNetworksGetCall struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworksGetCallMockRecorder
+}
+
+// MockNetworksGetCallMockRecorder is the mock recorder for MockNetworksGetCall
+type MockNetworksGetCallMockRecorder struct {
+	mock *MockNetworksGetCall
+}
+
+// NewMockNetworksGetCall creates a new mock instance
+func NewMockNetworksGetCall(ctrl *gomock.Controller) *MockNetworksGetCall {
+	mock := &MockNetworksGetCall{ctrl: ctrl}
+	mock.recorder = &MockNetworksGetCallMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNetworksGetCall) EXPECT() *MockNetworksGetCallMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method
+func (m *MockNetworksGetCall) Context(arg0 context.Context) client.NetworksGetCall {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context", arg0)
+	ret0, _ := ret[0].(client.NetworksGetCall)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockNetworksGetCallMockRecorder) Context(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockNetworksGetCall)(nil).Context), arg0)
+}
+
+// Do mocks base method
+func (m *MockNetworksGetCall) Do(arg0 ...googleapi.CallOption) (*v1.Network, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do
+func (mr *MockNetworksGetCallMockRecorder) Do(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockNetworksGetCall)(nil).Do), arg0...)
+}
+
+// MockRoutersGetCall is a mock of RoutersGetCall interface
+type MockRoutersGetCall struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoutersGetCallMockRecorder
+}
+
+// MockRoutersGetCallMockRecorder is the mock recorder for MockRoutersGetCall
+type MockRoutersGetCallMockRecorder struct {
+	mock *MockRoutersGetCall
+}
+
+// NewMockRoutersGetCall creates a new mock instance
+func NewMockRoutersGetCall(ctrl *gomock.Controller) *MockRoutersGetCall {
+	mock := &MockRoutersGetCall{ctrl: ctrl}
+	mock.recorder = &MockRoutersGetCallMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRoutersGetCall) EXPECT() *MockRoutersGetCallMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method
+func (m *MockRoutersGetCall) Context(arg0 context.Context) client.RoutersGetCall {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context", arg0)
+	ret0, _ := ret[0].(client.RoutersGetCall)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockRoutersGetCallMockRecorder) Context(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockRoutersGetCall)(nil).Context), arg0)
+}
+
+// Do mocks base method
+func (m *MockRoutersGetCall) Do(arg0 ...googleapi.CallOption) (*v1.Router, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Router)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do
+func (mr *MockRoutersGetCallMockRecorder) Do(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockRoutersGetCall)(nil).Do), arg0...)
+}
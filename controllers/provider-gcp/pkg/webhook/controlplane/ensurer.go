@@ -22,7 +22,9 @@ import (
 
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
+	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	extensionscontext "github.com/gardener/gardener-extensions/pkg/webhook/context"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/genericmutator"
 
@@ -55,7 +57,7 @@ func (e *ensurer) InjectClient(client client.Client) error {
 }
 
 // EnsureKubeAPIServerDeployment ensures that the kube-apiserver deployment conforms to the provider requirements.
-func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1.Deployment) error {
+func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, gctx extensionscontext.GardenContext, dep *appsv1.Deployment) error {
 	template := &dep.Spec.Template
 	ps := &template.Spec
 	if c := extensionswebhook.ContainerWithName(ps.Containers, "kube-apiserver"); c != nil {
@@ -68,11 +70,13 @@ func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1
 }
 
 // EnsureKubeControllerManagerDeployment ensures that the kube-controller-manager deployment conforms to the provider requirements.
-func (e *ensurer) EnsureKubeControllerManagerDeployment(ctx context.Context, dep *appsv1.Deployment) error {
+func (e *ensurer) EnsureKubeControllerManagerDeployment(ctx context.Context, gctx extensionscontext.GardenContext, dep *appsv1.Deployment) error {
 	template := &dep.Spec.Template
 	ps := &template.Spec
 	if c := extensionswebhook.ContainerWithName(ps.Containers, "kube-controller-manager"); c != nil {
-		ensureKubeControllerManagerCommandLineArgs(c)
+		if err := ensureCommandLineArgs(ctx, gctx, c, kubeControllerManagerFlags); err != nil {
+			return err
+		}
 		ensureEnvVars(c)
 		ensureVolumeMounts(c)
 	}
@@ -91,11 +95,30 @@ func ensureKubeAPIServerCommandLineArgs(c *corev1.Container) {
 		"PersistentVolumeLabel", ",")
 }
 
-func ensureKubeControllerManagerCommandLineArgs(c *corev1.Container) {
-	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--cloud-provider=", "external")
-	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--cloud-config=",
-		"/etc/kubernetes/cloudprovider/cloudprovider.conf")
-	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--external-cloud-volume-plugin=", "gce")
+var (
+	kubeControllerManagerFlags = []extensionswebhook.VersionedFlag{
+		extensionswebhook.EnsureFlag("--cloud-provider", "external"),
+		extensionswebhook.EnsureFlag("--cloud-config", "/etc/kubernetes/cloudprovider/cloudprovider.conf"),
+		extensionswebhook.EnsureFlag("--external-cloud-volume-plugin", "gce").ForVersions(gcp.InTreeVolumePluginVersionConstraint),
+		extensionswebhook.RemoveFlag("--external-cloud-volume-plugin").ForVersions(gcp.CSIVersionConstraint),
+		extensionswebhook.RemoveFlagListItem("--feature-gates", "CSIMigration=false").ForVersions(gcp.CSIVersionConstraint),
+		extensionswebhook.EnsureFlagListItem("--feature-gates", "CSIMigration=true").ForVersions(gcp.CSIVersionConstraint),
+		extensionswebhook.RemoveFlagListItem("--feature-gates", "CSIMigrationGCE=false").ForVersions(gcp.CSIVersionConstraint),
+		extensionswebhook.EnsureFlagListItem("--feature-gates", "CSIMigrationGCE=true").ForVersions(gcp.CSIVersionConstraint),
+	}
+	kubeletFlags = []extensionswebhook.VersionedFlag{
+		extensionswebhook.EnsureFlag("--cloud-provider", "gce").ForVersions(gcp.InTreeVolumePluginVersionConstraint),
+		extensionswebhook.EnsureFlag("--cloud-provider", "external").ForVersions(gcp.CSIVersionConstraint),
+	}
+)
+
+func ensureCommandLineArgs(ctx context.Context, gctx extensionscontext.GardenContext, c *corev1.Container, flags []extensionswebhook.VersionedFlag) error {
+	command, err := controlplane.EnsureVersionedFlags(ctx, gctx, c.Command, flags...)
+	if err != nil {
+		return err
+	}
+	c.Command = command
+	return nil
 }
 
 func ensureKubeControllerManagerAnnotations(t *corev1.PodTemplateSpec) {
@@ -161,10 +184,13 @@ func (e *ensurer) ensureChecksumAnnotations(ctx context.Context, template *corev
 }
 
 // EnsureKubeletServiceUnitOptions ensures that the kubelet.service unit options conform to the provider requirements.
-func (e *ensurer) EnsureKubeletServiceUnitOptions(ctx context.Context, opts []*unit.UnitOption) ([]*unit.UnitOption, error) {
+func (e *ensurer) EnsureKubeletServiceUnitOptions(ctx context.Context, gctx extensionscontext.GardenContext, opts []*unit.UnitOption) ([]*unit.UnitOption, error) {
 	if opt := extensionswebhook.UnitOptionWithSectionAndName(opts, "Service", "ExecStart"); opt != nil {
 		command := extensionswebhook.DeserializeCommandLine(opt.Value)
-		command = ensureKubeletCommandLineArgs(command)
+		command, err := controlplane.EnsureVersionedFlags(ctx, gctx, command, kubeletFlags...)
+		if err != nil {
+			return nil, err
+		}
 		opt.Value = extensionswebhook.SerializeCommandLine(command, 1, " \\\n    ")
 	}
 	opts = extensionswebhook.EnsureUnitOption(opts, &unit.UnitOption{
@@ -175,13 +201,27 @@ func (e *ensurer) EnsureKubeletServiceUnitOptions(ctx context.Context, opts []*u
 	return opts, nil
 }
 
-func ensureKubeletCommandLineArgs(command []string) []string {
-	command = extensionswebhook.EnsureStringWithPrefix(command, "--cloud-provider=", "gce")
-	return command
-}
-
 // EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
+func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, gctx extensionscontext.GardenContext, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
+	version, err := gctx.GetKubernetesVersion(ctx)
+	if err != nil {
+		return err
+	}
+	csiEnabled, err := util.VersionMatchesConstraint(version, gcp.CSIVersionConstraint)
+	if err != nil {
+		return err
+	}
+
+	if csiEnabled {
+		// Ensure in-tree GCE PD volumes are migrated to the CSI driver
+		if kubeletConfig.FeatureGates == nil {
+			kubeletConfig.FeatureGates = make(map[string]bool)
+		}
+		kubeletConfig.FeatureGates["CSIMigration"] = true
+		kubeletConfig.FeatureGates["CSIMigrationGCE"] = true
+		return nil
+	}
+
 	// Make sure CSI-related feature gates are not enabled
 	// TODO Leaving these enabled shouldn't do any harm, perhaps remove this code when properly tested?
 	delete(kubeletConfig.FeatureGates, "VolumeSnapshotDataSource")
@@ -193,7 +233,7 @@ func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, kubeletConfig
 var regexFindProperty = regexp.MustCompile("net.ipv4.ip_forward[[:space:]]*=[[:space:]]*([[:alnum:]]+)")
 
 // EnsureKubernetesGeneralConfiguration ensures that the kubernetes general configuration conforms to the provider requirements.
-func (e *ensurer) EnsureKubernetesGeneralConfiguration(ctx context.Context, data *string) error {
+func (e *ensurer) EnsureKubernetesGeneralConfiguration(ctx context.Context, gctx extensionscontext.GardenContext, data *string) error {
 	// If the needed property exists, ensure the correct value
 	if regexFindProperty.MatchString(*data) {
 		res := regexFindProperty.ReplaceAll([]byte(*data), []byte("net.ipv4.ip_forward = 1"))
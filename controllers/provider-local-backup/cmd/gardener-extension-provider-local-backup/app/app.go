@@ -0,0 +1,107 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	localbackupcmd "github.com/gardener/gardener-extensions/controllers/provider-local-backup/pkg/cmd"
+	localbackupbackupbucket "github.com/gardener/gardener-extensions/controllers/provider-local-backup/pkg/controller/backupbucket"
+	localbackupbackupentry "github.com/gardener/gardener-extensions/controllers/provider-local-backup/pkg/controller/backupentry"
+	"github.com/gardener/gardener-extensions/controllers/provider-local-backup/pkg/localbackup"
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	controllercmd "github.com/gardener/gardener-extensions/pkg/controller/cmd"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// NewControllerManagerCommand creates a new command for running a local backup provider controller.
+func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
+	var (
+		mgrConfigFileOpts = &controllercmd.ManagerConfigFileOptions{}
+		restOpts          = &controllercmd.RESTOptions{}
+		mgrOpts           = &controllercmd.ManagerOptions{
+			LeaderElection:          true,
+			LeaderElectionID:        controllercmd.LeaderElectionNameID(localbackup.Name),
+			LeaderElectionNamespace: os.Getenv("LEADER_ELECTION_NAMESPACE"),
+		}
+		storageOpts = &localbackupcmd.StorageOptions{}
+
+		// options for the backupbucket controller
+		backupBucketCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+
+		// options for the backupentry controller
+		backupEntryCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+		reconcileOpts = &controllercmd.ReconcilerOptions{}
+
+		controllerSwitches = localbackupcmd.ControllerSwitchOptions()
+
+		aggOption = controllercmd.NewOptionAggregator(
+			mgrConfigFileOpts,
+			restOpts,
+			mgrOpts,
+			storageOpts,
+			controllercmd.PrefixOption("backupbucket-", backupBucketCtrlOpts),
+			controllercmd.PrefixOption("backupentry-", backupEntryCtrlOpts),
+			controllerSwitches,
+			reconcileOpts,
+		)
+	)
+
+	cmd := &cobra.Command{
+		Use: fmt.Sprintf("%s-controller-manager", localbackup.Name),
+
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := aggOption.Complete(); err != nil {
+				controllercmd.LogErrAndExit(err, "Error completing options")
+			}
+
+			mgr, err := manager.New(restOpts.Completed().Config, mgrOpts.Completed().Options())
+			if err != nil {
+				controllercmd.LogErrAndExit(err, "Could not instantiate manager")
+			}
+
+			if err := controller.AddToScheme(mgr.GetScheme()); err != nil {
+				controllercmd.LogErrAndExit(err, "Could not update manager scheme")
+			}
+
+			storageOpts.Completed().Apply(&localbackupbackupbucket.DefaultAddOptions.RootDir)
+			storageOpts.Completed().Apply(&localbackupbackupentry.DefaultAddOptions.RootDir)
+			backupBucketCtrlOpts.Completed().Apply(&localbackupbackupbucket.DefaultAddOptions.Controller)
+			backupEntryCtrlOpts.Completed().Apply(&localbackupbackupentry.DefaultAddOptions.Controller)
+			reconcileOpts.Completed().Apply(&localbackupbackupbucket.DefaultAddOptions.IgnoreOperationAnnotation)
+			reconcileOpts.Completed().Apply(&localbackupbackupentry.DefaultAddOptions.IgnoreOperationAnnotation)
+
+			if err := controllerSwitches.Completed().AddToManager(mgr); err != nil {
+				controllercmd.LogErrAndExit(err, "Could not add controllers to manager")
+			}
+
+			if err := mgr.Start(ctx.Done()); err != nil {
+				controllercmd.LogErrAndExit(err, "Error running manager")
+			}
+		},
+	}
+
+	aggOption.AddFlags(cmd.Flags())
+
+	return cmd
+}
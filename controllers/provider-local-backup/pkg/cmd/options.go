@@ -0,0 +1,75 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	backupbucketcontroller "github.com/gardener/gardener-extensions/controllers/provider-local-backup/pkg/controller/backupbucket"
+	backupentrycontroller "github.com/gardener/gardener-extensions/controllers/provider-local-backup/pkg/controller/backupentry"
+	"github.com/gardener/gardener-extensions/controllers/provider-local-backup/pkg/localbackup"
+	extensionsbackupbucketcontroller "github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	extensionsbackupentrycontroller "github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+	controllercmd "github.com/gardener/gardener-extensions/pkg/controller/cmd"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	// RootDirFlag is the name of the command line flag to specify the directory below which the buckets are
+	// kept on the local filesystem.
+	RootDirFlag = "root-dir"
+)
+
+// ControllerSwitchOptions are the controllercmd.SwitchOptions for the provider controllers.
+func ControllerSwitchOptions() *controllercmd.SwitchOptions {
+	return controllercmd.NewSwitchOptions(
+		controllercmd.Switch(extensionsbackupbucketcontroller.ControllerName, backupbucketcontroller.AddToManager),
+		controllercmd.Switch(extensionsbackupentrycontroller.ControllerName, backupentrycontroller.AddToManager),
+	)
+}
+
+// StorageOptions are command line options for the local filesystem object store.
+type StorageOptions struct {
+	// RootDir is the directory below which the buckets are kept on the local filesystem.
+	RootDir string
+
+	config *StorageConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (s *StorageOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&s.RootDir, RootDirFlag, localbackup.DefaultRootDir, "Directory below which the buckets are kept on the local filesystem.")
+}
+
+// Complete implements Completer.Complete.
+func (s *StorageOptions) Complete() error {
+	s.config = &StorageConfig{s.RootDir}
+	return nil
+}
+
+// Completed returns the completed StorageConfig. Only call this if `Complete` was successful.
+func (s *StorageOptions) Completed() *StorageConfig {
+	return s.config
+}
+
+// StorageConfig is a completed local filesystem object store configuration.
+type StorageConfig struct {
+	// RootDir is the directory below which the buckets are kept on the local filesystem.
+	RootDir string
+}
+
+// Apply sets the root directory of this StorageConfig in the given string.
+func (c *StorageConfig) Apply(rootDir *string) {
+	*rootDir = c.RootDir
+}
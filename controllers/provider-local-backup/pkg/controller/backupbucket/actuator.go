@@ -0,0 +1,107 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-local-backup/pkg/localbackup"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator/filesystem"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// actuator keeps the buckets in the S3-compatible object store specified by the secret of a BackupBucket, or
+// below the root directory of the local filesystem if the secret does not specify an endpoint.
+type actuator struct {
+	client     client.Client
+	logger     logr.Logger
+	filesystem genericactuator.BackupBucketDelegate
+}
+
+func newActuator(rootDir string) genericactuator.BackupBucketDelegate {
+	return &actuator{
+		logger:     logger,
+		filesystem: filesystem.NewBackupBucketDelegate(rootDir),
+	}
+}
+
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+func (a *actuator) GetGeneratedSecretData(_ context.Context, _ *extensionsv1alpha1.BackupBucket) (map[string][]byte, error) {
+	return nil, nil
+}
+
+func (a *actuator) CreateBucketIfNotExists(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	credentials, err := localbackup.GetCredentialsFromSecretRef(ctx, a.client, bb.Spec.SecretRef)
+	if err != nil {
+		return err
+	}
+	if credentials == nil {
+		return a.filesystem.CreateBucketIfNotExists(ctx, bb)
+	}
+
+	s3Client, err := localbackup.NewClientFromCredentials(credentials)
+	if err != nil {
+		return err
+	}
+
+	return s3Client.CreateBucketIfNotExists(ctx, bb.Name)
+}
+
+// EnsureLifecyclePolicy does nothing as the lifecycle of the objects is managed by etcd-backup-restore.
+func (a *actuator) EnsureLifecyclePolicy(_ context.Context, _ *extensionsv1alpha1.BackupBucket) error {
+	return nil
+}
+
+func (a *actuator) DeleteObjectsWithPrefix(ctx context.Context, bb *extensionsv1alpha1.BackupBucket, prefix string) error {
+	credentials, err := localbackup.GetCredentialsFromSecretRef(ctx, a.client, bb.Spec.SecretRef)
+	if err != nil {
+		return err
+	}
+	if credentials == nil {
+		return a.filesystem.DeleteObjectsWithPrefix(ctx, bb, prefix)
+	}
+
+	s3Client, err := localbackup.NewClientFromCredentials(credentials)
+	if err != nil {
+		return err
+	}
+
+	return s3Client.DeleteObjectsWithPrefix(ctx, bb.Name, prefix)
+}
+
+func (a *actuator) DeleteBucketIfExists(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	credentials, err := localbackup.GetCredentialsFromSecretRef(ctx, a.client, bb.Spec.SecretRef)
+	if err != nil {
+		return err
+	}
+	if credentials == nil {
+		return a.filesystem.DeleteBucketIfExists(ctx, bb)
+	}
+
+	s3Client, err := localbackup.NewClientFromCredentials(credentials)
+	if err != nil {
+		return err
+	}
+
+	return s3Client.DeleteBucketIfExists(ctx, bb.Name)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-local-backup/pkg/localbackup"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator/filesystem"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// actuator deletes the objects of a BackupEntry from the S3-compatible object store specified by its secret, or
+// from below the root directory of the local filesystem if the secret does not specify an endpoint.
+type actuator struct {
+	client     client.Client
+	logger     logr.Logger
+	rootDir    string
+	filesystem genericactuator.BackupEntryDelegate
+}
+
+func newActuator(rootDir string) genericactuator.BackupEntryDelegate {
+	return &actuator{
+		logger:     logger,
+		rootDir:    rootDir,
+		filesystem: filesystem.NewBackupEntryDelegate(rootDir),
+	}
+}
+
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+// GetETCDSecretData adds the etcd-backup-restore storage provider to the given backup secret data. For the local
+// filesystem it adds the directory below which the buckets are kept, for S3-compatible object stores the region
+// and path-style addressing.
+func (a *actuator) GetETCDSecretData(_ context.Context, _ *extensionsv1alpha1.BackupEntry, backupSecretData map[string][]byte) (map[string][]byte, error) {
+	credentials, err := localbackup.ReadCredentialsSecret(&corev1.Secret{Data: backupSecretData})
+	if err != nil {
+		return nil, err
+	}
+
+	if credentials == nil {
+		backupSecretData[localbackup.StorageProvider] = []byte(localbackup.StorageProviderLocal)
+		backupSecretData[localbackup.HostPath] = []byte(a.rootDir)
+		return backupSecretData, nil
+	}
+
+	backupSecretData[localbackup.StorageProvider] = []byte(localbackup.StorageProviderS3)
+	backupSecretData[localbackup.Region] = credentials.Region
+	backupSecretData[localbackup.S3ForcePathStyle] = []byte("true")
+	return backupSecretData, nil
+}
+
+func (a *actuator) Delete(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
+	credentials, err := localbackup.GetCredentialsFromSecretRef(ctx, a.client, be.Spec.SecretRef)
+	if err != nil {
+		return err
+	}
+	if credentials == nil {
+		return a.filesystem.Delete(ctx, be)
+	}
+
+	s3Client, err := localbackup.NewClientFromCredentials(credentials)
+	if err != nil {
+		return err
+	}
+
+	return s3Client.DeleteObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/", be.Name))
+}
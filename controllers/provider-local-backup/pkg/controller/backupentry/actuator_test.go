@@ -0,0 +1,77 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-local-backup/pkg/localbackup"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Actuator", func() {
+	const rootDir = "/var/lib/backups"
+
+	var (
+		ctx = context.TODO()
+		be  = &extensionsv1alpha1.BackupEntry{}
+	)
+
+	Describe("#GetETCDSecretData", func() {
+		It("should return the secret data for the local filesystem", func() {
+			data, err := newActuator(rootDir).GetETCDSecretData(ctx, be, map[string][]byte{
+				localbackup.BucketName: []byte("bucket"),
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(map[string][]byte{
+				localbackup.BucketName:      []byte("bucket"),
+				localbackup.StorageProvider: []byte(localbackup.StorageProviderLocal),
+				localbackup.HostPath:        []byte(rootDir),
+			}))
+		})
+
+		It("should return the secret data for an S3-compatible object store", func() {
+			data, err := newActuator(rootDir).GetETCDSecretData(ctx, be, map[string][]byte{
+				localbackup.BucketName:      []byte("bucket"),
+				localbackup.Endpoint:        []byte("http://minio:9000"),
+				localbackup.AccessKeyID:     []byte("foo"),
+				localbackup.SecretAccessKey: []byte("bar"),
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(map[string][]byte{
+				localbackup.BucketName:       []byte("bucket"),
+				localbackup.Endpoint:         []byte("http://minio:9000"),
+				localbackup.AccessKeyID:      []byte("foo"),
+				localbackup.SecretAccessKey:  []byte("bar"),
+				localbackup.StorageProvider:  []byte(localbackup.StorageProviderS3),
+				localbackup.Region:           []byte(localbackup.DefaultRegion),
+				localbackup.S3ForcePathStyle: []byte("true"),
+			}))
+		})
+
+		It("should fail for an S3-compatible object store without credentials", func() {
+			_, err := newActuator(rootDir).GetETCDSecretData(ctx, be, map[string][]byte{
+				localbackup.Endpoint: []byte("http://minio:9000"),
+			})
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
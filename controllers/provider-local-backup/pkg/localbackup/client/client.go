@@ -0,0 +1,123 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// NewClient creates a new Client for the S3-compatible object store at <endpoint> with the given credentials
+// <accessKeyID> and <secretAccessKey>, and the region <region>. Buckets are addressed path-style as most
+// S3-compatible object stores do not support virtual-hosted-style addressing.
+func NewClient(accessKeyID, secretAccessKey, endpoint, region string) (Interface, error) {
+	s, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials(accessKeyID, secretAccessKey, ""),
+		Endpoint:         aws.String(endpoint),
+		Region:           aws.String(region),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		S3:     s3.New(s),
+		Region: region,
+	}, nil
+}
+
+// CreateBucketIfNotExists creates the bucket with name <bucket>. If it already exists, no error is returned.
+func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket string) error {
+	createBucketInput := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		ACL:    aws.String(s3.BucketCannedACLPrivate),
+	}
+	if c.Region != "us-east-1" {
+		createBucketInput.CreateBucketConfiguration = &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String(c.Region),
+		}
+	}
+
+	if _, err := c.S3.CreateBucketWithContext(ctx, createBucketInput); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == s3.ErrCodeBucketAlreadyExists || aerr.Code() == s3.ErrCodeBucketAlreadyOwnedByYou) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteObjectsWithPrefix deletes the objects with the specific <prefix> from <bucket>. If the bucket does not
+// exist, no error is returned.
+func (c *Client) DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error {
+	in := &s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}
+
+	var deleteErr error
+	if err := c.S3.ListObjectsPagesWithContext(ctx, in, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		objectIDs := make([]*s3.ObjectIdentifier, 0, len(page.Contents))
+		for _, key := range page.Contents {
+			objectIDs = append(objectIDs, &s3.ObjectIdentifier{Key: key.Key})
+		}
+		if len(objectIDs) == 0 {
+			return !lastPage
+		}
+
+		// A page holds at most 1000 objects which is the limit of a single delete request.
+		if _, deleteErr = c.S3.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objectIDs,
+			},
+		}); deleteErr != nil {
+			return false
+		}
+		return !lastPage
+	}); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket {
+			return nil
+		}
+		return err
+	}
+	return deleteErr
+}
+
+// DeleteBucketIfExists deletes the bucket with name <bucket> including all its objects. If it does not exist,
+// no error is returned.
+func (c *Client) DeleteBucketIfExists(ctx context.Context, bucket string) error {
+	if _, err := c.S3.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)}); err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			if aerr.Code() == s3.ErrCodeNoSuchBucket {
+				return nil
+			}
+			if aerr.Code() == errCodeBucketNotEmpty {
+				if err := c.DeleteObjectsWithPrefix(ctx, bucket, ""); err != nil {
+					return err
+				}
+				return c.DeleteBucketIfExists(ctx, bucket)
+			}
+		}
+		return err
+	}
+	return nil
+}
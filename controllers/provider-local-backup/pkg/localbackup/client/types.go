@@ -0,0 +1,40 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+const (
+	// errCodeBucketNotEmpty is the error code returned by S3-compatible object stores when deleting a bucket
+	// that still contains objects. The AWS SDK is missing this constant.
+	errCodeBucketNotEmpty = "BucketNotEmpty"
+)
+
+// Interface is an interface which must be implemented by clients of S3-compatible object stores.
+type Interface interface {
+	CreateBucketIfNotExists(ctx context.Context, bucket string) error
+	DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error
+	DeleteBucketIfExists(ctx context.Context, bucket string) error
+}
+
+// Client is a client for S3-compatible object stores like MinIO.
+type Client struct {
+	S3     s3iface.S3API
+	Region string
+}
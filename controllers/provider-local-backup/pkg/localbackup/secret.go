@@ -0,0 +1,73 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localbackup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-local-backup/pkg/localbackup/client"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReadCredentialsSecret reads a secret containing the credentials of an S3-compatible object store. It returns
+// nil if the secret does not contain an endpoint, i.e. if the buckets are kept on the local filesystem.
+func ReadCredentialsSecret(secret *corev1.Secret) (*Credentials, error) {
+	endpoint, ok := secret.Data[Endpoint]
+	if !ok || len(endpoint) == 0 {
+		return nil, nil
+	}
+
+	accessKeyID, ok := secret.Data[AccessKeyID]
+	if !ok {
+		return nil, fmt.Errorf("missing %q field in secret", AccessKeyID)
+	}
+
+	secretAccessKey, ok := secret.Data[SecretAccessKey]
+	if !ok {
+		return nil, fmt.Errorf("missing %q field in secret", SecretAccessKey)
+	}
+
+	region := secret.Data[Region]
+	if len(region) == 0 {
+		region = []byte(DefaultRegion)
+	}
+
+	return &Credentials{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		Endpoint:        endpoint,
+		Region:          region,
+	}, nil
+}
+
+// GetCredentialsFromSecretRef reads the credentials of an S3-compatible object store from the given k8s
+// <secretRef>, see ReadCredentialsSecret.
+func GetCredentialsFromSecretRef(ctx context.Context, c controllerclient.Client, secretRef corev1.SecretReference) (*Credentials, error) {
+	secret, err := extensionscontroller.GetSecretByReference(ctx, c, &secretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadCredentialsSecret(secret)
+}
+
+// NewClientFromCredentials creates a new client for the S3-compatible object store with the given credentials.
+func NewClientFromCredentials(credentials *Credentials) (client.Interface, error) {
+	return client.NewClient(string(credentials.AccessKeyID), string(credentials.SecretAccessKey), string(credentials.Endpoint), string(credentials.Region))
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localbackup_test
+
+import (
+	. "github.com/gardener/gardener-extensions/controllers/provider-local-backup/pkg/localbackup"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Secret", func() {
+	var secret *corev1.Secret
+
+	BeforeEach(func() {
+		secret = &corev1.Secret{}
+	})
+
+	Describe("#ReadCredentialsSecret", func() {
+		It("should return no credentials because the endpoint is missing", func() {
+			secret.Data = map[string][]byte{
+				AccessKeyID: []byte("foo"),
+			}
+
+			credentials, err := ReadCredentialsSecret(secret)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials).To(BeNil())
+		})
+
+		It("should return an error because access key id is missing", func() {
+			secret.Data = map[string][]byte{
+				Endpoint: []byte("http://minio:9000"),
+			}
+
+			credentials, err := ReadCredentialsSecret(secret)
+
+			Expect(credentials).To(BeNil())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error because secret access key is missing", func() {
+			secret.Data = map[string][]byte{
+				Endpoint:    []byte("http://minio:9000"),
+				AccessKeyID: []byte("foo"),
+			}
+
+			credentials, err := ReadCredentialsSecret(secret)
+
+			Expect(credentials).To(BeNil())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return the credentials structure with the default region", func() {
+			var (
+				endpoint = []byte("http://minio:9000")
+				id       = []byte("foo")
+				key      = []byte("bar")
+			)
+
+			secret.Data = map[string][]byte{
+				Endpoint:        endpoint,
+				AccessKeyID:     id,
+				SecretAccessKey: key,
+			}
+
+			credentials, err := ReadCredentialsSecret(secret)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials).To(Equal(&Credentials{
+				AccessKeyID:     id,
+				SecretAccessKey: key,
+				Endpoint:        endpoint,
+				Region:          []byte(DefaultRegion),
+			}))
+		})
+
+		It("should return the credentials structure with the given region", func() {
+			region := []byte("eu-central-1")
+			secret.Data = map[string][]byte{
+				Endpoint:        []byte("http://minio:9000"),
+				AccessKeyID:     []byte("foo"),
+				SecretAccessKey: []byte("bar"),
+				Region:          region,
+			}
+
+			credentials, err := ReadCredentialsSecret(secret)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials.Region).To(Equal(region))
+		})
+	})
+})
@@ -0,0 +1,64 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localbackup
+
+const (
+	// Name is the name of the local backup provider.
+	Name = "provider-local-backup"
+	// Type is the type of the BackupBucket and BackupEntry resources handled by the local backup provider.
+	Type = "local"
+
+	// DefaultRootDir is the default directory below which the buckets are kept on the local filesystem.
+	DefaultRootDir = "/var/lib/gardener/backups"
+	// DefaultRegion is the region that is used for S3-compatible object stores if the backup secret does not
+	// specify one.
+	DefaultRegion = "us-east-1"
+
+	// AccessKeyID is a constant for the key in a backup secret that holds the access key id of an S3-compatible
+	// object store.
+	AccessKeyID = "accessKeyID"
+	// SecretAccessKey is a constant for the key in a backup secret that holds the secret access key of an
+	// S3-compatible object store.
+	SecretAccessKey = "secretAccessKey"
+	// Endpoint is a constant for the key in a backup secret that holds the URL of an S3-compatible object store.
+	// Backup secrets without an endpoint select the local filesystem as object store.
+	Endpoint = "endpoint"
+	// Region is a constant for the key in a backup secret that holds the region of an S3-compatible object store.
+	Region = "region"
+	// S3ForcePathStyle is a constant for the key in an etcd backup secret that tells etcd-backup-restore to use
+	// path-style addressing for the buckets of an S3-compatible object store.
+	S3ForcePathStyle = "s3ForcePathStyle"
+	// BucketName is a constant for the key in an etcd backup secret that holds the bucket name.
+	BucketName = "bucketName"
+	// HostPath is a constant for the key in an etcd backup secret that holds the directory below which the
+	// buckets are kept on the local filesystem.
+	HostPath = "hostPath"
+	// StorageProvider is a constant for the key in an etcd backup secret that holds the etcd-backup-restore
+	// storage provider, i.e. StorageProviderLocal or StorageProviderS3.
+	StorageProvider = "storageProvider"
+
+	// StorageProviderLocal is the name of the etcd-backup-restore storage provider for the local filesystem.
+	StorageProviderLocal = "Local"
+	// StorageProviderS3 is the name of the etcd-backup-restore storage provider for S3-compatible object stores.
+	StorageProviderS3 = "S3"
+)
+
+// Credentials stores the credentials of an S3-compatible object store.
+type Credentials struct {
+	AccessKeyID     []byte
+	SecretAccessKey []byte
+	Endpoint        []byte
+	Region          []byte
+}
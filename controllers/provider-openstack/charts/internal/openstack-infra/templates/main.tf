@@ -24,15 +24,18 @@ resource "openstack_networking_router_v2" "router" {
 }
 {{- end}}
 
+{{ if .Values.create.network -}}
 resource "openstack_networking_network_v2" "cluster" {
   name           = "{{ required "clusterName is required" .Values.clusterName }}"
   admin_state_up = "true"
 }
+{{- end}}
 
+{{ if .Values.create.subnet -}}
 resource "openstack_networking_subnet_v2" "cluster" {
   name            = "{{ required "clusterName is required" .Values.clusterName }}"
   cidr            = "{{ required "networks.worker is required" .Values.networks.worker }}"
-  network_id      = "${openstack_networking_network_v2.cluster.id}"
+  network_id      = "{{ required "network.id is required" .Values.network.id }}"
   ip_version      = 4
   {{- if .Values.dnsServers }}
   dns_nameservers = [{{- include "openstack-infra.dnsServers" . | trimSuffix ", " }}]
@@ -45,6 +48,7 @@ resource "openstack_networking_router_interface_v2" "router_nodes" {
   router_id = "{{ required "router.id is required" $.Values.router.id }}"
   subnet_id = "${openstack_networking_subnet_v2.cluster.id}"
 }
+{{- end}}
 
 resource "openstack_networking_secgroup_v2" "cluster" {
   name                 = "{{ required "clusterName is required" .Values.clusterName }}"
@@ -103,7 +107,7 @@ output "{{ .Values.outputKeys.routerID }}" {
 }
 
 output "{{ .Values.outputKeys.networkID }}" {
-  value = "${openstack_networking_network_v2.cluster.id}"
+  value = "{{ required "network.id is required" .Values.network.id }}"
 }
 
 output "{{ .Values.outputKeys.keyName }}" {
@@ -123,5 +127,5 @@ output "{{ .Values.outputKeys.floatingNetworkID }}" {
 }
 
 output "{{ .Values.outputKeys.subnetID }}" {
-  value = "${openstack_networking_subnet_v2.cluster.id}"
+  value = "{{ required "subnet.id is required" .Values.subnet.id }}"
 }
\ No newline at end of file
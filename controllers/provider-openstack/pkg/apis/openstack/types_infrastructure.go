@@ -31,6 +31,10 @@ type InfrastructureConfig struct {
 
 // Networks holds information about the Kubernetes and infrastructure networks.
 type Networks struct {
+	// ID is the network id of an existing private OpenStack network.
+	ID *string
+	// SubnetID is the subnet id of an existing subnet in the network given by ID. Its CIDR must equal the worker CIDR.
+	SubnetID *string
 	// Router indicates whether to use an existing router or create a new one.
 	Router *Router
 	// Worker is a CIDRs of a worker subnet (private) to create (used for the VMs).
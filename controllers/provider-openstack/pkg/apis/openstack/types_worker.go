@@ -20,6 +20,19 @@ import (
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// Tags are additional tags that are added to the machines.
+	Tags map[string]string
+	// RollingUpdateTimeout is the maximum duration of a rolling update of the machines. It is excluded from the
+	// machine class hash as it does not affect the machines.
+	RollingUpdateTimeout *metav1.Duration `json:"-"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // WorkerStatus contains information about created worker resources.
 type WorkerStatus struct {
 	metav1.TypeMeta
@@ -31,6 +31,12 @@ type InfrastructureConfig struct {
 
 // Networks holds information about the Kubernetes and infrastructure networks.
 type Networks struct {
+	// ID is the network id of an existing private OpenStack network.
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// SubnetID is the subnet id of an existing subnet in the network given by ID. Its CIDR must equal the worker CIDR.
+	// +optional
+	SubnetID *string `json:"subnetID,omitempty"`
 	// Router indicates whether to use an existing router or create a new one.
 	// +optional
 	Router *Router `json:"router,omitempty"`
@@ -0,0 +1,59 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateControlPlaneConfig validates a ControlPlaneConfig object.
+func ValidateControlPlaneConfig(controlPlaneConfig *apisopenstack.ControlPlaneConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(controlPlaneConfig.LoadBalancerProvider) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("loadBalancerProvider"), "must provide a load balancer provider"))
+	}
+
+	if len(controlPlaneConfig.Zone) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("zone"), "must provide the name of a zone"))
+	}
+
+	classes := map[string]bool{}
+	for i, class := range controlPlaneConfig.LoadBalancerClasses {
+		namePath := fldPath.Child("loadBalancerClasses").Index(i).Child("name")
+		if len(class.Name) == 0 {
+			allErrs = append(allErrs, field.Required(namePath, "must provide a name"))
+			continue
+		}
+		if classes[class.Name] {
+			allErrs = append(allErrs, field.Duplicate(namePath, class.Name))
+		}
+		classes[class.Name] = true
+	}
+
+	if ccm := controlPlaneConfig.CloudControllerManager; ccm != nil {
+		allErrs = append(allErrs, extensionsvalidation.ValidateFeatureGates(ccm.FeatureGates, fldPath.Child("cloudControllerManager", "featureGates"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateControlPlaneConfigUpdate validates a ControlPlaneConfig object before an update. The zone is immutable.
+func ValidateControlPlaneConfigUpdate(oldConfig, newConfig *apisopenstack.ControlPlaneConfig, fldPath *field.Path) field.ErrorList {
+	return extensionsvalidation.ValidateImmutableField(newConfig.Zone, oldConfig.Zone, fldPath.Child("zone"))
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object.
+func ValidateInfrastructureConfig(infra *apisopenstack.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(infra.FloatingPoolName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("floatingPoolName"), "must provide the name of a floating pool"))
+	}
+
+	networksPath := fldPath.Child("networks")
+	if infra.Networks.Router != nil && len(infra.Networks.Router.ID) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath.Child("router", "id"), "must provide a router id"))
+	}
+	if infra.Networks.ID != nil && len(*infra.Networks.ID) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath.Child("id"), "must provide a network id"))
+	}
+	if subnetID := infra.Networks.SubnetID; subnetID != nil {
+		subnetIDPath := networksPath.Child("subnetID")
+		if len(*subnetID) == 0 {
+			allErrs = append(allErrs, field.Required(subnetIDPath, "must provide a subnet id"))
+		}
+		if infra.Networks.ID == nil {
+			allErrs = append(allErrs, field.Invalid(subnetIDPath, *subnetID, "an existing subnet can only be used together with the id of its existing network"))
+		}
+		if infra.Networks.Router == nil {
+			allErrs = append(allErrs, field.Invalid(subnetIDPath, *subnetID, "an existing subnet can only be used together with the id of an existing router it is attached to"))
+		}
+	}
+	allErrs = append(allErrs, extensionsvalidation.ValidateCIDR(infra.Networks.Worker, networksPath.Child("worker"))...)
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update. The floating pool
+// and the network configuration are immutable.
+func ValidateInfrastructureConfigUpdate(oldInfra, newInfra *apisopenstack.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, extensionsvalidation.ValidateImmutableField(newInfra.FloatingPoolName, oldInfra.FloatingPoolName, fldPath.Child("floatingPoolName"))...)
+	allErrs = append(allErrs, extensionsvalidation.ValidateImmutableField(newInfra.Networks, oldInfra.Networks, fldPath.Child("networks"))...)
+
+	return allErrs
+}
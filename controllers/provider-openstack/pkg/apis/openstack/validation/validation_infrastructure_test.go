@@ -0,0 +1,95 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	. "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisopenstack.InfrastructureConfig
+		fldPath              = field.NewPath("spec", "providerConfig")
+
+		networkID = "existing-network"
+		subnetID  = "existing-subnet"
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisopenstack.InfrastructureConfig{
+			FloatingPoolName: "fip",
+			Networks: apisopenstack.Networks{
+				ID:       &networkID,
+				SubnetID: &subnetID,
+				Router: &apisopenstack.Router{
+					ID: "existing-router",
+				},
+				Worker: "10.250.0.0/19",
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an empty network id", func() {
+			emptyID := ""
+			infrastructureConfig.Networks.ID = &emptyID
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.networks.id"),
+			}))))
+		})
+
+		It("should forbid an existing subnet without an existing network", func() {
+			infrastructureConfig.Networks.ID = nil
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.subnetID"),
+			}))))
+		})
+
+		It("should forbid an existing subnet without an existing router", func() {
+			infrastructureConfig.Networks.Router = nil
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.subnetID"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should forbid changing the networks", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.SubnetID = nil
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks"),
+			}))))
+		})
+	})
+})
@@ -23,9 +23,10 @@ import (
 	infrainternal "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/infrastructure"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionschartrenderer "github.com/gardener/gardener-extensions/pkg/gardener/chartrenderer"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 
 	"github.com/go-logr/logr"
 
@@ -46,13 +47,23 @@ type actuator struct {
 	scheme  *runtime.Scheme
 	decoder runtime.Decoder
 
-	chartRenderer chartrenderer.Interface
+	chartRenderer        chartrenderer.Interface
+	terraformerFactory   extensionsterraformer.Factory
+	chartRendererFactory extensionschartrenderer.Factory
 }
 
-// NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources.
-func NewActuator() infrastructure.Actuator {
+// NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources and uses the
+// Terraformers of the given factory.
+func NewActuator(terraformerFactory extensionsterraformer.Factory) infrastructure.Actuator {
+	return NewActuatorWithDeps(log.Log.WithName("infrastructure-actuator"), terraformerFactory, extensionschartrenderer.DefaultFactory())
+}
+
+// NewActuatorWithDeps creates a new Actuator with the given dependencies.
+func NewActuatorWithDeps(logger logr.Logger, terraformerFactory extensionsterraformer.Factory, chartRendererFactory extensionschartrenderer.Factory) infrastructure.Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		logger:               logger,
+		terraformerFactory:   terraformerFactory,
+		chartRendererFactory: chartRendererFactory,
 	}
 }
 
@@ -70,7 +81,7 @@ func (a *actuator) InjectClient(client client.Client) error {
 func (a *actuator) InjectConfig(config *rest.Config) error {
 	a.restConfig = config
 
-	chartRenderer, err := chartrenderer.NewForConfig(config)
+	chartRenderer, err := a.chartRendererFactory.NewForConfig(config)
 	if err != nil {
 		return err
 	}
@@ -88,11 +99,19 @@ func (a *actuator) Delete(ctx context.Context, config *extensionsv1alpha1.Infras
 	return a.delete(ctx, config, cluster)
 }
 
+func (a *actuator) Migrate(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.migrate(ctx, config, cluster)
+}
+
+func (a *actuator) Restore(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.reconcile(ctx, config, cluster)
+}
+
 // Helper functions
 
 func (a *actuator) updateProviderStatus(
 	ctx context.Context,
-	tf *terraformer.Terraformer,
+	tf extensionsterraformer.Interface,
 	infra *extensionsv1alpha1.Infrastructure,
 	config *openstackv1alpha1.InfrastructureConfig,
 ) error {
@@ -20,11 +20,13 @@ import (
 
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/infrastructure"
+	openstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack/client"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	infrastructurecontroller "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 )
 
 func (a *actuator) reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
@@ -38,23 +40,48 @@ func (a *actuator) reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 		return err
 	}
 
+	networkingClient, err := openstackclient.NewNetworkingClientFromCredentials(creds, infra.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	if err := infrastructure.CheckExistingResources(ctx, networkingClient, config); err != nil {
+		return err
+	}
+
 	terraformFiles, err := infrastructure.RenderTerraformerChart(a.chartRenderer, infra, creds, config, cluster)
 	if err != nil {
 		return err
 	}
 
-	tf, err := internal.NewTerraformer(a.restConfig, creds, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
+	tf, err := internal.NewTerraformer(a.terraformerFactory, a.restConfig, creds, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
 	if err != nil {
 		return err
 	}
 
-	if err := tf.
-		InitializeWith(terraformer.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars)).
-		Apply(); err != nil {
+	state, err := infrastructurecontroller.GetTerraformState(infra)
+	if err != nil {
+		return err
+	}
+
+	applyErr := tf.
+		InitializeWith(extensionsterraformer.WithState(
+			a.client,
+			a.terraformerFactory.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars),
+			state),
+		).
+		Apply()
+
+	// The Terraform state is persisted even if the apply failed as it might already reference created resources.
+	if err := infrastructurecontroller.SaveTerraformState(ctx, a.client, tf, infra); err != nil {
+		return err
+	}
 
-		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infra.Name)
+	infrastructurecontroller.ReportTerraformApplyResult(ctx, a.logger, infra, applyErr)
+	if applyErr != nil {
+		a.logger.Error(applyErr, "failed to apply the terraform config", "infrastructure", infra.Name)
 		return &controllererrors.RequeueAfterError{
-			Cause:        err,
+			Cause:        applyErr,
 			RequeueAfter: 30 * time.Second,
 		}
 	}
@@ -0,0 +1,179 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/controller/infrastructure"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/imagevector"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/infrastructure"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	infrastructurecontroller "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	mockchartrenderer "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/gardener/chartrenderer"
+	mockterraformer "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/gardener/terraformer"
+	mockgardenerchartrenderer "github.com/gardener/gardener-extensions/pkg/mock/gardener/chartrenderer"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/helm/pkg/manifest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctrl *gomock.Controller
+		ctx  = context.TODO()
+
+		terraformerFactory   *mockterraformer.MockFactory
+		terraformer          *mockterraformer.MockInterface
+		initializer          *mockterraformer.MockInitializer
+		chartRendererFactory *mockchartrenderer.MockFactory
+		chartRenderer        *mockgardenerchartrenderer.MockInterface
+		c                    client.Client
+		actuator             infrastructurecontroller.Actuator
+		infra                *extensionsv1alpha1.Infrastructure
+		cluster              *controller.Cluster
+
+		userName = "user"
+		password = "password"
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		terraformerFactory = mockterraformer.NewMockFactory(ctrl)
+		terraformer = mockterraformer.NewMockInterface(ctrl)
+		initializer = mockterraformer.NewMockInitializer(ctrl)
+		chartRendererFactory = mockchartrenderer.NewMockFactory(ctrl)
+		chartRenderer = mockgardenerchartrenderer.NewMockInterface(ctrl)
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "infra"},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				SecretRef: corev1.SecretReference{Namespace: "shoot--foo--bar", Name: "cloudprovider"},
+				ProviderConfig: &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1", "kind": "InfrastructureConfig", "floatingPoolName": "fip", "networks": {"worker": "10.250.0.0/19"}}`),
+				},
+			},
+		}
+		cluster = &controller.Cluster{CloudProfile: &gardenv1beta1.CloudProfile{Spec: gardenv1beta1.CloudProfileSpec{OpenStack: &gardenv1beta1.OpenStackProfile{}}}}
+
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(controller.AddToScheme(scheme)).To(Succeed())
+		c = fake.NewFakeClientWithScheme(scheme,
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "cloudprovider"},
+				Data: map[string][]byte{
+					openstack.DomainName: []byte("domain"),
+					openstack.TenantName: []byte("tenant"),
+					openstack.UserName:   []byte(userName),
+					openstack.Password:   []byte(password),
+				},
+			},
+			infra.DeepCopy(),
+		)
+
+		actuator = NewActuatorWithDeps(log.Log.WithName("test"), terraformerFactory, chartRendererFactory)
+		ok, err := inject.ClientInto(c, actuator)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+
+		chartRendererFactory.EXPECT().NewForConfig(gomock.Any()).Return(chartRenderer, nil)
+		ok, err = inject.ConfigInto(&rest.Config{}, actuator)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	variablesEnvironment := map[string]string{
+		internal.TerraformVarNameUserName: userName,
+		internal.TerraformVarNamePassword: password,
+	}
+
+	expectNewTerraformer := func() {
+		gomock.InOrder(
+			terraformerFactory.EXPECT().NewForConfig(gomock.Any(), gomock.Any(), infrastructure.TerraformerPurpose, infra.Namespace, infra.Name, imagevector.TerraformerImage()).
+				Return(terraformer, nil),
+			terraformer.EXPECT().SetVariablesEnvironment(variablesEnvironment).Return(terraformer),
+			terraformer.EXPECT().SetJobBackoffLimit(int32(0)).Return(terraformer),
+			terraformer.EXPECT().SetActiveDeadlineSeconds(int64(630)).Return(terraformer),
+			terraformer.EXPECT().SetDeadlineCleaning(5*time.Minute).Return(terraformer),
+			terraformer.EXPECT().SetDeadlinePod(15*time.Minute).Return(terraformer),
+			terraformer.EXPECT().SetDeadlineJob(15*time.Minute).Return(terraformer),
+		)
+	}
+
+	expectRenderTerraformerChart := func() {
+		gomock.InOrder(
+			chartRenderer.EXPECT().Render(gomock.Any(), "openstack-infra", infra.Namespace, gomock.Any()).Return(&chartrenderer.RenderedChart{
+				ChartName: "openstack-infra",
+				Manifests: []manifest.Manifest{
+					{Name: "openstack-infra/templates/main.tf", Content: "main"},
+					{Name: "openstack-infra/templates/variables.tf", Content: "variables"},
+					{Name: "openstack-infra/templates/terraform.tfvars", Content: "tfvars"},
+				},
+			}, nil),
+			terraformerFactory.EXPECT().DefaultInitializer(c, "main", "variables", []byte("tfvars")).Return(initializer),
+		)
+	}
+
+	Describe("#Delete", func() {
+		It("should destroy the infrastructure with a terraformer of the factory", func() {
+			expectRenderTerraformerChart()
+			expectNewTerraformer()
+			gomock.InOrder(
+				terraformer.EXPECT().InitializeWith(gomock.Any()).Return(terraformer),
+				terraformer.EXPECT().SetVariablesEnvironment(variablesEnvironment).Return(terraformer),
+				terraformer.EXPECT().Destroy(),
+			)
+
+			Expect(actuator.Delete(ctx, infra, cluster)).To(Succeed())
+		})
+	})
+
+	Describe("#Migrate", func() {
+		It("should persist the terraform state and clean up the terraform configuration", func() {
+			state := []byte("state")
+
+			expectNewTerraformer()
+			gomock.InOrder(
+				terraformer.EXPECT().GetState().Return(state, nil),
+				terraformer.EXPECT().CleanupConfiguration(ctx),
+			)
+
+			Expect(actuator.Migrate(ctx, infra, cluster)).To(Succeed())
+			Expect(controller.GetState(infra, controller.StateKeyTerraform)).To(Equal(state))
+		})
+	})
+})
@@ -118,6 +118,12 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			Image:   machineImage,
 		})
 
+		workerConfig := &openstackapi.WorkerConfig{}
+		providerConfig, err := worker.DecodeProviderConfig(w.decoder, pool, workerConfig)
+		if err != nil {
+			return err
+		}
+
 		for zoneIndex, zone := range pool.Zones {
 			machineClassSpec := map[string]interface{}{
 				"region":           w.worker.Spec.Region,
@@ -128,32 +134,37 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				"networkID":        infrastructureStatus.Networks.ID,
 				"podNetworkCidr":   extensionscontroller.GetPodNetwork(w.cluster.Shoot),
 				"securityGroups":   []string{nodesSecurityGroup.Name},
-				"tags": map[string]string{
+				"tags": worker.MergeTags(workerConfig.Tags, map[string]string{
 					fmt.Sprintf("kubernetes.io-cluster-%s", w.worker.Namespace): "1",
 					"kubernetes.io-role-node":                                   "1",
-				},
+				}),
 				"secret": map[string]interface{}{
 					"cloudConfig": string(pool.UserData),
 				},
 			}
 
+			machineClassSpecHash, err := worker.MachineClassHashWithProviderConfig(machineClassSpec, shootVersionMajorMinor, providerConfig)
+			if err != nil {
+				return err
+			}
+
 			var (
-				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-				deploymentName       = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
-				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+				deploymentName = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
+				className      = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
-				Name:           deploymentName,
-				ClassName:      className,
-				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
-				Labels:         pool.Labels,
-				Annotations:    pool.Annotations,
-				Taints:         pool.Taints,
+				Name:                 deploymentName,
+				ClassName:            className,
+				SecretName:           className,
+				Minimum:              worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
+				Maximum:              worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
+				MaxSurge:             worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
+				MaxUnavailable:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
+				Labels:               pool.Labels,
+				Annotations:          pool.Annotations,
+				Taints:               pool.Taints,
+				RollingUpdateTimeout: worker.RollingUpdateTimeout(workerConfig.RollingUpdateTimeout),
 			})
 
 			machineClassSpec["name"] = className
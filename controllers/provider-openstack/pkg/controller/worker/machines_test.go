@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
 	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
@@ -410,6 +411,64 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should render the worker config of the pools into the machine classes", func() {
+				expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithTags(map[string]string{
+					"team":                    "foo",
+					"kubernetes.io-role-node": "0",
+				})
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToCloudProfilesMapping, chartApplier, "", w, cluster)
+
+				var machineClasses []map[string]interface{}
+				chartApplier.
+					EXPECT().
+					ApplyChart(context.TODO(), filepath.Join(openstack.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _, _, _ string, values, _ map[string]interface{}) error {
+						machineClasses = values["machineClasses"].([]map[string]interface{})
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+				Expect(machineClasses).To(HaveLen(4))
+				Expect(machineClasses[0]["tags"]).To(Equal(map[string]string{
+					"team": "foo",
+					fmt.Sprintf("kubernetes.io-cluster-%s", namespace): "1",
+					"kubernetes.io-role-node":                          "1",
+				}))
+			})
+
+			It("should roll the machines if only the tags of a pool change", func() {
+				classHash := func() string {
+					expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+					workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToCloudProfilesMapping, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					return strings.TrimPrefix(result[0].ClassName, fmt.Sprintf("%s-%s-z1-", namespace, namePool1))
+				}
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithTags(map[string]string{"team": "foo"})
+				hashFoo := classHash()
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithTags(map[string]string{"team": "bar"})
+				Expect(classHash()).NotTo(Equal(hashFoo))
+
+				w.Spec.Pools[0].ProviderConfig = workerConfigWithTags(map[string]string{"team": "foo"})
+				Expect(classHash()).To(Equal(hashFoo))
+			})
+
+			It("should fail because the worker config cannot be decoded", func() {
+				expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte("invalid")}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToCloudProfilesMapping, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -477,6 +536,18 @@ func encode(obj runtime.Object) []byte {
 	return data
 }
 
+func workerConfigWithTags(tags map[string]string) *runtime.RawExtension {
+	return &runtime.RawExtension{
+		Raw: encode(&openstackv1alpha1.WorkerConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: openstackv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "WorkerConfig",
+			},
+			Tags: tags,
+		}),
+	}
+}
+
 func expectGetSecretCallToWork(c *mockclient.MockClient, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword string) {
 	c.EXPECT().
 		Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -16,9 +16,14 @@ package infrastructure
 
 import (
 	"context"
+	"fmt"
 
+	openstackv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+	openstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack/client"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -27,3 +32,51 @@ import (
 func GetCredentialsFromInfrastructure(ctx context.Context, c client.Client, config *extensionsv1alpha1.Infrastructure) (*internal.Credentials, error) {
 	return internal.GetCredentials(ctx, c, config.Spec.SecretRef)
 }
+
+// CheckExistingResources verifies that the network, subnet and router the given InfrastructureConfig refers to
+// exist and that the subnet belongs to the network and matches the worker CIDR. If not, an error with the gardencorev1alpha1.ErrorInfraDependencies
+// code is returned.
+func CheckExistingResources(ctx context.Context, c openstackclient.Networking, config *openstackv1alpha1.InfrastructureConfig) error {
+	networks := config.Networks
+
+	if networks.Router != nil {
+		exists, err := c.RouterExists(ctx, networks.Router.ID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraDependencies, fmt.Sprintf("router %s does not exist", networks.Router.ID))
+		}
+	}
+
+	if networks.ID == nil {
+		return nil
+	}
+
+	exists, err := c.NetworkExists(ctx, *networks.ID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraDependencies, fmt.Sprintf("network %s does not exist", *networks.ID))
+	}
+
+	if networks.SubnetID == nil {
+		return nil
+	}
+
+	subnet, err := c.GetSubnet(ctx, *networks.SubnetID)
+	if err != nil {
+		return err
+	}
+	if subnet == nil {
+		return gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraDependencies, fmt.Sprintf("subnet %s does not exist", *networks.SubnetID))
+	}
+	if subnet.NetworkID != *networks.ID {
+		return gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraDependencies, fmt.Sprintf("subnet %s does not belong to network %s", *networks.SubnetID, *networks.ID))
+	}
+	if subnet.CIDR != networks.Worker {
+		return gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraDependencies, fmt.Sprintf("cidr %s of subnet %s does not match the worker cidr %s", subnet.CIDR, *networks.SubnetID, networks.Worker))
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+
+	openstackv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/v1alpha1"
+	openstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack/client"
+	mockopenstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack/mock/client"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Infrastructure", func() {
+	var (
+		ctrl *gomock.Controller
+	)
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+	})
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#CheckExistingResources", func() {
+		var (
+			ctx       = context.TODO()
+			networkID = "network-id"
+			subnetID  = "subnet-id"
+			routerID  = "router-id"
+			cidr      = "10.250.0.0/19"
+
+			config *openstackv1alpha1.InfrastructureConfig
+			client *mockopenstackclient.MockNetworking
+		)
+
+		BeforeEach(func() {
+			config = &openstackv1alpha1.InfrastructureConfig{
+				FloatingPoolName: "fip",
+				Networks: openstackv1alpha1.Networks{
+					Worker: cidr,
+				},
+			}
+			client = mockopenstackclient.NewMockNetworking(ctrl)
+		})
+
+		It("should not look up anything if no existing resources are referenced", func() {
+			Expect(CheckExistingResources(ctx, client, config)).To(Succeed())
+		})
+
+		It("should succeed if the router, the network and the subnet exist", func() {
+			config.Networks.Router = &openstackv1alpha1.Router{ID: routerID}
+			config.Networks.ID = &networkID
+			config.Networks.SubnetID = &subnetID
+
+			gomock.InOrder(
+				client.EXPECT().RouterExists(ctx, routerID).Return(true, nil),
+				client.EXPECT().NetworkExists(ctx, networkID).Return(true, nil),
+				client.EXPECT().GetSubnet(ctx, subnetID).Return(&openstackclient.Subnet{ID: subnetID, NetworkID: networkID, CIDR: cidr}, nil),
+			)
+
+			Expect(CheckExistingResources(ctx, client, config)).To(Succeed())
+		})
+
+		It("should fail with a dependency error if the router does not exist", func() {
+			config.Networks.Router = &openstackv1alpha1.Router{ID: routerID}
+
+			client.EXPECT().RouterExists(ctx, routerID).Return(false, nil)
+
+			err := CheckExistingResources(ctx, client, config)
+			Expect(err).To(HaveOccurred())
+			Expect(gardencorev1alpha1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1alpha1.ErrorInfraDependencies))
+		})
+
+		It("should fail with a dependency error if the network does not exist", func() {
+			config.Networks.ID = &networkID
+
+			client.EXPECT().NetworkExists(ctx, networkID).Return(false, nil)
+
+			err := CheckExistingResources(ctx, client, config)
+			Expect(err).To(HaveOccurred())
+			Expect(gardencorev1alpha1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1alpha1.ErrorInfraDependencies))
+		})
+
+		It("should fail with a dependency error if the subnet does not exist", func() {
+			config.Networks.ID = &networkID
+			config.Networks.SubnetID = &subnetID
+
+			gomock.InOrder(
+				client.EXPECT().NetworkExists(ctx, networkID).Return(true, nil),
+				client.EXPECT().GetSubnet(ctx, subnetID).Return(nil, nil),
+			)
+
+			err := CheckExistingResources(ctx, client, config)
+			Expect(err).To(HaveOccurred())
+			Expect(gardencorev1alpha1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1alpha1.ErrorInfraDependencies))
+		})
+
+		It("should fail with a dependency error if the subnet belongs to another network", func() {
+			config.Networks.ID = &networkID
+			config.Networks.SubnetID = &subnetID
+
+			gomock.InOrder(
+				client.EXPECT().NetworkExists(ctx, networkID).Return(true, nil),
+				client.EXPECT().GetSubnet(ctx, subnetID).Return(&openstackclient.Subnet{ID: subnetID, NetworkID: "other", CIDR: cidr}, nil),
+			)
+
+			err := CheckExistingResources(ctx, client, config)
+			Expect(err).To(HaveOccurred())
+			Expect(gardencorev1alpha1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1alpha1.ErrorInfraDependencies))
+		})
+
+		It("should fail with a dependency error if the cidr of the subnet does not match the worker cidr", func() {
+			config.Networks.ID = &networkID
+			config.Networks.SubnetID = &subnetID
+
+			gomock.InOrder(
+				client.EXPECT().NetworkExists(ctx, networkID).Return(true, nil),
+				client.EXPECT().GetSubnet(ctx, subnetID).Return(&openstackclient.Subnet{ID: subnetID, NetworkID: networkID, CIDR: "10.251.0.0/19"}, nil),
+			)
+
+			err := CheckExistingResources(ctx, client, config)
+			Expect(err).To(HaveOccurred())
+			Expect(gardencorev1alpha1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1alpha1.ErrorInfraDependencies))
+		})
+	})
+})
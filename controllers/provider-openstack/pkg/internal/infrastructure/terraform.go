@@ -15,15 +15,16 @@
 package infrastructure
 
 import (
+	"fmt"
 	"path/filepath"
 
 	openstackv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -47,6 +48,10 @@ const (
 	TerraformOutputKeySubnetID = "subnet_id"
 	// DefaultRouterID is the computed router ID as generated by terraform.
 	DefaultRouterID = "${openstack_networking_router_v2.router.id}"
+	// DefaultNetworkID is the computed network ID as generated by terraform.
+	DefaultNetworkID = "${openstack_networking_network_v2.cluster.id}"
+	// DefaultSubnetID is the computed subnet ID as generated by terraform.
+	DefaultSubnetID = "${openstack_networking_subnet_v2.cluster.id}"
 )
 
 var (
@@ -69,13 +74,25 @@ func ComputeTerraformerChartValues(
 	cluster *controller.Cluster,
 ) map[string]interface{} {
 	var (
-		routerID     = DefaultRouterID
-		createRouter = true
+		routerID      = DefaultRouterID
+		createRouter  = true
+		networkID     = DefaultNetworkID
+		createNetwork = true
+		subnetID      = DefaultSubnetID
+		createSubnet  = true
 	)
 	if router := config.Networks.Router; router != nil {
 		createRouter = false
 		routerID = router.ID
 	}
+	if config.Networks.ID != nil {
+		createNetwork = false
+		networkID = *config.Networks.ID
+	}
+	if config.Networks.SubnetID != nil {
+		createSubnet = false
+		subnetID = *config.Networks.SubnetID
+	}
 	return map[string]interface{}{
 		"openstack": map[string]interface{}{
 			"authURL":          cluster.CloudProfile.Spec.OpenStack.KeyStoneURL,
@@ -85,13 +102,21 @@ func ComputeTerraformerChartValues(
 			"floatingPoolName": config.FloatingPoolName,
 		},
 		"create": map[string]interface{}{
-			"router": createRouter,
+			"router":  createRouter,
+			"network": createNetwork,
+			"subnet":  createSubnet,
 		},
 		"dnsServers":   cluster.CloudProfile.Spec.OpenStack.DNSServers,
 		"sshPublicKey": string(infra.Spec.SSHPublicKey),
 		"router": map[string]interface{}{
 			"id": routerID,
 		},
+		"network": map[string]interface{}{
+			"id": networkID,
+		},
+		"subnet": map[string]interface{}{
+			"id": subnetID,
+		},
 		"clusterName": infra.Namespace,
 		"networks": map[string]interface{}{
 			"worker": config.Networks.Worker,
@@ -155,33 +180,53 @@ type TerraformState struct {
 	SecurityGroupName string
 }
 
-// ExtractTerraformState extracts the TerraformState from the given Terraformer.
-func ExtractTerraformState(tf *terraformer.Terraformer, config *openstackv1alpha1.InfrastructureConfig) (*TerraformState, error) {
-	outputKeys := []string{
-		TerraformOutputKeySSHKeyName,
-		TerraformOutputKeyRouterID,
-		TerraformOutputKeyNetworkID,
-		TerraformOutputKeySubnetID,
-		TerraformOutputKeyFloatingNetworkID,
-		TerraformOutputKeySecurityGroupID,
-		TerraformOutputKeySecurityGroupName,
-	}
+// ExtractTerraformState extracts the TerraformState from the given typed Terraform state. The router, network, subnet
+// and floating pool network are read from the output variables as they are not managed by Terraform if they are
+// configured in the InfrastructureConfig.
+func ExtractTerraformState(state *extensionsterraformer.State, config *openstackv1alpha1.InfrastructureConfig) (*TerraformState, error) {
+	var (
+		out     = &TerraformState{}
+		outputs = []struct {
+			name  string
+			value *string
+		}{
+			{TerraformOutputKeyRouterID, &out.RouterID},
+			{TerraformOutputKeyNetworkID, &out.NetworkID},
+			{TerraformOutputKeySubnetID, &out.SubnetID},
+			{TerraformOutputKeyFloatingNetworkID, &out.FloatingNetworkID},
+		}
+		fields = []struct {
+			resourceType, resourceName, attribute string
+			value                                 *string
+		}{
+			{"openstack_compute_keypair_v2", "ssh_key", "name", &out.SSHKeyName},
+			{"openstack_networking_secgroup_v2", "cluster", "id", &out.SecurityGroupID},
+			{"openstack_networking_secgroup_v2", "cluster", "name", &out.SecurityGroupName},
+		}
+	)
 
-	vars, err := tf.GetStateOutputVariables(outputKeys...)
-	if err != nil {
-		return nil, err
+	for _, output := range outputs {
+		value, err := state.OutputString(output.name)
+		if err != nil {
+			return nil, err
+		}
+		*output.value = value
 	}
 
-	state := &TerraformState{
-		SSHKeyName:        vars[TerraformOutputKeySSHKeyName],
-		RouterID:          vars[TerraformOutputKeyRouterID],
-		NetworkID:         vars[TerraformOutputKeyNetworkID],
-		SubnetID:          vars[TerraformOutputKeySubnetID],
-		FloatingNetworkID: vars[TerraformOutputKeyFloatingNetworkID],
-		SecurityGroupID:   vars[TerraformOutputKeySecurityGroupID],
-		SecurityGroupName: vars[TerraformOutputKeySecurityGroupName],
+	for _, field := range fields {
+		resource := state.Resource(field.resourceType, field.resourceName)
+		if resource == nil {
+			return nil, fmt.Errorf("resource %s.%s not found in Terraform state", field.resourceType, field.resourceName)
+		}
+
+		value, err := resource.StringAttribute(field.attribute)
+		if err != nil {
+			return nil, err
+		}
+		*field.value = value
 	}
-	return state, nil
+
+	return out, nil
 }
 
 // StatusFromTerraformState computes an InfrastructureStatus from the given
@@ -225,8 +270,13 @@ func StatusFromTerraformState(state *TerraformState) *openstackv1alpha1.Infrastr
 }
 
 // ComputeStatus computes the status based on the Terraformer and the given InfrastructureConfig.
-func ComputeStatus(tf *terraformer.Terraformer, config *openstackv1alpha1.InfrastructureConfig) (*openstackv1alpha1.InfrastructureStatus, error) {
-	state, err := ExtractTerraformState(tf, config)
+func ComputeStatus(tf extensionsterraformer.StateGetter, config *openstackv1alpha1.InfrastructureConfig) (*openstackv1alpha1.InfrastructureStatus, error) {
+	tfState, err := extensionsterraformer.ReadState(tf)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := ExtractTerraformState(tfState, config)
 	if err != nil {
 		return nil, err
 	}
@@ -18,6 +18,7 @@ import (
 	openstackv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -111,13 +112,21 @@ var _ = Describe("Terraform", func() {
 					"floatingPoolName": config.FloatingPoolName,
 				},
 				"create": map[string]interface{}{
-					"router": false,
+					"router":  false,
+					"network": true,
+					"subnet":  true,
 				},
 				"dnsServers":   cluster.CloudProfile.Spec.OpenStack.DNSServers,
 				"sshPublicKey": string(infra.Spec.SSHPublicKey),
 				"router": map[string]interface{}{
 					"id": "1",
 				},
+				"network": map[string]interface{}{
+					"id": DefaultNetworkID,
+				},
+				"subnet": map[string]interface{}{
+					"id": DefaultSubnetID,
+				},
 				"clusterName": infra.Namespace,
 				"networks": map[string]interface{}{
 					"worker": config.Networks.Worker,
@@ -147,13 +156,21 @@ var _ = Describe("Terraform", func() {
 					"floatingPoolName": config.FloatingPoolName,
 				},
 				"create": map[string]interface{}{
-					"router": true,
+					"router":  true,
+					"network": true,
+					"subnet":  true,
 				},
 				"dnsServers":   cluster.CloudProfile.Spec.OpenStack.DNSServers,
 				"sshPublicKey": string(infra.Spec.SSHPublicKey),
 				"router": map[string]interface{}{
 					"id": DefaultRouterID,
 				},
+				"network": map[string]interface{}{
+					"id": DefaultNetworkID,
+				},
+				"subnet": map[string]interface{}{
+					"id": DefaultSubnetID,
+				},
 				"clusterName": infra.Namespace,
 				"networks": map[string]interface{}{
 					"worker": config.Networks.Worker,
@@ -169,6 +186,58 @@ var _ = Describe("Terraform", func() {
 				},
 			}))
 		})
+
+		It("should not create the network and the subnet if they already exist", func() {
+			var (
+				networkID = "network-id"
+				subnetID  = "subnet-id"
+			)
+			config.Networks.ID = &networkID
+			config.Networks.SubnetID = &subnetID
+
+			values := ComputeTerraformerChartValues(infra, credentials, config, cluster)
+
+			Expect(values["create"]).To(Equal(map[string]interface{}{
+				"router":  false,
+				"network": false,
+				"subnet":  false,
+			}))
+			Expect(values["network"]).To(Equal(map[string]interface{}{
+				"id": networkID,
+			}))
+			Expect(values["subnet"]).To(Equal(map[string]interface{}{
+				"id": subnetID,
+			}))
+		})
+	})
+
+	Describe("#ExtractTerraformState", func() {
+		It("should read the ids and names from the output variables and resources of the state", func() {
+			state, err := extensionsterraformer.ParseState([]byte(`{
+  "version": 4,
+  "outputs": {
+    "router_id": {"value": "router"},
+    "network_id": {"value": "network"},
+    "subnet_id": {"value": "subnet"},
+    "floating_network_id": {"value": "fip"}
+  },
+  "resources": [
+    {"mode": "managed", "type": "openstack_compute_keypair_v2", "name": "ssh_key", "instances": [{"attributes": {"id": "key", "name": "key"}}]},
+    {"mode": "managed", "type": "openstack_networking_secgroup_v2", "name": "cluster", "instances": [{"attributes": {"id": "sg-id", "name": "sg-name"}}]}
+  ]
+}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ExtractTerraformState(state, config)).To(Equal(&TerraformState{
+				SSHKeyName:        "key",
+				RouterID:          "router",
+				NetworkID:         "network",
+				SubnetID:          "subnet",
+				FloatingNetworkID: "fip",
+				SecurityGroupID:   "sg-id",
+				SecurityGroupName: "sg-name",
+			}))
+		})
 	})
 
 	Describe("#StatusFromTerraformState", func() {
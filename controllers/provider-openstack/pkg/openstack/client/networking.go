@@ -0,0 +1,107 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewNetworkingClientFromSecretRef retrieves the openstack networking client specified by the secret reference.
+func NewNetworkingClientFromSecretRef(ctx context.Context, c client.Client, secretRef corev1.SecretReference, region string) (*NetworkingClient, error) {
+	credentials, err := internal.GetCredentials(ctx, c, secretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewNetworkingClientFromCredentials(credentials, region)
+}
+
+// NewNetworkingClientFromCredentials creates the networking client from credentials.
+func NewNetworkingClientFromCredentials(credentials *internal.Credentials, region string) (*NetworkingClient, error) {
+	provider, err := newAuthenticatedProviderClient(credentials, region)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := openstack.NewNetworkV2(provider, gophercloud.EndpointOpts{Region: region})
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkingClient{
+		client: client,
+	}, nil
+}
+
+// NetworkExists checks whether the network with the given <id> exists.
+func (n *NetworkingClient) NetworkExists(ctx context.Context, id string) (bool, error) {
+	var body struct {
+		Network struct {
+			ID string `json:"id"`
+		} `json:"network"`
+	}
+	return n.exists(ctx, n.client.ServiceURL("networks", id), &body)
+}
+
+// GetSubnet returns the subnet with the given <id>. If it does not exist, nil is returned.
+func (n *NetworkingClient) GetSubnet(ctx context.Context, id string) (*Subnet, error) {
+	var body struct {
+		Subnet Subnet `json:"subnet"`
+	}
+	exists, err := n.exists(ctx, n.client.ServiceURL("subnets", id), &body)
+	if err != nil || !exists {
+		return nil, err
+	}
+	return &body.Subnet, nil
+}
+
+// RouterExists checks whether the router with the given <id> exists.
+func (n *NetworkingClient) RouterExists(ctx context.Context, id string) (bool, error) {
+	var body struct {
+		Router struct {
+			ID string `json:"id"`
+		} `json:"router"`
+	}
+	return n.exists(ctx, n.client.ServiceURL("routers", id), &body)
+}
+
+// exists fetches the resource at <url> into <body>. A missing resource is not treated as an error.
+func (n *NetworkingClient) exists(ctx context.Context, url string, body interface{}) (bool, error) {
+	if _, err := n.withContext(ctx).Get(url, body, nil); err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// withContext returns a copy of the service client whose requests are bound to the given context. The provider
+// client is copied, too, because its context is shared by all requests otherwise.
+func (n *NetworkingClient) withContext(ctx context.Context) *gophercloud.ServiceClient {
+	provider := *n.client.ProviderClient
+	provider.Context = ctx
+
+	client := *n.client
+	client.ProviderClient = &provider
+	return &client
+}
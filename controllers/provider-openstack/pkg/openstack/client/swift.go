@@ -23,7 +23,6 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
 	"github.com/gophercloud/gophercloud/pagination"
-	"github.com/gophercloud/utils/openstack/clientconfig"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,31 +40,11 @@ func NewStorageClientFromSecretRef(ctx context.Context, c client.Client, secretR
 
 // newStorageClientFromCredentials create the storage client from credentials.
 func newStorageClientFromCredentials(credentials *internal.Credentials, region string) (*StorageClient, error) {
-	opts := &clientconfig.ClientOpts{
-		AuthInfo: &clientconfig.AuthInfo{
-			AuthURL:     credentials.AuthURL,
-			Username:    credentials.Username,
-			Password:    credentials.Password,
-			ProjectName: credentials.TenantName,
-			DomainName:  credentials.DomainName,
-		},
-		RegionName: region,
-	}
-	authOpts, err := clientconfig.AuthOptions(opts)
+	provider, err := newAuthenticatedProviderClient(credentials, region)
 	if err != nil {
 		return nil, err
 	}
 
-	// AllowReauth should be set to true if you grant permission for Gophercloud to
-	// cache your credentials in memory, and to allow Gophercloud to attempt to
-	// re-authenticate automatically if/when your token expires.
-	authOpts.AllowReauth = true
-
-	provider, err := openstack.AuthenticatedClient(*authOpts)
-	if err != nil {
-		return nil, err
-
-	}
 	client, err := openstack.NewObjectStorageV1(provider, gophercloud.EndpointOpts{})
 	if err != nil {
 		return nil, err
@@ -92,7 +71,7 @@ func (s *StorageClient) DeleteObjectsWithPrefix(ctx context.Context, container,
 	// Retrieve a pager (i.e. a paginated collection)
 	pager := objects.List(s.client, container, opts)
 
-	return pager.EachPage(func(page pagination.Page) (bool, error) {
+	if err := pager.EachPage(func(page pagination.Page) (bool, error) {
 		objectList, err := objects.ExtractNames(page)
 		if err != nil {
 			return false, err
@@ -103,7 +82,13 @@ func (s *StorageClient) DeleteObjectsWithPrefix(ctx context.Context, container,
 			}
 		}
 		return true, nil
-	})
+	}); err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil
+		}
+		return err
+	}
+	return nil
 }
 
 // deleteObjectIfExists deletes the openstack object with name <objectName> from <container>. If it does not exist,
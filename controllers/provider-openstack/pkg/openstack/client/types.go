@@ -31,3 +31,25 @@ type Storage interface {
 	CreateContainerIfNotExists(ctx context.Context, container string) error
 	DeleteContainerIfExists(ctx context.Context, container string) error
 }
+
+// NetworkingClient represents an Openstack networking (neutron) client.
+type NetworkingClient struct {
+	client *gophercloud.ServiceClient
+}
+
+// Subnet is an Openstack subnet.
+type Subnet struct {
+	// ID is the id of the subnet.
+	ID string `json:"id"`
+	// NetworkID is the id of the network the subnet belongs to.
+	NetworkID string `json:"network_id"`
+	// CIDR is the address range of the subnet.
+	CIDR string `json:"cidr"`
+}
+
+// Networking represents an Openstack networking client.
+type Networking interface {
+	NetworkExists(ctx context.Context, id string) (bool, error)
+	GetSubnet(ctx context.Context, id string) (*Subnet, error)
+	RouterExists(ctx context.Context, id string) (bool, error)
+}
@@ -28,6 +28,14 @@ const (
 	CloudControllerImageName = "openstack-cloud-controller-manager"
 	// ETCDBackupRestoreImageName is the name of the etcd backup and restore image.
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSINodeDriverRegistrarImageName is the name of the CSI driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSIPluginImageName is the name of the CSI plugin image.
+	CSIPluginImageName = "cinder-csi-plugin"
 
 	// AuthURL is a constant for the key in a cloud provider secret that holds the OpenStack auth url.
 	AuthURL = "authURL"
@@ -61,6 +69,13 @@ const (
 	// BackupSecretName defines the name of the secret containing the credentials which are required to
 	// authenticate against the respective cloud provider (required to store the backups of Shoot clusters).
 	BackupSecretName = "etcd-backup"
+
+	// CSIVersionConstraint is the constraint for the shoot Kubernetes versions for which the Cinder CSI driver is
+	// deployed and in-tree Cinder volumes are migrated to it.
+	CSIVersionConstraint = ">= 1.19"
+	// InTreeVolumePluginVersionConstraint is the constraint for the shoot Kubernetes versions for which the in-tree
+	// Cinder volume plugin is used.
+	InTreeVolumePluginVersionConstraint = "< 1.19"
 )
 
 var (
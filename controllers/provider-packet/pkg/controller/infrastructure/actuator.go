@@ -16,12 +16,14 @@ package infrastructure
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/imagevector"
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	glogger "github.com/gardener/gardener/pkg/logger"
@@ -41,17 +43,20 @@ import (
 type actuator struct {
 	logger logr.Logger
 
-	restConfig *rest.Config
+	restConfig         *rest.Config
+	terraformerFactory extensionsterraformer.Factory
 
 	client  client.Client
 	scheme  *runtime.Scheme
 	decoder runtime.Decoder
 }
 
-// NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources.
-func NewActuator() infrastructure.Actuator {
+// NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources. It uses the
+// Terraformers of the given factory.
+func NewActuator(terraformerFactory extensionsterraformer.Factory) infrastructure.Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		logger:             log.Log.WithName("infrastructure-actuator"),
+		terraformerFactory: terraformerFactory,
 	}
 }
 
@@ -79,10 +84,23 @@ func (a *actuator) Delete(ctx context.Context, config *extensionsv1alpha1.Infras
 	return a.delete(ctx, config, cluster)
 }
 
+func (a *actuator) Migrate(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	tf, err := a.newTerraformer(packet.TerraformerPurposeInfra, config.Namespace, config.Name)
+	if err != nil {
+		return fmt.Errorf("could not create the Terraformer: %+v", err)
+	}
+
+	return infrastructure.MigrateTerraformState(ctx, a.client, tf, config)
+}
+
+func (a *actuator) Restore(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.reconcile(ctx, config, cluster)
+}
+
 // Helper functions
 
-func (a *actuator) newTerraformer(purpose, namespace, name string) (*terraformer.Terraformer, error) {
-	t, err := terraformer.NewForConfig(glogger.NewLogger("info"), a.restConfig, purpose, namespace, name, imagevector.TerraformerImage())
+func (a *actuator) newTerraformer(purpose, namespace, name string) (extensionsterraformer.Interface, error) {
+	t, err := a.terraformerFactory.NewForConfig(glogger.NewLogger("info"), a.restConfig, purpose, namespace, name, imagevector.TerraformerImage())
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +113,17 @@ func (a *actuator) newTerraformer(purpose, namespace, name string) (*terraformer
 		SetDeadlineJob(15 * time.Minute), nil
 }
 
+func (a *actuator) newPlanTerraformer(purpose, namespace, name string) (extensionsterraformer.Interface, error) {
+	t, err := a.terraformerFactory.NewForConfig(glogger.NewLogger("info"), a.restConfig, purpose, namespace, name, imagevector.TerraformerImage())
+	if err != nil {
+		return nil, err
+	}
+
+	return t.
+		SetActiveDeadlineSeconds(630).
+		SetDeadlinePod(15 * time.Minute), nil
+}
+
 func generateTerraformInfraVariablesEnvironment(secret *corev1.Secret) map[string]string {
 	return terraformer.GenerateVariablesEnvironment(secret, map[string]string{
 		"PACKET_API_KEY": packet.APIToken,
@@ -20,6 +20,7 @@ import (
 
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	infrastructurecontroller "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -38,7 +39,18 @@ func (a *actuator) delete(ctx context.Context, infrastructure *extensionsv1alpha
 		return err
 	}
 
+	release, err := a.renderTerraformInfraChart(infrastructure, providerSecret)
+	if err != nil {
+		return err
+	}
+
+	state, err := infrastructurecontroller.GetTerraformState(infrastructure)
+	if err != nil {
+		return err
+	}
+
 	return tf.
 		SetVariablesEnvironment(generateTerraformInfraVariablesEnvironment(providerSecret)).
+		InitializeWith(a.terraformInfraInitializer(release, state)).
 		Destroy()
 }
@@ -0,0 +1,65 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	infrastructurecontroller "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DryRun implements infrastructure.DryRunner.
+func (a *actuator) DryRun(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (*extensionscontroller.DryRunResult, error) {
+	providerSecret := &corev1.Secret{}
+	if err := a.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
+		return nil, err
+	}
+
+	release, err := a.renderTerraformInfraChart(infrastructure, providerSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := a.newTerraformer(packet.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not create the Terraformer: %+v", err)
+	}
+
+	state, err := infrastructurecontroller.CurrentTerraformState(infrastructure, tf)
+	if err != nil {
+		return nil, err
+	}
+
+	planTF, err := a.newPlanTerraformer(infrastructurecontroller.TerraformPlanPurpose(packet.TerraformerPurposeInfra), infrastructure.Namespace, infrastructure.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not create the plan Terraformer: %+v", err)
+	}
+
+	return infrastructurecontroller.PlanTerraform(
+		ctx,
+		a.client,
+		planTF.SetVariablesEnvironment(generateTerraformInfraVariablesEnvironment(providerSecret)),
+		a.terraformInfraDefaultInitializer(release),
+		state,
+	)
+}
@@ -24,10 +24,11 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	infrastructurecontroller "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,36 +42,36 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 		return err
 	}
 
-	terraformConfig := GenerateTerraformInfraConfig(infrastructure, string(providerSecret.Data[packet.ProjectID]))
-
-	chartRenderer, err := chartrenderer.NewForConfig(a.restConfig)
+	release, err := a.renderTerraformInfraChart(infrastructure, providerSecret)
 	if err != nil {
-		return fmt.Errorf("could not create chart renderer: %+v", err)
+		return err
 	}
 
-	release, err := chartRenderer.Render(filepath.Join(packet.InternalChartsPath, "packet-infra"), "packet-infra", infrastructure.Namespace, terraformConfig)
+	tf, err := a.newTerraformer(packet.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
 	if err != nil {
-		return fmt.Errorf("could not render Terraform chart: %+v", err)
+		return fmt.Errorf("could not create terraformer object: %+v", err)
 	}
 
-	tf, err := a.newTerraformer(packet.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
+	state, err := infrastructurecontroller.GetTerraformState(infrastructure)
 	if err != nil {
-		return fmt.Errorf("could not create terraformer object: %+v", err)
+		return err
 	}
 
-	if err := tf.
+	applyErr := tf.
 		SetVariablesEnvironment(generateTerraformInfraVariablesEnvironment(providerSecret)).
-		InitializeWith(terraformer.DefaultInitializer(
-			a.client,
-			release.FileContent("main.tf"),
-			release.FileContent("variables.tf"),
-			[]byte(release.FileContent("terraform.tfvars"))),
-		).
-		Apply(); err != nil {
-
-		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infrastructure.Name)
+		InitializeWith(a.terraformInfraInitializer(release, state)).
+		Apply()
+
+	// The Terraform state is persisted even if the apply failed as it might already reference created resources.
+	if err := infrastructurecontroller.SaveTerraformState(ctx, a.client, tf, infrastructure); err != nil {
+		return fmt.Errorf("could not persist the Terraform state: %+v", err)
+	}
+
+	infrastructurecontroller.ReportTerraformApplyResult(ctx, a.logger, infrastructure, applyErr)
+	if applyErr != nil {
+		a.logger.Error(applyErr, "failed to apply the terraform config", "infrastructure", infrastructure.Name)
 		return &controllererrors.RequeueAfterError{
-			Cause:        err,
+			Cause:        applyErr,
 			RequeueAfter: 30 * time.Second,
 		}
 	}
@@ -78,6 +79,34 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 	return a.updateProviderStatus(ctx, tf, infrastructure)
 }
 
+func (a *actuator) renderTerraformInfraChart(infrastructure *extensionsv1alpha1.Infrastructure, providerSecret *corev1.Secret) (*chartrenderer.RenderedChart, error) {
+	terraformConfig := GenerateTerraformInfraConfig(infrastructure, string(providerSecret.Data[packet.ProjectID]))
+
+	chartRenderer, err := chartrenderer.NewForConfig(a.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not create chart renderer: %+v", err)
+	}
+
+	release, err := chartRenderer.Render(filepath.Join(packet.InternalChartsPath, "packet-infra"), "packet-infra", infrastructure.Namespace, terraformConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not render Terraform chart: %+v", err)
+	}
+	return release, nil
+}
+
+func (a *actuator) terraformInfraInitializer(release *chartrenderer.RenderedChart, state []byte) extensionsterraformer.Initializer {
+	return extensionsterraformer.WithState(a.client, a.terraformInfraDefaultInitializer(release), state)
+}
+
+func (a *actuator) terraformInfraDefaultInitializer(release *chartrenderer.RenderedChart) extensionsterraformer.Initializer {
+	return a.terraformerFactory.DefaultInitializer(
+		a.client,
+		release.FileContent("main.tf"),
+		release.FileContent("variables.tf"),
+		[]byte(release.FileContent("terraform.tfvars")),
+	)
+}
+
 // GenerateTerraformInfraConfig generates the Packet Terraform configuration based on the given infrastructure and project.
 func GenerateTerraformInfraConfig(infrastructure *extensionsv1alpha1.Infrastructure, projectID string) map[string]interface{} {
 	return map[string]interface{}{
@@ -92,7 +121,7 @@ func GenerateTerraformInfraConfig(infrastructure *extensionsv1alpha1.Infrastruct
 	}
 }
 
-func (a *actuator) updateProviderStatus(ctx context.Context, tf *terraformer.Terraformer, infrastructure *extensionsv1alpha1.Infrastructure) error {
+func (a *actuator) updateProviderStatus(ctx context.Context, tf extensionsterraformer.Interface, infrastructure *extensionsv1alpha1.Infrastructure) error {
 	outputVarKeys := []string{
 		packet.SSHKeyID,
 	}
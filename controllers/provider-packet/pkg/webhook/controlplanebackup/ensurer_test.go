@@ -22,6 +22,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	extensionscontext "github.com/gardener/gardener-extensions/pkg/webhook/context"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
@@ -87,7 +88,7 @@ var _ = Describe("Ensurer", func() {
 			ensurer := NewEnsurer(imageVector, logger)
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err := ensurer.EnsureETCDStatefulSet(context.TODO(), extensionscontext.NewInternalGardenContext(cluster), ss)
 			Expect(err).To(Not(HaveOccurred()))
 			checkETCDMainStatefulSet(ss, nil)
 		})
@@ -114,7 +115,7 @@ var _ = Describe("Ensurer", func() {
 			ensurer := NewEnsurer(imageVector, logger)
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err := ensurer.EnsureETCDStatefulSet(context.TODO(), extensionscontext.NewInternalGardenContext(cluster), ss)
 			Expect(err).To(Not(HaveOccurred()))
 			checkETCDMainStatefulSet(ss, nil)
 		})
@@ -130,7 +131,7 @@ var _ = Describe("Ensurer", func() {
 			ensurer := NewEnsurer(imageVector, logger)
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err := ensurer.EnsureETCDStatefulSet(context.TODO(), extensionscontext.NewInternalGardenContext(cluster), ss)
 			Expect(err).To(Not(HaveOccurred()))
 			checkETCDEventsStatefulSet(ss)
 		})
@@ -157,7 +158,7 @@ var _ = Describe("Ensurer", func() {
 			ensurer := NewEnsurer(imageVector, logger)
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err := ensurer.EnsureETCDStatefulSet(context.TODO(), extensionscontext.NewInternalGardenContext(cluster), ss)
 			Expect(err).To(Not(HaveOccurred()))
 			checkETCDEventsStatefulSet(ss)
 		})
@@ -0,0 +1,138 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+type actuator struct {
+	backupBucketDelegate BackupBucketDelegate
+	client               client.Client
+	logger               logr.Logger
+}
+
+// InjectClient injects the given client into the actuator.
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+// InjectFunc enables injecting Kubernetes dependencies into actuator's dependencies.
+func (a *actuator) InjectFunc(f inject.Func) error {
+	return f(a.backupBucketDelegate)
+}
+
+// NewActuator creates a new Actuator that creates and deletes the buckets of the handled BackupBucket resources
+// with the help of the given BackupBucketDelegate.
+func NewActuator(backupBucketDelegate BackupBucketDelegate, logger logr.Logger) backupbucket.Actuator {
+	return &actuator{
+		logger:               logger,
+		backupBucketDelegate: backupBucketDelegate,
+	}
+}
+
+// Reconcile reconciles the update of a BackupBucket.
+func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	if err := a.deployGeneratedSecret(ctx, bb); err != nil {
+		return errors.Wrapf(err, "could not deploy generated secret for backupbucket '%s'", bb.Name)
+	}
+
+	if err := a.backupBucketDelegate.CreateBucketIfNotExists(ctx, bb); err != nil {
+		return errors.Wrapf(err, "could not create bucket for backupbucket '%s'", bb.Name)
+	}
+
+	if err := a.backupBucketDelegate.EnsureLifecyclePolicy(ctx, bb); err != nil {
+		return errors.Wrapf(err, "could not ensure lifecycle policy of bucket for backupbucket '%s'", bb.Name)
+	}
+	return nil
+}
+
+// deployGeneratedSecret creates the secret generated for the given BackupBucket, if any, and references it in
+// the status of the BackupBucket. The secret is generated only once.
+func (a *actuator) deployGeneratedSecret(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	if bb.Status.GeneratedSecretRef != nil {
+		return nil
+	}
+
+	generatedSecretData, err := a.backupBucketDelegate.GetGeneratedSecretData(ctx, bb)
+	if err != nil || generatedSecretData == nil {
+		return err
+	}
+
+	generatedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GeneratedSecretName(bb.Name),
+			Namespace: GeneratedSecretNamespace,
+		},
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, a.client, generatedSecret, func() error {
+		generatedSecret.Data = generatedSecretData
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, bb, func() error {
+		bb.Status.GeneratedSecretRef = &corev1.SecretReference{
+			Name:      generatedSecret.Name,
+			Namespace: generatedSecret.Namespace,
+		}
+		return nil
+	})
+}
+
+// Delete deletes the BackupBucket.
+func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	// Not all object stores allow deleting buckets that still contain objects, hence the bucket is emptied first.
+	if err := a.backupBucketDelegate.DeleteObjectsWithPrefix(ctx, bb, ""); err != nil {
+		return errors.Wrapf(err, "could not delete objects of bucket for backupbucket '%s'", bb.Name)
+	}
+
+	if err := a.backupBucketDelegate.DeleteBucketIfExists(ctx, bb); err != nil {
+		return errors.Wrapf(err, "could not delete bucket for backupbucket '%s'", bb.Name)
+	}
+
+	return a.deleteGeneratedSecret(ctx, bb)
+}
+
+// deleteGeneratedSecret deletes the secret generated for the given BackupBucket, if any.
+func (a *actuator) deleteGeneratedSecret(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	if bb.Status.GeneratedSecretRef == nil {
+		return nil
+	}
+
+	generatedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bb.Status.GeneratedSecretRef.Name,
+			Namespace: bb.Status.GeneratedSecretRef.Namespace,
+		},
+	}
+	return client.IgnoreNotFound(a.client.Delete(ctx, generatedSecret))
+}
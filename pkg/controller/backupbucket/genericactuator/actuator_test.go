@@ -0,0 +1,188 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
+	mockgenericactuator "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/controller/backupbucket/genericactuator"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+const bucketName = "test-bucket"
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctrl *gomock.Controller
+		ctx  = context.TODO()
+
+		scheme *runtime.Scheme
+		c      client.Client
+		a      backupbucket.Actuator
+
+		backupBucketDelegate *mockgenericactuator.MockBackupBucketDelegate
+
+		bb                 *extensionsv1alpha1.BackupBucket
+		generatedSecretKey = kutil.Key(genericactuator.GeneratedSecretNamespace, genericactuator.GeneratedSecretName(bucketName))
+
+		logger = log.Log.WithName("test")
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		scheme = runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		bb = &extensionsv1alpha1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: bucketName,
+			},
+		}
+
+		backupBucketDelegate = mockgenericactuator.NewMockBackupBucketDelegate(ctrl)
+		a = genericactuator.NewActuator(backupBucketDelegate, logger)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#Reconcile", func() {
+		It("should create the bucket without generating a secret", func() {
+			c = fakeclient.NewFakeClientWithScheme(scheme, bb)
+			Expect(a.(inject.Client).InjectClient(c)).To(Succeed())
+
+			gomock.InOrder(
+				backupBucketDelegate.EXPECT().GetGeneratedSecretData(ctx, bb),
+				backupBucketDelegate.EXPECT().CreateBucketIfNotExists(ctx, bb),
+				backupBucketDelegate.EXPECT().EnsureLifecyclePolicy(ctx, bb),
+			)
+
+			Expect(a.Reconcile(ctx, bb)).To(Succeed())
+			Expect(bb.Status.GeneratedSecretRef).To(BeNil())
+
+			err := c.Get(ctx, generatedSecretKey, &corev1.Secret{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should generate a secret and reference it in the status", func() {
+			c = fakeclient.NewFakeClientWithScheme(scheme, bb)
+			Expect(a.(inject.Client).InjectClient(c)).To(Succeed())
+
+			generatedSecretData := map[string][]byte{"foo": []byte("bar")}
+			gomock.InOrder(
+				backupBucketDelegate.EXPECT().GetGeneratedSecretData(ctx, bb).Return(generatedSecretData, nil),
+				backupBucketDelegate.EXPECT().CreateBucketIfNotExists(ctx, bb),
+				backupBucketDelegate.EXPECT().EnsureLifecyclePolicy(ctx, bb),
+			)
+
+			Expect(a.Reconcile(ctx, bb)).To(Succeed())
+			Expect(bb.Status.GeneratedSecretRef).To(Equal(&corev1.SecretReference{
+				Name:      generatedSecretKey.Name,
+				Namespace: generatedSecretKey.Namespace,
+			}))
+
+			generatedSecret := &corev1.Secret{}
+			Expect(c.Get(ctx, generatedSecretKey, generatedSecret)).To(Succeed())
+			Expect(generatedSecret.Data).To(Equal(generatedSecretData))
+		})
+
+		It("should not generate the secret again if it is already referenced", func() {
+			bb.Status.GeneratedSecretRef = &corev1.SecretReference{Name: generatedSecretKey.Name, Namespace: generatedSecretKey.Namespace}
+			c = fakeclient.NewFakeClientWithScheme(scheme, bb)
+			Expect(a.(inject.Client).InjectClient(c)).To(Succeed())
+
+			gomock.InOrder(
+				backupBucketDelegate.EXPECT().CreateBucketIfNotExists(ctx, bb),
+				backupBucketDelegate.EXPECT().EnsureLifecyclePolicy(ctx, bb),
+			)
+
+			Expect(a.Reconcile(ctx, bb)).To(Succeed())
+		})
+
+		It("should fail if the bucket cannot be created", func() {
+			c = fakeclient.NewFakeClientWithScheme(scheme, bb)
+			Expect(a.(inject.Client).InjectClient(c)).To(Succeed())
+
+			gomock.InOrder(
+				backupBucketDelegate.EXPECT().GetGeneratedSecretData(ctx, bb),
+				backupBucketDelegate.EXPECT().CreateBucketIfNotExists(ctx, bb).Return(fmt.Errorf("error")),
+			)
+
+			Expect(a.Reconcile(ctx, bb)).NotTo(Succeed())
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete the bucket and the generated secret", func() {
+			bb.Status.GeneratedSecretRef = &corev1.SecretReference{Name: generatedSecretKey.Name, Namespace: generatedSecretKey.Namespace}
+			generatedSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: generatedSecretKey.Name, Namespace: generatedSecretKey.Namespace}}
+			c = fakeclient.NewFakeClientWithScheme(scheme, bb, generatedSecret)
+			Expect(a.(inject.Client).InjectClient(c)).To(Succeed())
+
+			gomock.InOrder(
+				backupBucketDelegate.EXPECT().DeleteObjectsWithPrefix(ctx, bb, ""),
+				backupBucketDelegate.EXPECT().DeleteBucketIfExists(ctx, bb),
+			)
+
+			Expect(a.Delete(ctx, bb)).To(Succeed())
+
+			err := c.Get(ctx, generatedSecretKey, &corev1.Secret{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should keep the generated secret if the bucket cannot be deleted", func() {
+			bb.Status.GeneratedSecretRef = &corev1.SecretReference{Name: generatedSecretKey.Name, Namespace: generatedSecretKey.Namespace}
+			generatedSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: generatedSecretKey.Name, Namespace: generatedSecretKey.Namespace}}
+			c = fakeclient.NewFakeClientWithScheme(scheme, bb, generatedSecret)
+			Expect(a.(inject.Client).InjectClient(c)).To(Succeed())
+
+			gomock.InOrder(
+				backupBucketDelegate.EXPECT().DeleteObjectsWithPrefix(ctx, bb, ""),
+				backupBucketDelegate.EXPECT().DeleteBucketIfExists(ctx, bb).Return(fmt.Errorf("error")),
+			)
+
+			Expect(a.Delete(ctx, bb)).NotTo(Succeed())
+			Expect(c.Get(ctx, generatedSecretKey, &corev1.Secret{})).To(Succeed())
+		})
+
+		It("should not delete the bucket if it cannot be emptied", func() {
+			c = fakeclient.NewFakeClientWithScheme(scheme, bb)
+			Expect(a.(inject.Client).InjectClient(c)).To(Succeed())
+
+			backupBucketDelegate.EXPECT().DeleteObjectsWithPrefix(ctx, bb, "").Return(fmt.Errorf("error"))
+
+			Expect(a.Delete(ctx, bb)).NotTo(Succeed())
+		})
+	})
+})
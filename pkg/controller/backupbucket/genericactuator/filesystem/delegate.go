@@ -0,0 +1,185 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	backupbucketgenericactuator "github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
+	backupentrygenericactuator "github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// store is an object store that keeps every bucket as a directory below its root directory. The key of an
+// object is its slash separated path relative to the directory of its bucket.
+type store struct {
+	root string
+}
+
+type backupBucketDelegate struct {
+	store
+}
+
+// NewBackupBucketDelegate creates a new BackupBucketDelegate that keeps the buckets as directories below the
+// given root directory of the local filesystem. It does neither generate secrets nor support lifecycle policies.
+func NewBackupBucketDelegate(root string) backupbucketgenericactuator.BackupBucketDelegate {
+	return &backupBucketDelegate{store{root}}
+}
+
+// GetGeneratedSecretData implements BackupBucketDelegate.
+func (d *backupBucketDelegate) GetGeneratedSecretData(_ context.Context, _ *extensionsv1alpha1.BackupBucket) (map[string][]byte, error) {
+	return nil, nil
+}
+
+// CreateBucketIfNotExists implements BackupBucketDelegate.
+func (d *backupBucketDelegate) CreateBucketIfNotExists(_ context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	return d.createBucketIfNotExists(bb.Name)
+}
+
+// EnsureLifecyclePolicy implements BackupBucketDelegate.
+func (d *backupBucketDelegate) EnsureLifecyclePolicy(_ context.Context, _ *extensionsv1alpha1.BackupBucket) error {
+	return nil
+}
+
+// DeleteObjectsWithPrefix implements BackupBucketDelegate.
+func (d *backupBucketDelegate) DeleteObjectsWithPrefix(_ context.Context, bb *extensionsv1alpha1.BackupBucket, prefix string) error {
+	return d.deleteObjectsWithPrefix(bb.Name, prefix)
+}
+
+// DeleteBucketIfExists implements BackupBucketDelegate.
+func (d *backupBucketDelegate) DeleteBucketIfExists(_ context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	return d.deleteBucketIfExists(bb.Name)
+}
+
+type backupEntryDelegate struct {
+	store
+}
+
+// NewBackupEntryDelegate creates a new BackupEntryDelegate that deletes the objects of BackupEntry resources from
+// the buckets kept below the given root directory of the local filesystem, see NewBackupBucketDelegate.
+func NewBackupEntryDelegate(root string) backupentrygenericactuator.BackupEntryDelegate {
+	return &backupEntryDelegate{store{root}}
+}
+
+// GetETCDSecretData implements BackupEntryDelegate.
+func (d *backupEntryDelegate) GetETCDSecretData(_ context.Context, _ *extensionsv1alpha1.BackupEntry, backupSecretData map[string][]byte) (map[string][]byte, error) {
+	return backupSecretData, nil
+}
+
+// Delete implements BackupEntryDelegate.
+func (d *backupEntryDelegate) Delete(_ context.Context, be *extensionsv1alpha1.BackupEntry) error {
+	return d.deleteObjectsWithPrefix(be.Spec.BucketName, fmt.Sprintf("%s/", be.Name))
+}
+
+func (s *store) bucketPath(bucket string) (string, error) {
+	if len(bucket) == 0 || bucket == "." || bucket == ".." || strings.ContainsAny(bucket, `/\`) {
+		return "", fmt.Errorf("invalid bucket name %q", bucket)
+	}
+	return filepath.Join(s.root, bucket), nil
+}
+
+func (s *store) createBucketIfNotExists(bucket string) error {
+	path, err := s.bucketPath(bucket)
+	if err != nil {
+		return err
+	}
+	return os.MkdirAll(path, 0700)
+}
+
+func (s *store) deleteBucketIfExists(bucket string) error {
+	path, err := s.bucketPath(bucket)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(path)
+}
+
+func (s *store) deleteObjectsWithPrefix(bucket, prefix string) error {
+	path, err := s.bucketPath(bucket)
+	if err != nil {
+		return err
+	}
+
+	var directories []string
+	if err := filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if file == path {
+			return nil
+		}
+
+		key, err := filepath.Rel(path, file)
+		if err != nil {
+			return err
+		}
+		key = filepath.ToSlash(key)
+
+		if info.IsDir() {
+			if strings.HasPrefix(key+"/", prefix) {
+				directories = append(directories, file)
+			}
+			return nil
+		}
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		return os.Remove(file)
+	}); err != nil {
+		return err
+	}
+
+	// Remove the directories of the deleted objects that became empty, deepest first.
+	sort.Sort(sort.Reverse(sort.StringSlice(directories)))
+	for _, directory := range directories {
+		empty, err := isEmptyDir(directory)
+		if err != nil {
+			return err
+		}
+		if !empty {
+			continue
+		}
+		if err := os.Remove(directory); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isEmptyDir(path string) (bool, error) {
+	dir, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer dir.Close()
+
+	if _, err := dir.Readdirnames(1); err != nil {
+		if err == io.EOF {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	backupbucketgenericactuator "github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator/filesystem"
+	backupentrygenericactuator "github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+const (
+	bucketName       = "test-bucket"
+	shootTechnicalID = "shoot--foo--bar"
+	secretName       = "backupprovider"
+	secretNamespace  = "garden"
+)
+
+var _ = Describe("Filesystem", func() {
+	var (
+		ctx    = context.TODO()
+		logger = log.Log.WithName("test")
+
+		root string
+
+		bb = &extensionsv1alpha1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: bucketName,
+			},
+		}
+		be = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: shootTechnicalID + "--uid",
+			},
+			Spec: extensionsv1alpha1.BackupEntrySpec{
+				BucketName: bucketName,
+				SecretRef: corev1.SecretReference{
+					Name:      secretName,
+					Namespace: secretNamespace,
+				},
+			},
+		}
+		otherEntryName = shootTechnicalID + "--other-uid"
+
+		writeObject = func(key string) {
+			path := filepath.Join(root, bucketName, filepath.FromSlash(key))
+			Expect(os.MkdirAll(filepath.Dir(path), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(path, []byte("data"), 0600)).To(Succeed())
+		}
+		objectExists = func(key string) bool {
+			_, err := os.Stat(filepath.Join(root, bucketName, filepath.FromSlash(key)))
+			return err == nil
+		}
+	)
+
+	BeforeEach(func() {
+		var err error
+		root, err = ioutil.TempDir("", "backupbucket")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(root)).To(Succeed())
+	})
+
+	It("should reconcile and delete buckets and entries", func() {
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		c := fakeclient.NewFakeClientWithScheme(scheme,
+			bb.DeepCopy(),
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: shootTechnicalID}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: secretNamespace}, Data: map[string][]byte{}},
+		)
+
+		bucketActuator := backupbucketgenericactuator.NewActuator(filesystem.NewBackupBucketDelegate(root), logger)
+		Expect(bucketActuator.(inject.Client).InjectClient(c)).To(Succeed())
+		entryActuator := backupentrygenericactuator.NewActuator(filesystem.NewBackupEntryDelegate(root), logger)
+		Expect(entryActuator.(inject.Client).InjectClient(c)).To(Succeed())
+
+		Expect(bucketActuator.Reconcile(ctx, bb)).To(Succeed())
+		Expect(filepath.Join(root, bucketName)).To(BeADirectory())
+		Expect(bb.Status.GeneratedSecretRef).To(BeNil())
+
+		Expect(entryActuator.Reconcile(ctx, be)).To(Succeed())
+		etcdBackupSecret := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: shootTechnicalID, Name: backupentrygenericactuator.EtcdBackupSecretName}, etcdBackupSecret)).To(Succeed())
+		Expect(etcdBackupSecret.Data).To(HaveKeyWithValue("bucketName", []byte(bucketName)))
+
+		writeObject(be.Name + "/v1/Full-00000000-00000001")
+		writeObject(be.Name + "/v1/Incr-00000002-00000003")
+		writeObject(otherEntryName + "/v1/Full-00000000-00000001")
+
+		Expect(entryActuator.Delete(ctx, be)).To(Succeed())
+		Expect(filepath.Join(root, bucketName, be.Name)).NotTo(BeADirectory())
+		Expect(objectExists(otherEntryName + "/v1/Full-00000000-00000001")).To(BeTrue())
+
+		Expect(bucketActuator.Delete(ctx, bb)).To(Succeed())
+		Expect(filepath.Join(root, bucketName)).NotTo(BeADirectory())
+	})
+
+	It("should delete only the objects with the given prefix", func() {
+		delegate := filesystem.NewBackupBucketDelegate(root)
+		Expect(delegate.CreateBucketIfNotExists(ctx, bb)).To(Succeed())
+
+		writeObject("foo/bar")
+		writeObject("foobar")
+		writeObject("baz/foo")
+
+		Expect(delegate.DeleteObjectsWithPrefix(ctx, bb, "foo")).To(Succeed())
+		Expect(objectExists("foo")).To(BeFalse())
+		Expect(objectExists("foobar")).To(BeFalse())
+		Expect(objectExists("baz/foo")).To(BeTrue())
+	})
+
+	It("should not fail if the bucket does not exist", func() {
+		delegate := filesystem.NewBackupBucketDelegate(root)
+
+		Expect(delegate.DeleteObjectsWithPrefix(ctx, bb, "")).To(Succeed())
+		Expect(delegate.DeleteBucketIfExists(ctx, bb)).To(Succeed())
+	})
+
+	It("should reject invalid bucket names", func() {
+		delegate := filesystem.NewBackupBucketDelegate(root)
+
+		Expect(delegate.CreateBucketIfNotExists(ctx, &extensionsv1alpha1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "../foo"}})).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,49 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// GeneratedSecretNamespace is the namespace of the secrets that are generated for BackupBucket resources.
+	GeneratedSecretNamespace = "garden"
+
+	generatedSecretNamePrefix = "generated-bucket-"
+)
+
+// BackupBucketDelegate performs provider specific operations on the object store of BackupBucket resources.
+type BackupBucketDelegate interface {
+	// GetGeneratedSecretData returns the data of the secret that is generated for the given BackupBucket and referenced
+	// in its status, e.g. object store specific credentials. It returns nil if no secret shall be generated.
+	GetGeneratedSecretData(context.Context, *extensionsv1alpha1.BackupBucket) (map[string][]byte, error)
+	// CreateBucketIfNotExists creates the bucket of the given BackupBucket if it does not exist yet.
+	CreateBucketIfNotExists(context.Context, *extensionsv1alpha1.BackupBucket) error
+	// EnsureLifecyclePolicy ensures the lifecycle and retention policy of the bucket of the given BackupBucket.
+	EnsureLifecyclePolicy(context.Context, *extensionsv1alpha1.BackupBucket) error
+	// DeleteObjectsWithPrefix deletes all objects with the given prefix from the bucket of the given BackupBucket,
+	// e.g. the objects of a BackupEntry stored in it. It must not fail if the bucket does not exist.
+	DeleteObjectsWithPrefix(context.Context, *extensionsv1alpha1.BackupBucket, string) error
+	// DeleteBucketIfExists deletes the bucket of the given BackupBucket if it exists.
+	DeleteBucketIfExists(context.Context, *extensionsv1alpha1.BackupBucket) error
+}
+
+// GeneratedSecretName returns the name of the secret that is generated for the BackupBucket with the given name.
+func GeneratedSecretName(backupBucketName string) string {
+	return generatedSecretNamePrefix + backupBucketName
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -116,7 +117,8 @@ func (r *reconciler) reconcile(ctx context.Context, bb *extensionsv1alpha1.Backu
 
 	r.logger.Info("Starting the reconciliation of backupbucket", "backupbucket", bb.Name)
 	r.recorder.Event(bb, corev1.EventTypeNormal, EventBackupBucketReconciliation, "Reconciling the backupbucket")
-	if err := r.actuator.Reconcile(ctx, bb); err != nil {
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.BackupBucketResource, bb.Spec.Type, operationType)
+	if err := observe(r.actuator.Reconcile(ctx, bb)); err != nil {
 		msg := "Error reconciling backupbucket"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), bb, operationType, msg)
 		r.logger.Error(err, msg, "backupbucket", bb.Name)
@@ -151,7 +153,8 @@ func (r *reconciler) delete(ctx context.Context, bb *extensionsv1alpha1.BackupBu
 
 	r.logger.Info("Starting the deletion of backupbucket", "backupbucket", bb.Name)
 	r.recorder.Event(bb, corev1.EventTypeNormal, EventBackupBucketDeletion, "Deleting the backupbucket")
-	if err := r.actuator.Delete(r.ctx, bb); err != nil {
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.BackupBucketResource, bb.Spec.Type, operationType)
+	if err := observe(r.actuator.Delete(r.ctx, bb)); err != nil {
 		msg := "Error deleting backupbucket"
 		r.recorder.Eventf(bb, corev1.EventTypeWarning, EventBackupBucketDeletion, "%s: %+v", msg, err)
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), bb, operationType, msg)
@@ -26,4 +26,8 @@ type Actuator interface {
 	Reconcile(context.Context, *extensionsv1alpha1.BackupEntry) error
 	// Delete deletes the BackupEntry.
 	Delete(context.Context, *extensionsv1alpha1.BackupEntry) error
+	// Migrate releases all seed-local resources of the BackupEntry while keeping the backups intact.
+	Migrate(context.Context, *extensionsv1alpha1.BackupEntry) error
+	// Restore rebuilds the seed-local resources of the BackupEntry after a migration.
+	Restore(context.Context, *extensionsv1alpha1.BackupEntry) error
 }
@@ -15,6 +15,7 @@
 package backupentry
 
 import (
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
 	corev1 "k8s.io/api/core/v1"
@@ -79,7 +80,7 @@ func Add(mgr manager.Manager, args AddArgs) error {
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
 func add(mgr manager.Manager, options controller.Options, predicates []predicate.Predicate) error {
-	ctrl, err := controller.New(ControllerName, mgr, options)
+	ctrl, err := extensionscontroller.NewController(ControllerName, mgr, &extensionsv1alpha1.BackupEntry{}, options)
 	if err != nil {
 		return err
 	}
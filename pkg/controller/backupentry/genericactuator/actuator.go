@@ -84,6 +84,9 @@ func (a *actuator) deployEtcdBackupSecret(ctx context.Context, be *extensionsv1a
 	}
 
 	backupSecretData := backupSecret.DeepCopy().Data
+	if backupSecretData == nil {
+		backupSecretData = map[string][]byte{}
+	}
 	backupSecretData[backupBucketName] = []byte(be.Spec.BucketName)
 	etcdSecretData, err := a.backupEntryDelegate.GetETCDSecretData(ctx, be, backupSecretData)
 	if err != nil {
@@ -108,3 +111,20 @@ func (a *actuator) deployEtcdBackupSecret(ctx context.Context, be *extensionsv1a
 func (a *actuator) Delete(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
 	return a.backupEntryDelegate.Delete(ctx, be)
 }
+
+// Migrate deletes the etcd backup secret from the shoot namespace of the seed. The backups themselves are not touched.
+func (a *actuator) Migrate(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
+	shootTechnicalID, _ := backupentry.ExtractShootDetailsFromBackupEntryName(be.Name)
+	etcdSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      EtcdBackupSecretName,
+			Namespace: shootTechnicalID,
+		},
+	}
+	return client.IgnoreNotFound(a.client.Delete(ctx, etcdSecret))
+}
+
+// Restore restores the etcd backup secret in the shoot namespace of the seed.
+func (a *actuator) Restore(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
+	return a.deployEtcdBackupSecret(ctx, be)
+}
@@ -23,11 +23,13 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
 	mockgenericactuator "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/controller/backupentry/genericactuator"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -165,4 +167,44 @@ var _ = Describe("Actuator", func() {
 			})
 		})
 	})
+
+	Describe("#Migrate", func() {
+		It("should delete the etcd backup secret", func() {
+			client := fakeclient.NewFakeClientWithScheme(scheme.Scheme, seedNamespace, beSecret, etcdBackupSecret.DeepCopy())
+
+			// Create mock values provider
+			backupEntryDelegate := mockgenericactuator.NewMockBackupEntryDelegate(ctrl)
+
+			// Create actuator
+			a := genericactuator.NewActuator(backupEntryDelegate, logger)
+			err := a.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call Migrate method and check the result
+			err = a.Migrate(context.TODO(), be)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.Get(context.TODO(), etcdBackupSecretKey, &corev1.Secret{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+			err = client.Get(context.TODO(), kutil.Key(providerSecretNamespace, providerSecretName), &corev1.Secret{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should succeed if the etcd backup secret does not exist", func() {
+			client := fakeclient.NewFakeClientWithScheme(scheme.Scheme, seedNamespace, beSecret)
+
+			// Create mock values provider
+			backupEntryDelegate := mockgenericactuator.NewMockBackupEntryDelegate(ctrl)
+
+			// Create actuator
+			a := genericactuator.NewActuator(backupEntryDelegate, logger)
+			err := a.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call Migrate method and check the result
+			err = a.Migrate(context.TODO(), be)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
 })
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constantshelper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -41,6 +42,10 @@ const (
 	EventBackupEntryReconciliation string = "BackupEntryReconciliation"
 	// EventBackupEntryDeletion an event reason to describe backup entry deletion.
 	EventBackupEntryDeletion string = "BackupEntryDeletion"
+	// EventBackupEntryMigration an event reason to describe backup entry migration.
+	EventBackupEntryMigration string = "BackupEntryMigration"
+	// EventBackupEntryRestoration an event reason to describe backup entry restoration.
+	EventBackupEntryRestoration string = "BackupEntryRestoration"
 )
 
 type reconciler struct {
@@ -87,10 +92,20 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
-	if be.DeletionTimestamp != nil {
+	operationType := extensionscontroller.ComputeOperationType(be.ObjectMeta, be.Status.LastOperation)
+
+	switch {
+	case operationType == extensionscontroller.LastOperationTypeMigrate:
+		return r.migrate(r.ctx, be)
+	case operationType == extensionscontroller.LastOperationTypeRestore:
+		return r.restore(r.ctx, be)
+	case extensionscontroller.IsMigrated(be):
+		return reconcile.Result{}, nil
+	case be.DeletionTimestamp != nil:
 		return r.delete(r.ctx, be)
+	default:
+		return r.reconcile(r.ctx, be)
 	}
-	return r.reconcile(r.ctx, be)
 }
 
 func (r *reconciler) reconcile(ctx context.Context, be *extensionsv1alpha1.BackupEntry) (reconcile.Result, error) {
@@ -98,7 +113,7 @@ func (r *reconciler) reconcile(ctx context.Context, be *extensionsv1alpha1.Backu
 		return reconcile.Result{}, err
 	}
 
-	operationType := v1alpha1constantshelper.ComputeOperationType(be.ObjectMeta, be.Status.LastOperation)
+	operationType := extensionscontroller.ComputeOperationType(be.ObjectMeta, be.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, be, operationType, "Reconciling the backupentry"); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -115,7 +130,8 @@ func (r *reconciler) reconcile(ctx context.Context, be *extensionsv1alpha1.Backu
 
 	r.logger.Info("Starting the reconciliation of backupentry", "backupentry", be.Name)
 	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryReconciliation, "Reconciling the backupentry")
-	if err := r.actuator.Reconcile(ctx, be); err != nil {
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.BackupEntryResource, be.Spec.Type, operationType)
+	if err := observe(r.actuator.Reconcile(ctx, be)); err != nil {
 		msg := "Error reconciling backupentry"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), be, operationType, msg)
 		r.logger.Error(err, msg, "backupentry", be.Name)
@@ -143,14 +159,15 @@ func (r *reconciler) delete(ctx context.Context, be *extensionsv1alpha1.BackupEn
 		return reconcile.Result{}, nil
 	}
 
-	operationType := v1alpha1constantshelper.ComputeOperationType(be.ObjectMeta, be.Status.LastOperation)
+	operationType := extensionscontroller.ComputeOperationType(be.ObjectMeta, be.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, be, operationType, "Deleting the backupentry"); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	r.logger.Info("Starting the deletion of backupentry", "backupentry", be.Name)
 	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryDeletion, "Deleting the backupentry")
-	if err := r.actuator.Delete(r.ctx, be); err != nil {
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.BackupEntryResource, be.Spec.Type, operationType)
+	if err := observe(r.actuator.Delete(r.ctx, be)); err != nil {
 		msg := "Error deleting backupentry"
 		r.recorder.Eventf(be, corev1.EventTypeWarning, EventBackupEntryDeletion, "%s: %+v", msg, err)
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), be, operationType, msg)
@@ -184,6 +201,98 @@ func (r *reconciler) delete(ctx context.Context, be *extensionsv1alpha1.BackupEn
 	return reconcile.Result{}, nil
 }
 
+func (r *reconciler) migrate(ctx context.Context, be *extensionsv1alpha1.BackupEntry) (reconcile.Result, error) {
+	operationType := extensionscontroller.LastOperationTypeMigrate
+	if err := r.updateStatusProcessing(ctx, be, operationType, "Migrating the backupentry"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the migration of backupentry", "backupentry", be.Name)
+	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryMigration, "Migrating the backupentry")
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.BackupEntryResource, be.Spec.Type, operationType)
+	if err := observe(r.actuator.Migrate(ctx, be)); err != nil {
+		msg := "Error migrating backupentry"
+		r.recorder.Eventf(be, corev1.EventTypeWarning, EventBackupEntryMigration, "%s: %+v", msg, err)
+		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), be, operationType, msg)
+		r.logger.Error(err, msg, "backupentry", be.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully migrated backupentry"
+	r.logger.Info(msg, "backupentry", be.Name)
+	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryMigration, msg)
+	if err := r.updateStatusSuccess(ctx, be, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	secret, err := extensionscontroller.GetSecretByReference(ctx, r.client, &be.Spec.SecretRef)
+	if err != nil {
+		r.logger.Info("failed to get backup entry secret, %v", err)
+		return reconcile.Result{}, err
+	}
+	if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, secret); err != nil {
+		r.logger.Info("failed to remove finalizer on backup entry secret, %v", err)
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Removing finalizer.", "backupentry", be.Name)
+	if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, be); err != nil {
+		r.logger.Error(err, "Error removing finalizer from backupentry", "backupentry", be.Name)
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, be); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) restore(ctx context.Context, be *extensionsv1alpha1.BackupEntry) (reconcile.Result, error) {
+	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, FinalizerName, be); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	operationType := extensionscontroller.LastOperationTypeRestore
+	if err := r.updateStatusProcessing(ctx, be, operationType, "Restoring the backupentry"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	secret, err := extensionscontroller.GetSecretByReference(ctx, r.client, &be.Spec.SecretRef)
+	if err != nil {
+		r.logger.Info("failed to get backup entry secret, %v", err)
+		return reconcile.Result{}, err
+	}
+	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, FinalizerName, secret); err != nil {
+		r.logger.Info("failed to ensure finalizer on backup entry secret, %v", err)
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the restoration of backupentry", "backupentry", be.Name)
+	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryRestoration, "Restoring the backupentry")
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.BackupEntryResource, be.Spec.Type, operationType)
+	if err := observe(r.actuator.Restore(ctx, be)); err != nil {
+		msg := "Error restoring backupentry"
+		r.recorder.Eventf(be, corev1.EventTypeWarning, EventBackupEntryRestoration, "%s: %+v", msg, err)
+		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), be, operationType, msg)
+		r.logger.Error(err, msg, "backupentry", be.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully restored backupentry"
+	r.logger.Info(msg, "backupentry", be.Name)
+	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryRestoration, msg)
+	if err := r.updateStatusSuccess(ctx, be, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, be); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
 func (r *reconciler) updateStatusProcessing(ctx context.Context, be *extensionsv1alpha1.BackupEntry, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, be, func() error {
 		be.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
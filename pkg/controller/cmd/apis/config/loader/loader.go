@@ -0,0 +1,75 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"io/ioutil"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/cmd/apis/config"
+	"github.com/gardener/gardener-extensions/pkg/controller/cmd/apis/config/install"
+	"github.com/gardener/gardener-extensions/pkg/controller/cmd/apis/config/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/runtime/serializer/versioning"
+)
+
+var (
+	// Codec is the codec used to decode controller manager configurations.
+	Codec runtime.Codec
+	// Scheme is the scheme the controller manager configuration API is installed in.
+	Scheme *runtime.Scheme
+)
+
+func init() {
+	Scheme = runtime.NewScheme()
+	install.Install(Scheme)
+	yamlSerializer := json.NewYAMLSerializer(json.DefaultMetaFactory, Scheme, Scheme)
+	Codec = versioning.NewDefaultingCodecForScheme(
+		Scheme,
+		yamlSerializer,
+		yamlSerializer,
+		v1alpha1.SchemeGroupVersion,
+		runtime.InternalGroupVersioner,
+	)
+}
+
+// LoadFromFile takes a filename and de-serializes the contents into ControllerManagerConfiguration object.
+func LoadFromFile(filename string) (*config.ControllerManagerConfiguration, error) {
+	bytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return Load(bytes)
+}
+
+// Load takes a byte slice and de-serializes the contents into ControllerManagerConfiguration object.
+// Encapsulates de-serialization without assuming the source is a file.
+func Load(data []byte) (*config.ControllerManagerConfiguration, error) {
+	cfg := &config.ControllerManagerConfiguration{}
+
+	if len(data) == 0 {
+		return cfg, nil
+	}
+
+	gvk := v1alpha1.SchemeGroupVersion.WithKind("ControllerManagerConfiguration")
+	decoded, _, err := Codec.Decode(data, &gvk, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return decoded.(*config.ControllerManagerConfiguration), nil
+}
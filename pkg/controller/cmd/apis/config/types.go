@@ -0,0 +1,111 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ControllerManagerConfiguration defines the configuration of an extension controller manager. Unset fields keep
+// the defaults of the respective command line flags, and flags that are set on the command line override the
+// values of the configuration.
+type ControllerManagerConfiguration struct {
+	metav1.TypeMeta
+
+	// LeaderElection is the leader election configuration.
+	LeaderElection *LeaderElectionConfiguration
+	// SyncPeriod is the minimum interval in which all watched resources are reconciled.
+	SyncPeriod *metav1.Duration
+	// Controllers is the configuration of the controllers.
+	Controllers *ControllersConfiguration
+	// Webhooks is the configuration of the webhooks.
+	Webhooks *WebhooksConfiguration
+}
+
+// LeaderElectionConfiguration is the leader election configuration.
+type LeaderElectionConfiguration struct {
+	// LeaderElect specifies whether leader election is turned on or not.
+	LeaderElect *bool
+	// ID is the id to do leader election with.
+	ID string
+	// Namespace is the namespace to do leader election in.
+	Namespace string
+}
+
+// ControllersConfiguration is the configuration of the controllers.
+type ControllersConfiguration struct {
+	// MaxConcurrentReconciles is the maximum number of concurrent reconciliations of all controllers that are not
+	// configured individually.
+	MaxConcurrentReconciles *int
+	// IgnoreOperationAnnotation specifies whether the operation annotation is ignored or not.
+	IgnoreOperationAnnotation *bool
+	// Disabled is the list of controllers that are disabled.
+	Disabled []string
+	// Controllers maps the names of individual controllers, e.g. `controlplane_controller`, to their configuration.
+	Controllers map[string]ControllerConfiguration
+}
+
+// ControllerConfiguration is the configuration of an individual controller.
+type ControllerConfiguration struct {
+	// MaxConcurrentReconciles is the maximum number of concurrent reconciliations of the controller.
+	MaxConcurrentReconciles *int
+	// Resync is the interval after which successfully reconciled objects are reconciled again.
+	Resync *metav1.Duration
+	// RateLimiter is the configuration of the rate limiter of the reconciliations.
+	RateLimiter *RateLimiterConfiguration
+	// ReconcileTimeout is the duration after which a single reconciliation is considered failed.
+	ReconcileTimeout *metav1.Duration
+}
+
+// RateLimiterConfiguration is the configuration of the rate limiter of the reconciliations of a controller.
+type RateLimiterConfiguration struct {
+	// BaseDelay is the delay after which an object is reconciled again after its first failed reconciliation.
+	// The delay is doubled with each consecutive failure.
+	BaseDelay *metav1.Duration
+	// MaxDelay is the maximum delay after which an object is reconciled again after failed reconciliations.
+	MaxDelay *metav1.Duration
+	// QPS is the overall number of reconciliations per second the controller may start.
+	QPS *float32
+	// Burst is the maximum number of reconciliations that may be started at once.
+	Burst *int
+}
+
+// WebhooksConfiguration is the configuration of the webhooks.
+type WebhooksConfiguration struct {
+	// Server is the configuration of the webhook server.
+	Server *WebhookServerConfiguration
+	// Disabled is the list of webhooks that are disabled.
+	Disabled []string
+	// ReportOnly is the list of webhooks that only report their mutations instead of applying them.
+	ReportOnly []string
+}
+
+// WebhookServerConfiguration is the configuration of the webhook server.
+type WebhookServerConfiguration struct {
+	// Mode is the mode that is used to register the webhooks, i.e. `service`, `url` or `url-service`.
+	Mode string
+	// Host is the host the webhook server binds to.
+	Host string
+	// Port is the port the webhook server listens on.
+	Port *int
+	// URL is the URL that is used to register the webhooks in `url` mode.
+	URL string
+	// Namespace is the namespace of the webhook service in `service` mode.
+	Namespace string
+	// CertDir is the directory that contains the webhook server key and certificate.
+	CertDir string
+}
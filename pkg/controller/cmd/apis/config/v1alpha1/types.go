@@ -0,0 +1,139 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ControllerManagerConfiguration defines the configuration of an extension controller manager. Unset fields keep
+// the defaults of the respective command line flags, and flags that are set on the command line override the
+// values of the configuration.
+type ControllerManagerConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// LeaderElection is the leader election configuration.
+	// +optional
+	LeaderElection *LeaderElectionConfiguration `json:"leaderElection,omitempty"`
+	// SyncPeriod is the minimum interval in which all watched resources are reconciled.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Controllers is the configuration of the controllers.
+	// +optional
+	Controllers *ControllersConfiguration `json:"controllers,omitempty"`
+	// Webhooks is the configuration of the webhooks.
+	// +optional
+	Webhooks *WebhooksConfiguration `json:"webhooks,omitempty"`
+}
+
+// LeaderElectionConfiguration is the leader election configuration.
+type LeaderElectionConfiguration struct {
+	// LeaderElect specifies whether leader election is turned on or not.
+	// +optional
+	LeaderElect *bool `json:"leaderElect,omitempty"`
+	// ID is the id to do leader election with.
+	// +optional
+	ID string `json:"id,omitempty"`
+	// Namespace is the namespace to do leader election in.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ControllersConfiguration is the configuration of the controllers.
+type ControllersConfiguration struct {
+	// MaxConcurrentReconciles is the maximum number of concurrent reconciliations of all controllers that are not
+	// configured individually.
+	// +optional
+	MaxConcurrentReconciles *int `json:"maxConcurrentReconciles,omitempty"`
+	// IgnoreOperationAnnotation specifies whether the operation annotation is ignored or not.
+	// +optional
+	IgnoreOperationAnnotation *bool `json:"ignoreOperationAnnotation,omitempty"`
+	// Disabled is the list of controllers that are disabled.
+	// +optional
+	Disabled []string `json:"disabled,omitempty"`
+	// Controllers maps the names of individual controllers, e.g. `controlplane_controller`, to their configuration.
+	// +optional
+	Controllers map[string]ControllerConfiguration `json:"controllers,omitempty"`
+}
+
+// ControllerConfiguration is the configuration of an individual controller.
+type ControllerConfiguration struct {
+	// MaxConcurrentReconciles is the maximum number of concurrent reconciliations of the controller.
+	// +optional
+	MaxConcurrentReconciles *int `json:"maxConcurrentReconciles,omitempty"`
+	// Resync is the interval after which successfully reconciled objects are reconciled again.
+	// +optional
+	Resync *metav1.Duration `json:"resync,omitempty"`
+	// RateLimiter is the configuration of the rate limiter of the reconciliations.
+	// +optional
+	RateLimiter *RateLimiterConfiguration `json:"rateLimiter,omitempty"`
+	// ReconcileTimeout is the duration after which a single reconciliation is considered failed.
+	// +optional
+	ReconcileTimeout *metav1.Duration `json:"reconcileTimeout,omitempty"`
+}
+
+// RateLimiterConfiguration is the configuration of the rate limiter of the reconciliations of a controller.
+type RateLimiterConfiguration struct {
+	// BaseDelay is the delay after which an object is reconciled again after its first failed reconciliation.
+	// The delay is doubled with each consecutive failure.
+	// +optional
+	BaseDelay *metav1.Duration `json:"baseDelay,omitempty"`
+	// MaxDelay is the maximum delay after which an object is reconciled again after failed reconciliations.
+	// +optional
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+	// QPS is the overall number of reconciliations per second the controller may start.
+	// +optional
+	QPS *float32 `json:"qps,omitempty"`
+	// Burst is the maximum number of reconciliations that may be started at once.
+	// +optional
+	Burst *int `json:"burst,omitempty"`
+}
+
+// WebhooksConfiguration is the configuration of the webhooks.
+type WebhooksConfiguration struct {
+	// Server is the configuration of the webhook server.
+	// +optional
+	Server *WebhookServerConfiguration `json:"server,omitempty"`
+	// Disabled is the list of webhooks that are disabled.
+	// +optional
+	Disabled []string `json:"disabled,omitempty"`
+	// ReportOnly is the list of webhooks that only report their mutations instead of applying them.
+	// +optional
+	ReportOnly []string `json:"reportOnly,omitempty"`
+}
+
+// WebhookServerConfiguration is the configuration of the webhook server.
+type WebhookServerConfiguration struct {
+	// Mode is the mode that is used to register the webhooks, i.e. `service`, `url` or `url-service`.
+	// +optional
+	Mode string `json:"mode,omitempty"`
+	// Host is the host the webhook server binds to.
+	// +optional
+	Host string `json:"host,omitempty"`
+	// Port is the port the webhook server listens on.
+	// +optional
+	Port *int `json:"port,omitempty"`
+	// URL is the URL that is used to register the webhooks in `url` mode.
+	// +optional
+	URL string `json:"url,omitempty"`
+	// Namespace is the namespace of the webhook service in `service` mode.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// CertDir is the directory that contains the webhook server key and certificate.
+	// +optional
+	CertDir string `json:"certDir,omitempty"`
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"github.com/gardener/gardener-extensions/pkg/controller/cmd/apis/config"
+	"github.com/gardener/gardener-extensions/pkg/webhook"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var validWebhookModes = sets.NewString(webhook.ModeService, webhook.ModeURL, webhook.ModeURLWithServiceName)
+
+// ValidateControllerManagerConfiguration validates a ControllerManagerConfiguration object.
+func ValidateControllerManagerConfiguration(cfg *config.ControllerManagerConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validatePositiveDuration(cfg.SyncPeriod, field.NewPath("syncPeriod"))...)
+	if cfg.Controllers != nil {
+		allErrs = append(allErrs, validateControllersConfiguration(cfg.Controllers, field.NewPath("controllers"))...)
+	}
+	if cfg.Webhooks != nil {
+		allErrs = append(allErrs, validateWebhooksConfiguration(cfg.Webhooks, field.NewPath("webhooks"))...)
+	}
+
+	return allErrs
+}
+
+func validateControllersConfiguration(cfg *config.ControllersConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := validateMaxConcurrentReconciles(cfg.MaxConcurrentReconciles, fldPath.Child("maxConcurrentReconciles"))
+
+	controllersPath := fldPath.Child("controllers")
+	for name, controller := range cfg.Controllers {
+		allErrs = append(allErrs, validateControllerConfiguration(controller, controllersPath.Key(name))...)
+	}
+
+	return allErrs
+}
+
+func validateControllerConfiguration(cfg config.ControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := validateMaxConcurrentReconciles(cfg.MaxConcurrentReconciles, fldPath.Child("maxConcurrentReconciles"))
+	allErrs = append(allErrs, validatePositiveDuration(cfg.Resync, fldPath.Child("resync"))...)
+	allErrs = append(allErrs, validatePositiveDuration(cfg.ReconcileTimeout, fldPath.Child("reconcileTimeout"))...)
+
+	if rateLimiter := cfg.RateLimiter; rateLimiter != nil {
+		rateLimiterPath := fldPath.Child("rateLimiter")
+
+		allErrs = append(allErrs, validatePositiveDuration(rateLimiter.BaseDelay, rateLimiterPath.Child("baseDelay"))...)
+		allErrs = append(allErrs, validatePositiveDuration(rateLimiter.MaxDelay, rateLimiterPath.Child("maxDelay"))...)
+		if rateLimiter.BaseDelay != nil && rateLimiter.MaxDelay != nil && rateLimiter.BaseDelay.Duration > rateLimiter.MaxDelay.Duration {
+			allErrs = append(allErrs, field.Invalid(rateLimiterPath.Child("maxDelay"), rateLimiter.MaxDelay.Duration.String(), "must not be less than the base delay"))
+		}
+		if rateLimiter.QPS != nil && *rateLimiter.QPS <= 0 {
+			allErrs = append(allErrs, field.Invalid(rateLimiterPath.Child("qps"), *rateLimiter.QPS, "must be greater than 0"))
+		}
+		if rateLimiter.Burst != nil && *rateLimiter.Burst < 1 {
+			allErrs = append(allErrs, field.Invalid(rateLimiterPath.Child("burst"), *rateLimiter.Burst, "must be at least 1"))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePositiveDuration(duration *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if duration != nil && duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration.Duration.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateMaxConcurrentReconciles(maxConcurrentReconciles *int, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if maxConcurrentReconciles != nil && *maxConcurrentReconciles < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *maxConcurrentReconciles, "must be at least 1"))
+	}
+
+	return allErrs
+}
+
+func validateWebhooksConfiguration(cfg *config.WebhooksConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if server := cfg.Server; server != nil {
+		serverPath := fldPath.Child("server")
+
+		if !validWebhookModes.Has(server.Mode) {
+			allErrs = append(allErrs, field.NotSupported(serverPath.Child("mode"), server.Mode, validWebhookModes.List()))
+		}
+		if server.Mode == webhook.ModeURL && len(server.URL) == 0 {
+			allErrs = append(allErrs, field.Required(serverPath.Child("url"), "must provide a url in 'url' mode"))
+		}
+		if server.Port != nil && (*server.Port < 1 || *server.Port > 65535) {
+			allErrs = append(allErrs, field.Invalid(serverPath.Child("port"), *server.Port, "must be between 1 and 65535"))
+		}
+	}
+
+	disabled := sets.NewString(cfg.Disabled...)
+	reportOnlyPath := fldPath.Child("reportOnly")
+	for i, name := range cfg.ReportOnly {
+		if disabled.Has(name) {
+			allErrs = append(allErrs, field.Invalid(reportOnlyPath.Index(i), name, "webhook must not be disabled and report-only at the same time"))
+		}
+	}
+
+	return allErrs
+}
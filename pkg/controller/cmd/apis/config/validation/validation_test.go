@@ -0,0 +1,165 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/cmd/apis/config"
+	. "github.com/gardener/gardener-extensions/pkg/controller/cmd/apis/config/validation"
+	"github.com/gardener/gardener-extensions/pkg/webhook"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("ControllerManagerConfiguration validation", func() {
+	var cfg *config.ControllerManagerConfiguration
+
+	BeforeEach(func() {
+		one, port := 1, 443
+		cfg = &config.ControllerManagerConfiguration{
+			SyncPeriod: &metav1.Duration{Duration: time.Hour},
+			Controllers: &config.ControllersConfiguration{
+				MaxConcurrentReconciles: &one,
+				Controllers: map[string]config.ControllerConfiguration{
+					"controlplane": {MaxConcurrentReconciles: &one},
+				},
+			},
+			Webhooks: &config.WebhooksConfiguration{
+				Server: &config.WebhookServerConfiguration{
+					Mode: webhook.ModeService,
+					Port: &port,
+				},
+				Disabled:   []string{"foo"},
+				ReportOnly: []string{"bar"},
+			},
+		}
+	})
+
+	Describe("#ValidateControllerManagerConfiguration", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(cfg)).To(BeEmpty())
+		})
+
+		It("should allow an empty configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(&config.ControllerManagerConfiguration{})).To(BeEmpty())
+		})
+
+		It("should forbid a non-positive sync period", func() {
+			cfg.SyncPeriod.Duration = 0
+
+			Expect(ValidateControllerManagerConfiguration(cfg)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("syncPeriod"),
+			}))))
+		})
+
+		It("should forbid invalid concurrency settings", func() {
+			zero := 0
+			cfg.Controllers.MaxConcurrentReconciles = &zero
+			cfg.Controllers.Controllers["controlplane"] = config.ControllerConfiguration{MaxConcurrentReconciles: &zero}
+
+			Expect(ValidateControllerManagerConfiguration(cfg)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.maxConcurrentReconciles"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.controllers[controlplane].maxConcurrentReconciles"),
+				})),
+			))
+		})
+
+		It("should forbid invalid controller tunings", func() {
+			zero := 0
+			qps := float32(0)
+			cfg.Controllers.Controllers["controlplane"] = config.ControllerConfiguration{
+				Resync:           &metav1.Duration{},
+				ReconcileTimeout: &metav1.Duration{Duration: -time.Second},
+				RateLimiter: &config.RateLimiterConfiguration{
+					BaseDelay: &metav1.Duration{Duration: time.Minute},
+					MaxDelay:  &metav1.Duration{Duration: time.Second},
+					QPS:       &qps,
+					Burst:     &zero,
+				},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(cfg)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.controllers[controlplane].resync"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.controllers[controlplane].reconcileTimeout"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.controllers[controlplane].rateLimiter.maxDelay"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.controllers[controlplane].rateLimiter.qps"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.controllers[controlplane].rateLimiter.burst"),
+				})),
+			))
+		})
+
+		It("should forbid unsupported webhook modes", func() {
+			cfg.Webhooks.Server.Mode = "foo"
+
+			Expect(ValidateControllerManagerConfiguration(cfg)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("webhooks.server.mode"),
+			}))))
+		})
+
+		It("should require a url in 'url' mode", func() {
+			cfg.Webhooks.Server.Mode = webhook.ModeURL
+
+			Expect(ValidateControllerManagerConfiguration(cfg)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("webhooks.server.url"),
+			}))))
+		})
+
+		It("should forbid invalid webhook server ports", func() {
+			port := 70000
+			cfg.Webhooks.Server.Port = &port
+
+			Expect(ValidateControllerManagerConfiguration(cfg)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("webhooks.server.port"),
+			}))))
+		})
+
+		It("should forbid webhooks that are disabled and report-only", func() {
+			cfg.Webhooks.ReportOnly = []string{"bar", "foo"}
+
+			Expect(ValidateControllerManagerConfiguration(cfg)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("webhooks.reportOnly[1]"),
+			}))))
+		})
+	})
+})
@@ -0,0 +1,61 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// DriftDetectionIntervalFlag is the name of the command line flag to specify the interval in which resources are
+// checked for drift.
+const DriftDetectionIntervalFlag = "drift-detection-interval"
+
+// DriftDetectionOptions are command line options for the periodic detection of drift between the actual and the
+// desired state of resources.
+type DriftDetectionOptions struct {
+	// Interval is the interval in which resources are checked for drift. Zero disables the drift detection.
+	Interval time.Duration
+
+	config *DriftDetectionConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (d *DriftDetectionOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&d.Interval, DriftDetectionIntervalFlag, d.Interval, "The interval in which resources are checked for drift without applying changes. Zero disables the drift detection.")
+}
+
+// Complete implements Completer.Complete.
+func (d *DriftDetectionOptions) Complete() error {
+	d.config = &DriftDetectionConfig{d.Interval}
+	return nil
+}
+
+// Completed returns the completed DriftDetectionConfig. Only call this if `Complete` was successful.
+func (d *DriftDetectionOptions) Completed() *DriftDetectionConfig {
+	return d.config
+}
+
+// DriftDetectionConfig is a completed drift detection configuration.
+type DriftDetectionConfig struct {
+	// Interval is the interval in which resources are checked for drift.
+	Interval time.Duration
+}
+
+// Apply sets the values of this DriftDetectionConfig in the given interval.
+func (d *DriftDetectionConfig) Apply(interval *time.Duration) {
+	*interval = d.Interval
+}
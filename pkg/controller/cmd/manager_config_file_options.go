@@ -0,0 +1,240 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/cmd/apis/config"
+	"github.com/gardener/gardener-extensions/pkg/controller/cmd/apis/config/loader"
+	"github.com/gardener/gardener-extensions/pkg/controller/cmd/apis/config/validation"
+	webhookcmd "github.com/gardener/gardener-extensions/pkg/webhook/cmd"
+
+	"github.com/spf13/pflag"
+)
+
+// ManagerConfigFileFlag is the name of the command line flag to specify the controller manager configuration file.
+const ManagerConfigFileFlag = "manager-config-file"
+
+// ManagerConfigFileOptions are command line options to load a ControllerManagerConfiguration from a file.
+//
+// The values of the configuration are applied to all registered command line flags that have not been set
+// explicitly, i.e. the configuration replaces the flag defaults while flags given on the command line still take
+// precedence. As the values are applied when the options are completed, the ManagerConfigFileOptions must not be
+// prefixed and have to be the first options of an OptionAggregator.
+type ManagerConfigFileOptions struct {
+	// ConfigFilePath is the path to a controller manager configuration file.
+	ConfigFilePath string
+
+	fs     *pflag.FlagSet
+	config *ManagerConfigFileConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *ManagerConfigFileOptions) AddFlags(fs *pflag.FlagSet) {
+	o.fs = fs
+	fs.StringVar(&o.ConfigFilePath, ManagerConfigFileFlag, o.ConfigFilePath, "Path to the controller manager configuration file. Flags given on the command line override its values.")
+}
+
+// Complete implements Completer.Complete.
+func (o *ManagerConfigFileOptions) Complete() error {
+	cfg := &config.ControllerManagerConfiguration{}
+
+	if len(o.ConfigFilePath) != 0 {
+		var err error
+		cfg, err = loader.LoadFromFile(o.ConfigFilePath)
+		if err != nil {
+			return fmt.Errorf("could not load manager config file %q: %v", o.ConfigFilePath, err)
+		}
+
+		if errs := validation.ValidateControllerManagerConfiguration(cfg); len(errs) != 0 {
+			return fmt.Errorf("invalid manager config file %q: %v", o.ConfigFilePath, errs.ToAggregate())
+		}
+
+		if o.fs != nil {
+			if err := applyManagerConfigToFlags(cfg, o.fs); err != nil {
+				return err
+			}
+		}
+	}
+
+	o.config = &ManagerConfigFileConfig{cfg}
+	return nil
+}
+
+// Completed returns the completed ManagerConfigFileConfig. Only call this if `Complete` was successful.
+func (o *ManagerConfigFileOptions) Completed() *ManagerConfigFileConfig {
+	return o.config
+}
+
+// ManagerConfigFileConfig is a completed controller manager configuration.
+type ManagerConfigFileConfig struct {
+	// Config is the loaded controller manager configuration.
+	Config *config.ControllerManagerConfiguration
+}
+
+func applyManagerConfigToFlags(cfg *config.ControllerManagerConfiguration, fs *pflag.FlagSet) error {
+	if le := cfg.LeaderElection; le != nil {
+		if le.LeaderElect != nil {
+			if err := setFlagDefault(fs, LeaderElectionFlag, strconv.FormatBool(*le.LeaderElect)); err != nil {
+				return err
+			}
+		}
+		if err := setFlagDefault(fs, LeaderElectionIDFlag, le.ID); err != nil {
+			return err
+		}
+		if err := setFlagDefault(fs, LeaderElectionNamespaceFlag, le.Namespace); err != nil {
+			return err
+		}
+	}
+
+	if cfg.SyncPeriod != nil {
+		if err := setFlagDefault(fs, SyncPeriodFlag, cfg.SyncPeriod.Duration.String()); err != nil {
+			return err
+		}
+	}
+
+	if controllers := cfg.Controllers; controllers != nil {
+		if err := applyControllersConfigToFlags(controllers, fs); err != nil {
+			return err
+		}
+	}
+
+	if webhooks := cfg.Webhooks; webhooks != nil {
+		if err := applyWebhooksConfigToFlags(webhooks, fs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyControllersConfigToFlags(cfg *config.ControllersConfiguration, fs *pflag.FlagSet) error {
+	if cfg.IgnoreOperationAnnotation != nil {
+		if err := setFlagDefault(fs, IgnoreOperationAnnotationFlag, strconv.FormatBool(*cfg.IgnoreOperationAnnotation)); err != nil {
+			return err
+		}
+	}
+	if err := setFlagDefault(fs, DisableFlag, strings.Join(cfg.Disabled, ",")); err != nil {
+		return err
+	}
+
+	if cfg.MaxConcurrentReconciles != nil {
+		var concurrencyFlags []string
+		fs.VisitAll(func(flag *pflag.Flag) {
+			if flag.Name == MaxConcurrentReconcilesFlag || strings.HasSuffix(flag.Name, "-"+MaxConcurrentReconcilesFlag) {
+				concurrencyFlags = append(concurrencyFlags, flag.Name)
+			}
+		})
+
+		for _, name := range concurrencyFlags {
+			if err := setFlagDefault(fs, name, strconv.Itoa(*cfg.MaxConcurrentReconciles)); err != nil {
+				return err
+			}
+		}
+	}
+
+	var tuning []string
+	for name, controller := range cfg.Controllers {
+		tuning = append(tuning, controllerTuningValues(name, controller)...)
+	}
+	sort.Strings(tuning)
+
+	if err := setFlagDefault(fs, ControllerTuningFlag, strings.Join(tuning, ",")); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func controllerTuningValues(name string, cfg config.ControllerConfiguration) []string {
+	var values []string
+	add := func(option, value string) {
+		values = append(values, fmt.Sprintf("%s=%s", TuningKey(name, option), value))
+	}
+
+	if cfg.MaxConcurrentReconciles != nil {
+		add(TuningMaxConcurrentReconciles, strconv.Itoa(*cfg.MaxConcurrentReconciles))
+	}
+	if cfg.Resync != nil {
+		add(TuningResync, cfg.Resync.Duration.String())
+	}
+	if cfg.ReconcileTimeout != nil {
+		add(TuningReconcileTimeout, cfg.ReconcileTimeout.Duration.String())
+	}
+	if rateLimiter := cfg.RateLimiter; rateLimiter != nil {
+		if rateLimiter.BaseDelay != nil {
+			add(TuningRateLimiterBaseDelay, rateLimiter.BaseDelay.Duration.String())
+		}
+		if rateLimiter.MaxDelay != nil {
+			add(TuningRateLimiterMaxDelay, rateLimiter.MaxDelay.Duration.String())
+		}
+		if rateLimiter.QPS != nil {
+			add(TuningRateLimiterQPS, strconv.FormatFloat(float64(*rateLimiter.QPS), 'f', -1, 32))
+		}
+		if rateLimiter.Burst != nil {
+			add(TuningRateLimiterBurst, strconv.Itoa(*rateLimiter.Burst))
+		}
+	}
+
+	return values
+}
+
+func applyWebhooksConfigToFlags(cfg *config.WebhooksConfiguration, fs *pflag.FlagSet) error {
+	values := map[string]string{
+		webhookcmd.DisableFlag:    strings.Join(cfg.Disabled, ","),
+		webhookcmd.ReportOnlyFlag: strings.Join(cfg.ReportOnly, ","),
+	}
+
+	if server := cfg.Server; server != nil {
+		values[webhookcmd.ModeFlag] = server.Mode
+		values[webhookcmd.URLFlag] = server.URL
+		values[webhookcmd.NamespaceFlag] = server.Namespace
+		values[webhookcmd.CertDirFlag] = server.CertDir
+		values[WebhookServerHostFlag] = server.Host
+		if server.Port != nil {
+			values[WebhookServerPortFlag] = strconv.Itoa(*server.Port)
+		}
+	}
+
+	for name, value := range values {
+		if err := setFlagDefault(fs, name, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setFlagDefault sets the given value for the flag with the given name unless the value is empty, the flag is not
+// registered or it has been set explicitly on the command line.
+func setFlagDefault(fs *pflag.FlagSet, name, value string) error {
+	if len(value) == 0 {
+		return nil
+	}
+
+	flag := fs.Lookup(name)
+	if flag == nil || flag.Changed {
+		return nil
+	}
+
+	if err := flag.Value.Set(value); err != nil {
+		return fmt.Errorf("could not apply value %q of manager config file to flag %q: %v", value, name, err)
+	}
+	return nil
+}
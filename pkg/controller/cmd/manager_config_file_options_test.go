@@ -0,0 +1,186 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"time"
+
+	webhookcmd "github.com/gardener/gardener-extensions/pkg/webhook/cmd"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+)
+
+var _ = Describe("ManagerConfigFileOptions", func() {
+	var (
+		configFile *os.File
+
+		configFileOpts   *ManagerConfigFileOptions
+		mgrOpts          *ManagerOptions
+		reconcileOpts    *ReconcilerOptions
+		switchOpts       *SwitchOptions
+		controlPlaneOpts *ControllerOptions
+		workerOpts       *ControllerOptions
+		serverOpts       *webhookcmd.ServerOptions
+		aggOption        OptionAggregator
+		fs               *pflag.FlagSet
+	)
+
+	writeConfig := func(data string) {
+		_, err := configFile.WriteString(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configFile.Close()).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		configFile, err = ioutil.TempFile("", "manager-config")
+		Expect(err).NotTo(HaveOccurred())
+
+		configFileOpts = &ManagerConfigFileOptions{}
+		mgrOpts = &ManagerOptions{LeaderElection: true}
+		reconcileOpts = &ReconcilerOptions{}
+		switchOpts = NewSwitchOptions(
+			Switch("controlplane", nil),
+			Switch("worker", nil),
+		)
+		controlPlaneOpts = &ControllerOptions{MaxConcurrentReconciles: 5}
+		workerOpts = &ControllerOptions{MaxConcurrentReconciles: 5}
+		serverOpts = &webhookcmd.ServerOptions{}
+		aggOption = NewOptionAggregator(
+			configFileOpts,
+			mgrOpts,
+			PrefixOption("controlplane-", controlPlaneOpts),
+			PrefixOption("worker-", workerOpts),
+			reconcileOpts,
+			switchOpts,
+			serverOpts,
+		)
+
+		fs = pflag.NewFlagSet("test", pflag.ContinueOnError)
+		aggOption.AddFlags(fs)
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(configFile.Name())).To(Succeed())
+	})
+
+	It("should keep the flag defaults if no config file is given", func() {
+		Expect(fs.Parse(nil)).To(Succeed())
+		Expect(aggOption.Complete()).To(Succeed())
+
+		Expect(configFileOpts.Completed().Config).NotTo(BeNil())
+		Expect(mgrOpts.LeaderElection).To(BeTrue())
+		Expect(controlPlaneOpts.MaxConcurrentReconciles).To(Equal(5))
+	})
+
+	It("should apply the defaulted config file values to the flags", func() {
+		writeConfig(`apiVersion: extensions.config.gardener.cloud/v1alpha1
+kind: ControllerManagerConfiguration
+leaderElection:
+  id: foo
+  namespace: bar
+syncPeriod: 1h
+controllers:
+  maxConcurrentReconciles: 10
+  ignoreOperationAnnotation: true
+  disabled:
+  - worker
+  controllers:
+    controlplane:
+      maxConcurrentReconciles: 2
+      resync: 30m
+      rateLimiter:
+        qps: 0.5
+webhooks:
+  server:
+    namespace: garden
+    certDir: /tmp/certs
+`)
+
+		Expect(fs.Parse([]string{"--" + ManagerConfigFileFlag, configFile.Name()})).To(Succeed())
+		Expect(aggOption.Complete()).To(Succeed())
+
+		Expect(mgrOpts.Completed()).To(Equal(&ManagerConfig{
+			LeaderElection:          true,
+			LeaderElectionID:        "foo",
+			LeaderElectionNamespace: "bar",
+			WebhookServerPort:       443,
+			SyncPeriod:              time.Hour,
+		}))
+		Expect(controlPlaneOpts.MaxConcurrentReconciles).To(Equal(10))
+		Expect(workerOpts.MaxConcurrentReconciles).To(Equal(10))
+		Expect(reconcileOpts.IgnoreOperationAnnotation).To(BeTrue())
+		Expect(switchOpts.Disabled).To(ConsistOf("worker"))
+		Expect(switchOpts.Tuning).To(Equal(map[string]string{
+			"controlplane.max-concurrent-reconciles": "2",
+			"controlplane.resync":                    "30m0s",
+			"controlplane.rate-limiter-qps":          "0.5",
+		}))
+		Expect(serverOpts.Completed()).To(Equal(&webhookcmd.ServerConfig{
+			Mode:      "service",
+			Namespace: "garden",
+			CertDir:   "/tmp/certs",
+		}))
+	})
+
+	It("should not override flags that are set on the command line", func() {
+		writeConfig(`apiVersion: extensions.config.gardener.cloud/v1alpha1
+kind: ControllerManagerConfiguration
+leaderElection:
+  leaderElect: false
+controllers:
+  maxConcurrentReconciles: 10
+`)
+
+		Expect(fs.Parse([]string{
+			"--" + ManagerConfigFileFlag, configFile.Name(),
+			"--" + LeaderElectionFlag + "=true",
+			"--worker-" + MaxConcurrentReconcilesFlag, "3",
+		})).To(Succeed())
+		Expect(aggOption.Complete()).To(Succeed())
+
+		Expect(mgrOpts.LeaderElection).To(BeTrue())
+		Expect(controlPlaneOpts.MaxConcurrentReconciles).To(Equal(10))
+		Expect(workerOpts.MaxConcurrentReconciles).To(Equal(3))
+	})
+
+	It("should fail for unknown controllers", func() {
+		writeConfig(`apiVersion: extensions.config.gardener.cloud/v1alpha1
+kind: ControllerManagerConfiguration
+controllers:
+  controllers:
+    infrastructure:
+      maxConcurrentReconciles: 2
+`)
+
+		Expect(fs.Parse([]string{"--" + ManagerConfigFileFlag, configFile.Name()})).To(Succeed())
+		Expect(aggOption.Complete()).To(MatchError(ContainSubstring(`cannot tune unknown controller "infrastructure"`)))
+	})
+
+	It("should fail for invalid configurations", func() {
+		writeConfig(`apiVersion: extensions.config.gardener.cloud/v1alpha1
+kind: ControllerManagerConfiguration
+controllers:
+  maxConcurrentReconciles: 0
+`)
+
+		Expect(fs.Parse([]string{"--" + ManagerConfigFileFlag, configFile.Name()})).To(Succeed())
+		Expect(aggOption.Complete()).To(MatchError(ContainSubstring("controllers.maxConcurrentReconciles")))
+	})
+})
@@ -17,6 +17,8 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/spf13/pflag"
@@ -38,6 +40,12 @@ const (
 	WebhookServerHostFlag = "webhook-config-server-host"
 	// WebhookServerPortFlag is the name of the command line flag to specify the webhook server port.
 	WebhookServerPortFlag = "webhook-config-server-port"
+	// SyncPeriodFlag is the name of the command line flag to specify the minimum interval in which all watched
+	// resources are reconciled.
+	SyncPeriodFlag = "sync-period"
+	// MetricsBindAddressFlag is the name of the command line flag to specify the address the metrics endpoint
+	// binds to.
+	MetricsBindAddressFlag = "metrics-bind-address"
 
 	// MaxConcurrentReconcilesFlag is the name of the command line flag to specify the maximum number of
 	// concurrent reconciliations a controller can do.
@@ -151,6 +159,11 @@ type ManagerOptions struct {
 	WebhookServerHost string
 	// WebhookServerPort is the port for the webhook server.
 	WebhookServerPort int
+	// SyncPeriod is the minimum interval in which all watched resources are reconciled.
+	SyncPeriod time.Duration
+	// MetricsBindAddress is the address the metrics endpoint binds to. If empty, the default address of the
+	// controller-runtime manager (':8080') is used. The metrics endpoint is disabled with '0'.
+	MetricsBindAddress string
 
 	config *ManagerConfig
 }
@@ -162,11 +175,13 @@ func (m *ManagerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&m.LeaderElectionNamespace, LeaderElectionNamespaceFlag, m.LeaderElectionNamespace, "The namespace to do leader election in.")
 	fs.StringVar(&m.WebhookServerHost, WebhookServerHostFlag, m.WebhookServerHost, "The webhook server host.")
 	fs.IntVar(&m.WebhookServerPort, WebhookServerPortFlag, m.WebhookServerPort, "The webhook server port.")
+	fs.DurationVar(&m.SyncPeriod, SyncPeriodFlag, m.SyncPeriod, "The minimum interval in which all watched resources are reconciled.")
+	fs.StringVar(&m.MetricsBindAddress, MetricsBindAddressFlag, m.MetricsBindAddress, "The address the metrics endpoint binds to. If empty, ':8080' is used. Set it to '0' to disable the metrics endpoint.")
 }
 
 // Complete implements Completer.Complete.
 func (m *ManagerOptions) Complete() error {
-	m.config = &ManagerConfig{m.LeaderElection, m.LeaderElectionID, m.LeaderElectionNamespace, m.WebhookServerHost, m.WebhookServerPort}
+	m.config = &ManagerConfig{m.LeaderElection, m.LeaderElectionID, m.LeaderElectionNamespace, m.WebhookServerHost, m.WebhookServerPort, m.SyncPeriod, m.MetricsBindAddress}
 	return nil
 }
 
@@ -187,6 +202,11 @@ type ManagerConfig struct {
 	WebhookServerHost string
 	// WebhookServerPort is the port for the webhook server.
 	WebhookServerPort int
+	// SyncPeriod is the minimum interval in which all watched resources are reconciled.
+	SyncPeriod time.Duration
+	// MetricsBindAddress is the address the metrics endpoint binds to. If empty, the default address of the
+	// controller-runtime manager (':8080') is used. The metrics endpoint is disabled with '0'.
+	MetricsBindAddress string
 }
 
 // Apply sets the values of this ManagerConfig in the given manager.Options.
@@ -196,6 +216,13 @@ func (c *ManagerConfig) Apply(opts *manager.Options) {
 	opts.LeaderElectionNamespace = c.LeaderElectionNamespace
 	opts.Host = c.WebhookServerHost
 	opts.Port = c.WebhookServerPort
+	if c.SyncPeriod != 0 {
+		syncPeriod := c.SyncPeriod
+		opts.SyncPeriod = &syncPeriod
+	}
+	if c.MetricsBindAddress != "" {
+		opts.MetricsBindAddress = c.MetricsBindAddress
+	}
 }
 
 // Options initializes empty manager.Options, applies the set values and returns it.
@@ -313,9 +340,11 @@ func (r *RESTOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&r.MasterURL, MasterURLFlag, "", "The address of the Kubernetes API server. Overrides any value in kubeconfig. Only required if out-of-cluster.")
 }
 
-// SwitchOptions are options to build an AddToManager function that filters the disabled controllers.
+// SwitchOptions are options to build an AddToManager function that filters the disabled controllers and applies
+// the tuning of the individual controllers.
 type SwitchOptions struct {
 	Disabled []string
+	Tuning   map[string]string
 
 	nameToAddToManager  map[string]func(manager.Manager) error
 	addToManagerBuilder extensionscontroller.AddToManagerBuilder
@@ -352,6 +381,15 @@ func NewSwitchOptions(pairs ...NameToAddToManagerFunc) *SwitchOptions {
 // AddFlags implements Option.
 func (d *SwitchOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringSliceVar(&d.Disabled, DisableFlag, d.Disabled, "List of controllers to disable")
+	fs.StringToStringVar(&d.Tuning, ControllerTuningFlag, d.Tuning, fmt.Sprintf("Tuning of individual controllers as <controller>.<option>=<value> pairs. Options are %s.", strings.Join([]string{
+		TuningMaxConcurrentReconciles,
+		TuningResync,
+		TuningRateLimiterBaseDelay,
+		TuningRateLimiterMaxDelay,
+		TuningRateLimiterQPS,
+		TuningRateLimiterBurst,
+		TuningReconcileTimeout,
+	}, ", ")))
 }
 
 // Complete implements Option.
@@ -364,14 +402,35 @@ func (d *SwitchOptions) Complete() error {
 		disabled.Insert(disabledName)
 	}
 
+	tunings, err := ParseTuning(d.Tuning)
+	if err != nil {
+		return err
+	}
+	for name := range tunings {
+		if _, ok := d.nameToAddToManager[name]; !ok {
+			return fmt.Errorf("cannot tune unknown controller %q", name)
+		}
+	}
+
 	for name, addToManager := range d.nameToAddToManager {
-		if !disabled.Has(name) {
-			d.addToManagerBuilder.Register(addToManager)
+		if disabled.Has(name) {
+			continue
+		}
+
+		if tuning, ok := tunings[name]; ok {
+			addToManager = tunedAddToManager(addToManager, tuning)
 		}
+		d.addToManagerBuilder.Register(addToManager)
 	}
 	return nil
 }
 
+func tunedAddToManager(addToManager func(manager.Manager) error, tuning extensionscontroller.Tuning) func(manager.Manager) error {
+	return func(mgr manager.Manager) error {
+		return addToManager(extensionscontroller.WithTuning(mgr, tuning))
+	}
+}
+
 // Completed returns the completed SwitchConfig. Call this only after successfully calling `Completed`.
 func (d *SwitchOptions) Completed() *SwitchConfig {
 	return &SwitchConfig{d.addToManagerBuilder.AddToManager}
@@ -17,7 +17,10 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"time"
 
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	mockcontroller "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/controller"
 	mockcmd "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/controller/cmd"
 	"github.com/gardener/gardener-extensions/pkg/util/test"
@@ -26,6 +29,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"github.com/spf13/pflag"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -310,6 +314,83 @@ var _ = Describe("Options", func() {
 		})
 	})
 
+	Context("TerraformerOptions", func() {
+		const (
+			name      = "foo"
+			binary    = "/bin/terraform"
+			pluginDir = "/terraform-plugins"
+		)
+		command := test.NewCommandBuilder(name).
+			Flags(
+				test.StringFlag(TerraformerModeFlag, TerraformerModeLocal),
+				test.StringFlag(TerraformerBinaryFlag, binary),
+				test.StringFlag(TerraformerPluginDirFlag, pluginDir),
+			).
+			Command().
+			Slice()
+
+		Describe("#AddFlags", func() {
+			It("should add all flags", func() {
+				fs := pflag.NewFlagSet(name, pflag.ExitOnError)
+				opts := TerraformerOptions{}
+
+				opts.AddFlags(fs)
+
+				Expect(fs.Parse(command)).NotTo(HaveOccurred())
+				Expect(opts).To(Equal(TerraformerOptions{
+					Mode:      TerraformerModeLocal,
+					Binary:    binary,
+					PluginDir: pluginDir,
+				}))
+			})
+
+			It("should default to the pod mode", func() {
+				fs := pflag.NewFlagSet(name, pflag.ExitOnError)
+				opts := TerraformerOptions{}
+
+				opts.AddFlags(fs)
+
+				Expect(fs.Parse(nil)).NotTo(HaveOccurred())
+				Expect(opts.Mode).To(Equal(TerraformerModePod))
+			})
+		})
+
+		Describe("#Complete", func() {
+			It("should fail for an unknown mode", func() {
+				opts := TerraformerOptions{Mode: "foo"}
+
+				Expect(opts.Complete()).To(HaveOccurred())
+			})
+		})
+
+		Describe("#Completed", func() {
+			It("should yield the local factory in the local mode", func() {
+				fs := pflag.NewFlagSet(name, pflag.ExitOnError)
+				opts := TerraformerOptions{}
+
+				opts.AddFlags(fs)
+
+				Expect(fs.Parse(command)).NotTo(HaveOccurred())
+				Expect(opts.Complete()).NotTo(HaveOccurred())
+				Expect(opts.Completed()).To(Equal(&TerraformerConfig{
+					Factory: extensionsterraformer.NewLocalFactory(extensionsterraformer.LocalOptions{
+						Binary:    binary,
+						PluginDir: pluginDir,
+					}),
+				}))
+			})
+
+			It("should yield the default factory in the pod mode", func() {
+				opts := TerraformerOptions{Mode: TerraformerModePod}
+
+				Expect(opts.Complete()).NotTo(HaveOccurred())
+				Expect(opts.Completed()).To(Equal(&TerraformerConfig{
+					Factory: extensionsterraformer.DefaultFactory(),
+				}))
+			})
+		})
+	})
+
 	Context("RESTOptions", func() {
 		const (
 			name       = "foo"
@@ -519,6 +600,33 @@ var _ = Describe("Options", func() {
 					LeaderElectionNamespace: leaderElectionNamespace,
 				}))
 			})
+
+			It("should apply the sync period to the given manager.Options if it is set", func() {
+				cfg := &ManagerConfig{SyncPeriod: time.Hour}
+
+				opts := manager.Options{}
+				cfg.Apply(&opts)
+
+				Expect(opts.SyncPeriod).To(PointTo(Equal(time.Hour)))
+			})
+
+			It("should apply the metrics bind address to the given manager.Options if it is set", func() {
+				cfg := &ManagerConfig{MetricsBindAddress: ":8080"}
+
+				opts := manager.Options{}
+				cfg.Apply(&opts)
+
+				Expect(opts.MetricsBindAddress).To(Equal(":8080"))
+			})
+
+			It("should keep the default metrics bind address of the given manager.Options if it is not set", func() {
+				cfg := &ManagerConfig{}
+
+				opts := manager.Options{MetricsBindAddress: "0"}
+				cfg.Apply(&opts)
+
+				Expect(opts.MetricsBindAddress).To(Equal("0"))
+			})
 		})
 
 		Describe("#Options", func() {
@@ -619,6 +727,43 @@ var _ = Describe("Options", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(switches.Complete()).To(HaveOccurred())
 			})
+
+			It("should correctly parse the controller tuning", func() {
+				switches := NewSwitchOptions(Switch("foo", nil))
+
+				fs := pflag.NewFlagSet(commandName, pflag.ContinueOnError)
+				switches.AddFlags(fs)
+
+				err := fs.Parse(test.NewCommandBuilder(commandName).
+					Flags(
+						test.StringFlag(ControllerTuningFlag, "foo.resync=10m,foo.rate-limiter-qps=2.5"),
+					).
+					Command().
+					Slice())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(switches.Complete()).To(Succeed())
+
+				Expect(switches.Tuning).To(Equal(map[string]string{
+					"foo.resync":           "10m",
+					"foo.rate-limiter-qps": "2.5",
+				}))
+			})
+
+			DescribeTable("should error on an invalid controller tuning",
+				func(tuning map[string]string, errMsg string) {
+					switches := NewSwitchOptions(Switch("foo", nil))
+					switches.Tuning = tuning
+
+					Expect(switches.Complete()).To(MatchError(ContainSubstring(errMsg)))
+				},
+				Entry("unknown controller", map[string]string{"bar.resync": "1m"}, `cannot tune unknown controller "bar"`),
+				Entry("missing option", map[string]string{"foo": "1m"}, "expected <controller>.<option>"),
+				Entry("unknown option", map[string]string{"foo.bar": "1"}, `unknown option "bar"`),
+				Entry("invalid duration", map[string]string{"foo.reconcile-timeout": "1"}, "reconcile-timeout"),
+				Entry("non-positive concurrency", map[string]string{"foo.max-concurrent-reconciles": "0"}, "must be at least 1"),
+				Entry("non-positive qps", map[string]string{"foo.rate-limiter-qps": "-1"}, "must be greater than 0"),
+			)
 		})
 
 		Describe("#AddToManager", func() {
@@ -643,6 +788,40 @@ var _ = Describe("Options", func() {
 				Expect(switches.Complete()).To(Succeed())
 				Expect(switches.Completed().AddToManager(nil)).To(Succeed())
 			})
+
+			It("should add the tuned controllers with their tuning", func() {
+				var (
+					f1 = mockcontroller.NewMockAddToManager(ctrl)
+					f2 = mockcontroller.NewMockAddToManager(ctrl)
+
+					name1 = "name1"
+					name2 = "name2"
+
+					switches = NewSwitchOptions(
+						Switch(name1, f1.Do),
+						Switch(name2, f2.Do),
+					)
+				)
+
+				f1.EXPECT().Do(gomock.Any()).DoAndReturn(func(mgr manager.Manager) error {
+					tuning, ok := extensionscontroller.TuningFrom(mgr)
+					Expect(ok).To(BeTrue())
+					Expect(tuning).To(Equal(extensionscontroller.Tuning{
+						MaxConcurrentReconciles: 3,
+						Resync:                  time.Hour,
+					}))
+					return nil
+				})
+				f2.EXPECT().Do(nil)
+
+				switches.Tuning = map[string]string{
+					TuningKey(name1, TuningMaxConcurrentReconciles): "3",
+					TuningKey(name1, TuningResync):                  "1h",
+				}
+
+				Expect(switches.Complete()).To(Succeed())
+				Expect(switches.Completed().AddToManager(nil)).To(Succeed())
+			})
 		})
 	})
 })
@@ -22,12 +22,17 @@ const (
 	// IgnoreOperationAnnotationFlag is the name of the command line flag to specify whether the operation annotation
 	// is ignored or not.
 	IgnoreOperationAnnotationFlag = "ignore-operation-annotation"
+	// DryRunFlag is the name of the command line flag to specify whether resources are only dry-run instead of
+	// being reconciled.
+	DryRunFlag = "dry-run"
 )
 
 // ReconcilerOptions are command line options that can be set for controller.Options.
 type ReconcilerOptions struct {
 	// IgnoreOperationAnnotation defines whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// DryRun defines whether resources are only dry-run instead of being reconciled.
+	DryRun bool
 
 	config *ReconcilerConfig
 }
@@ -35,11 +40,12 @@ type ReconcilerOptions struct {
 // AddFlags implements Flagger.AddFlags.
 func (c *ReconcilerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&c.IgnoreOperationAnnotation, IgnoreOperationAnnotationFlag, c.IgnoreOperationAnnotation, "Ignore the operation annotation or not.")
+	fs.BoolVar(&c.DryRun, DryRunFlag, c.DryRun, "Only compute the changes a reconciliation would make and record them in the status of the resources, without applying them.")
 }
 
 // Complete implements Completer.Complete.
 func (c *ReconcilerOptions) Complete() error {
-	c.config = &ReconcilerConfig{c.IgnoreOperationAnnotation}
+	c.config = &ReconcilerConfig{c.IgnoreOperationAnnotation, c.DryRun}
 	return nil
 }
 
@@ -52,9 +58,16 @@ func (c *ReconcilerOptions) Completed() *ReconcilerConfig {
 type ReconcilerConfig struct {
 	// IgnoreOperationAnnotation defines whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// DryRun defines whether resources are only dry-run instead of being reconciled.
+	DryRun bool
 }
 
 // Apply sets the values of this ReconcilerConfig in the given controller.Options.
 func (c *ReconcilerConfig) Apply(ignore *bool) {
 	*ignore = c.IgnoreOperationAnnotation
 }
+
+// ApplyDryRun sets the dry-run mode of this ReconcilerConfig in the given controller options.
+func (c *ReconcilerConfig) ApplyDryRun(dryRun *bool) {
+	*dryRun = c.DryRun
+}
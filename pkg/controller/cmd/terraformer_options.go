@@ -0,0 +1,95 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	// TerraformerModeFlag is the name of the command line flag to specify how Terraform is executed.
+	TerraformerModeFlag = "terraformer-mode"
+	// TerraformerBinaryFlag is the name of the command line flag to specify the Terraform binary of the local mode.
+	TerraformerBinaryFlag = "terraformer-binary"
+	// TerraformerPluginDirFlag is the name of the command line flag to specify the directory containing the
+	// Terraform provider plugins of the local mode.
+	TerraformerPluginDirFlag = "terraformer-plugin-dir"
+
+	// TerraformerModePod executes Terraform in terraformer pods in the seed.
+	TerraformerModePod = "pod"
+	// TerraformerModeLocal executes Terraform as a local subprocess of the controller.
+	TerraformerModeLocal = "local"
+)
+
+// TerraformerOptions are command line options for the execution of Terraform.
+type TerraformerOptions struct {
+	// Mode is the mode in which Terraform is executed, either TerraformerModePod or TerraformerModeLocal.
+	Mode string
+	// Binary is the Terraform binary of the local mode.
+	Binary string
+	// PluginDir is the directory containing the Terraform provider plugins of the local mode.
+	PluginDir string
+
+	config *TerraformerConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (t *TerraformerOptions) AddFlags(fs *pflag.FlagSet) {
+	if t.Mode == "" {
+		t.Mode = TerraformerModePod
+	}
+	fs.StringVar(&t.Mode, TerraformerModeFlag, t.Mode, fmt.Sprintf("The mode in which Terraform is executed, either '%s' (terraformer pods in the seed) or '%s' (subprocess of the controller).", TerraformerModePod, TerraformerModeLocal))
+	fs.StringVar(&t.Binary, TerraformerBinaryFlag, t.Binary, fmt.Sprintf("The Terraform binary of the '%s' mode. Defaults to '%s' in the PATH.", TerraformerModeLocal, extensionsterraformer.DefaultTerraformBinary))
+	fs.StringVar(&t.PluginDir, TerraformerPluginDirFlag, t.PluginDir, fmt.Sprintf("The directory containing the Terraform provider plugins of the '%s' mode. If empty, Terraform downloads them.", TerraformerModeLocal))
+}
+
+// Complete implements Completer.Complete.
+func (t *TerraformerOptions) Complete() error {
+	var factory extensionsterraformer.Factory
+	switch t.Mode {
+	case TerraformerModePod, "":
+		factory = extensionsterraformer.DefaultFactory()
+	case TerraformerModeLocal:
+		factory = extensionsterraformer.NewLocalFactory(extensionsterraformer.LocalOptions{
+			Binary:    t.Binary,
+			PluginDir: t.PluginDir,
+		})
+	default:
+		return fmt.Errorf("invalid terraformer mode %q, must be either '%s' or '%s'", t.Mode, TerraformerModePod, TerraformerModeLocal)
+	}
+
+	t.config = &TerraformerConfig{factory}
+	return nil
+}
+
+// Completed returns the completed TerraformerConfig. Only call this if `Complete` was successful.
+func (t *TerraformerOptions) Completed() *TerraformerConfig {
+	return t.config
+}
+
+// TerraformerConfig is a completed Terraform execution configuration.
+type TerraformerConfig struct {
+	// Factory is the factory for the Terraformers of the configured mode.
+	Factory extensionsterraformer.Factory
+}
+
+// Apply sets the factory of this TerraformerConfig in the given factory.
+func (t *TerraformerConfig) Apply(factory *extensionsterraformer.Factory) {
+	*factory = t.Factory
+}
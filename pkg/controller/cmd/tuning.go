@@ -0,0 +1,126 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+)
+
+const (
+	// ControllerTuningFlag is the name of the command line flag to tune individual controllers. Its value is a list
+	// of `<controller>.<option>=<value>` pairs, e.g. `infrastructure_controller.resync=30m`.
+	ControllerTuningFlag = "controller-tuning"
+
+	// TuningMaxConcurrentReconciles is the tuning option for the maximum number of concurrent reconciliations.
+	TuningMaxConcurrentReconciles = "max-concurrent-reconciles"
+	// TuningResync is the tuning option for the interval after which successfully reconciled objects are
+	// reconciled again.
+	TuningResync = "resync"
+	// TuningRateLimiterBaseDelay is the tuning option for the base delay of the failure backoff.
+	TuningRateLimiterBaseDelay = "rate-limiter-base-delay"
+	// TuningRateLimiterMaxDelay is the tuning option for the maximum delay of the failure backoff.
+	TuningRateLimiterMaxDelay = "rate-limiter-max-delay"
+	// TuningRateLimiterQPS is the tuning option for the overall number of reconciliations per second.
+	TuningRateLimiterQPS = "rate-limiter-qps"
+	// TuningRateLimiterBurst is the tuning option for the maximum number of reconciliations started at once.
+	TuningRateLimiterBurst = "rate-limiter-burst"
+	// TuningReconcileTimeout is the tuning option for the duration after which a single reconciliation is
+	// considered failed.
+	TuningReconcileTimeout = "reconcile-timeout"
+)
+
+// TuningKey returns the key of the given option of the given controller in the ControllerTuningFlag.
+func TuningKey(controllerName, option string) string {
+	return fmt.Sprintf("%s.%s", controllerName, option)
+}
+
+// ParseTuning parses the given `<controller>.<option>=<value>` pairs into the Tunings of the respective controllers.
+func ParseTuning(values map[string]string) (map[string]extensionscontroller.Tuning, error) {
+	tunings := make(map[string]extensionscontroller.Tuning)
+
+	for key, value := range values {
+		idx := strings.LastIndex(key, ".")
+		if idx <= 0 || idx == len(key)-1 {
+			return nil, fmt.Errorf("invalid controller tuning key %q, expected <controller>.<option>", key)
+		}
+
+		name, option := key[:idx], key[idx+1:]
+		tuning := tunings[name]
+		if err := setTuningOption(&tuning, option, value); err != nil {
+			return nil, fmt.Errorf("invalid tuning of controller %q: %v", name, err)
+		}
+		tunings[name] = tuning
+	}
+
+	return tunings, nil
+}
+
+func setTuningOption(tuning *extensionscontroller.Tuning, option, value string) error {
+	var err error
+	switch option {
+	case TuningMaxConcurrentReconciles:
+		tuning.MaxConcurrentReconciles, err = parsePositiveInt(value)
+	case TuningResync:
+		tuning.Resync, err = parsePositiveDuration(value)
+	case TuningRateLimiterBaseDelay:
+		tuning.RateLimiter.BaseDelay, err = parsePositiveDuration(value)
+	case TuningRateLimiterMaxDelay:
+		tuning.RateLimiter.MaxDelay, err = parsePositiveDuration(value)
+	case TuningRateLimiterQPS:
+		var qps float64
+		if qps, err = strconv.ParseFloat(value, 32); err == nil && qps <= 0 {
+			err = fmt.Errorf("must be greater than 0")
+		}
+		tuning.RateLimiter.QPS = float32(qps)
+	case TuningRateLimiterBurst:
+		tuning.RateLimiter.Burst, err = parsePositiveInt(value)
+	case TuningReconcileTimeout:
+		tuning.ReconcileTimeout, err = parsePositiveDuration(value)
+	default:
+		return fmt.Errorf("unknown option %q", option)
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid value %q of option %q: %v", value, option, err)
+	}
+	return nil
+}
+
+func parsePositiveInt(value string) (int, error) {
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if i < 1 {
+		return 0, fmt.Errorf("must be at least 1")
+	}
+	return i, nil
+}
+
+func parsePositiveDuration(value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("must be greater than 0")
+	}
+	return d, nil
+}
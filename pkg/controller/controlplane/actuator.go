@@ -28,4 +28,15 @@ type Actuator interface {
 	Reconcile(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) (bool, error)
 	// Delete deletes the ControlPlane.
 	Delete(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) error
+	// Migrate releases all seed-local resources of the ControlPlane while keeping the objects in the shoot.
+	Migrate(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) error
+	// Restore rebuilds the seed-local resources of the ControlPlane after a migration.
+	Restore(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) (bool, error)
+}
+
+// DryRunner is implemented by Actuators that are able to compute the changes a reconciliation of a ControlPlane
+// would make, without changing anything in the seed or the shoot.
+type DryRunner interface {
+	// DryRun computes the changes a reconciliation of the ControlPlane would make.
+	DryRun(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) (*extensionscontroller.DryRunResult, error)
 }
@@ -15,9 +15,11 @@
 package controlplane
 
 import (
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -31,6 +33,13 @@ const (
 	FinalizerName = "extensions.gardener.cloud/controlplane"
 	// ControllerName is the name of the controller
 	ControllerName = "controlplane_controller"
+
+	// ConditionTypeShootWebhooksHealthy is the type of the condition that describes whether the endpoints of the
+	// shoot webhooks of a controlplane are reachable.
+	ConditionTypeShootWebhooksHealthy gardencorev1alpha1.ConditionType = "ShootWebhooksHealthy"
+	// ConditionTypeControlPlaneHealthy is the type of the condition that describes whether the additional control
+	// plane components of a controlplane have been deployed successfully.
+	ConditionTypeControlPlaneHealthy gardencorev1alpha1.ConditionType = "ControlPlaneHealthy"
 )
 
 // AddArgs are arguments for adding an controlplane controller to a manager.
@@ -44,6 +53,8 @@ type AddArgs struct {
 	// Predicates are the predicates to use.
 	// If unset, GenerationChanged will be used.
 	Predicates []predicate.Predicate
+	// DryRun specifies whether all ControlPlane resources are only dry-run instead of being reconciled.
+	DryRun bool
 }
 
 // DefaultPredicates returns the default predicates for a controlplane reconciler.
@@ -73,9 +84,9 @@ func DefaultPredicates(typeName string, ignoreOperationAnnotation bool) []predic
 // Add creates a new ControlPlane Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, args.DryRun)
 
-	ctrl, err := controller.New(ControllerName, mgr, args.ControllerOptions)
+	ctrl, err := extensionscontroller.NewController(ControllerName, mgr, &extensionsv1alpha1.ControlPlane{}, args.ControllerOptions)
 	if err != nil {
 		return err
 	}
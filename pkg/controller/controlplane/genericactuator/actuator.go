@@ -15,19 +15,18 @@
 package genericactuator
 
 import (
-	"bytes"
 	"context"
-	"fmt"
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionswebhookshoot "github.com/gardener/gardener-extensions/pkg/webhook/shoot"
-	"github.com/gardener/gardener-resource-manager/pkg/manager"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
 	gardenerkubernetes "github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/go-logr/logr"
@@ -36,7 +35,6 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -68,7 +66,7 @@ func NewActuator(
 	chartRendererFactory extensionscontroller.ChartRendererFactory,
 	imageVector imagevector.ImageVector,
 	configName string,
-	shootWebhooks []admissionregistrationv1beta1.Webhook,
+	shootWebhooks func() []admissionregistrationv1beta1.Webhook,
 	webhookServerPort int,
 	logger logr.Logger,
 ) controlplane.Actuator {
@@ -105,12 +103,13 @@ type actuator struct {
 	chartRendererFactory      extensionscontroller.ChartRendererFactory
 	imageVector               imagevector.ImageVector
 	configName                string
-	shootWebhooks             []admissionregistrationv1beta1.Webhook
+	shootWebhooks             func() []admissionregistrationv1beta1.Webhook
 	webhookServerPort         int
 
 	clientset         kubernetes.Interface
 	gardenerClientset gardenerkubernetes.Interface
 	chartApplier      gardenerkubernetes.ChartApplier
+	chartRenderer     chartrenderer.Interface
 	client            client.Client
 	logger            logr.Logger
 }
@@ -141,6 +140,12 @@ func (a *actuator) InjectConfig(config *rest.Config) error {
 		return errors.Wrap(err, "could not create chart applier")
 	}
 
+	// Create chart renderer
+	a.chartRenderer, err = chartrenderer.NewForConfig(config)
+	if err != nil {
+		return errors.Wrap(err, "could not create chart renderer")
+	}
+
 	return nil
 }
 
@@ -150,6 +155,13 @@ func (a *actuator) InjectClient(client client.Client) error {
 	return nil
 }
 
+var (
+	// checkShootWebhookEndpoints checks whether the endpoints of the given shoot webhooks are reachable.
+	checkShootWebhookEndpoints = extensionswebhookshoot.CheckWebhookEndpoints
+	// checkShootWebhooksNetworkPolicy checks whether the kube-apiserver is allowed to reach the shoot webhooks.
+	checkShootWebhooksNetworkPolicy = extensionswebhookshoot.CheckNetworkPolicy
+)
+
 const (
 	controlPlaneShootChartResourceName = "extension-controlplane-shoot"
 	storageClassesChartResourceName    = "extension-controlplane-storageclasses"
@@ -166,7 +178,10 @@ func (a *actuator) Reconcile(
 	if cp.Spec.Purpose != nil && *cp.Spec.Purpose == extensionsv1alpha1.Exposure {
 		return a.reconcileControlPlaneExposure(ctx, cp, cluster)
 	}
-	return a.reconcileControlPlane(ctx, cp, cluster)
+
+	requeue, err := a.reconcileControlPlane(ctx, cp, cluster)
+	a.reportControlPlaneHealth(ctx, cp, err)
+	return requeue, err
 }
 
 func (a *actuator) reconcileControlPlaneExposure(
@@ -182,7 +197,7 @@ func (a *actuator) reconcileControlPlaneExposure(
 	checksums := make(map[string]string)
 	if a.exposureSecrets != nil {
 		a.logger.Info("Deploying control plane exposure secrets", "controlplane", util.ObjectName(cp))
-		deployedSecrets, err := a.exposureSecrets.Deploy(ctx, a.clientset, a.gardenerClientset, cp.Namespace)
+		deployedSecrets, err := a.deploySecrets(ctx, cp, a.exposureSecrets)
 		if err != nil {
 			return false, errors.Wrapf(err, "could not deploy control plane exposure secrets for controlplane '%s'", util.ObjectName(cp))
 		}
@@ -213,37 +228,17 @@ func (a *actuator) reconcileControlPlane(
 	cluster *extensionscontroller.Cluster,
 ) (bool, error) {
 
-	if len(a.shootWebhooks) > 0 {
+	if shootWebhooks := a.getShootWebhooks(); len(shootWebhooks) > 0 {
 		// Deploy shoot webhook configurations
-		if err := extensionswebhookshoot.EnsureNetworkPolicy(ctx, a.client, cp.Namespace, a.providerName, a.webhookServerPort); err != nil {
-			return false, errors.Wrapf(err, "could not create or update network policy for shoot webhooks in namespace '%s'", cp.Namespace)
-		}
-
-		webhookConfiguration, err := marshalWebhooks(a.shootWebhooks, a.providerName)
-		if err != nil {
+		if err := extensionswebhookshoot.ReconcileWebhookConfig(ctx, a.client, cp.Namespace, a.providerName, shootWebhooksResourceName, a.webhookServerPort, shootWebhooks); err != nil {
 			return false, err
 		}
-
-		if err := manager.
-			NewSecret(a.client).
-			WithNamespacedName(cp.Namespace, shootWebhooksResourceName).
-			WithKeyValues(map[string][]byte{"mutatingwebhookconfiguration.yaml": webhookConfiguration}).
-			Reconcile(ctx); err != nil {
-			return false, errors.Wrapf(err, "could not create or update secret '%s/%s' of managed resource containing shoot webhooks", cp.Namespace, shootWebhooksResourceName)
-		}
-
-		if err := manager.
-			NewManagedResource(a.client).
-			WithNamespacedName(cp.Namespace, shootWebhooksResourceName).
-			WithSecretRef(shootWebhooksResourceName).
-			Reconcile(ctx); err != nil {
-			return false, errors.Wrapf(err, "could not create or update managed resource '%s/%s' containing shoot webhooks", cp.Namespace, shootWebhooksResourceName)
-		}
+		a.reportShootWebhooksHealth(ctx, cp, shootWebhooks)
 	}
 
 	// Deploy secrets
 	a.logger.Info("Deploying secrets", "controlplane", util.ObjectName(cp))
-	deployedSecrets, err := a.secrets.Deploy(ctx, a.clientset, a.gardenerClientset, cp.Namespace)
+	deployedSecrets, err := a.deploySecrets(ctx, cp, a.secrets)
 	if err != nil {
 		return false, errors.Wrapf(err, "could not deploy secrets for controlplane '%s'", util.ObjectName(cp))
 	}
@@ -339,6 +334,25 @@ func (a *actuator) reconcileControlPlane(
 	return requeue, nil
 }
 
+// deploySecrets deploys the given secrets. The secrets persisted in the status of the given controlplane are restored
+// before so that they are reused instead of being generated again, e.g. after a migration. The deployed secrets are
+// persisted in the status afterwards.
+func (a *actuator) deploySecrets(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, secrets util.Secrets) (map[string]*corev1.Secret, error) {
+	if err := extensionscontroller.RestoreSecrets(ctx, a.client, cp, cp.Namespace); err != nil {
+		return nil, errors.Wrapf(err, "could not restore secrets")
+	}
+
+	deployedSecrets, err := secrets.Deploy(ctx, a.clientset, a.gardenerClientset, cp.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := extensionscontroller.SaveSecretsState(ctx, a.client, cp, deployedSecrets); err != nil {
+		return nil, errors.Wrapf(err, "could not persist secrets")
+	}
+	return deployedSecrets, nil
+}
+
 // Delete reconciles the given controlplane and cluster, deleting the additional
 // control plane components as needed.
 func (a *actuator) Delete(
@@ -352,6 +366,36 @@ func (a *actuator) Delete(
 	return a.deleteControlPlane(ctx, cp, cluster)
 }
 
+// Migrate reconciles the given controlplane and cluster, releasing the additional control plane components
+// in the seed. The objects deployed into the shoot via managed resources are kept.
+func (a *actuator) Migrate(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) error {
+	if cp.Spec.Purpose != nil && *cp.Spec.Purpose == extensionsv1alpha1.Exposure {
+		return a.deleteControlPlaneExposure(ctx, cp, cluster)
+	}
+
+	for _, name := range []string{storageClassesChartResourceName, controlPlaneShootChartResourceName, shootWebhooksResourceName} {
+		if err := extensionscontroller.SetKeepObjects(ctx, a.client, cp.Namespace, name, true); err != nil {
+			return errors.Wrapf(err, "could not keep objects of managed resource '%s' for controlplane '%s'", name, util.ObjectName(cp))
+		}
+	}
+
+	return a.deleteControlPlane(ctx, cp, cluster)
+}
+
+// Restore reconciles the given controlplane and cluster, recreating the additional control plane components
+// after a migration.
+func (a *actuator) Restore(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (bool, error) {
+	return a.Reconcile(ctx, cp, cluster)
+}
+
 // deleteControlPlaneExposure reconciles the given controlplane and cluster, deleting the additional Seed
 // control plane components as needed.
 func (a *actuator) deleteControlPlaneExposure(
@@ -425,20 +469,9 @@ func (a *actuator) deleteControlPlane(
 		return errors.Wrapf(err, "could not delete secrets for controlplane '%s'", util.ObjectName(cp))
 	}
 
-	if len(a.shootWebhooks) > 0 {
-		networkPolicy := extensionswebhookshoot.GetNetworkPolicyMeta(cp.Namespace, a.providerName)
-		if err := a.client.Delete(ctx, networkPolicy); client.IgnoreNotFound(err) != nil {
-			return errors.Wrapf(err, "could not delete network policy for shoot webhooks in namespace '%s'", cp.Namespace)
-		}
-
-		if err := extensionscontroller.DeleteManagedResource(ctx, a.client, cp.Namespace, shootWebhooksResourceName); err != nil {
-			return errors.Wrapf(err, "could not delete managed resource containing shoot webhooks for controlplane '%s'", util.ObjectName(cp))
-		}
-
-		timeoutCtx3, cancel := context.WithTimeout(ctx, 2*time.Minute)
-		defer cancel()
-		if err := extensionscontroller.WaitUntilManagedResourceDeleted(timeoutCtx3, a.client, cp.Namespace, shootWebhooksResourceName); err != nil {
-			return errors.Wrapf(err, "error while waiting for managed resource containing shoot webhooks for controlplane '%s' to be deleted", util.ObjectName(cp))
+	if len(a.getShootWebhooks()) > 0 {
+		if err := extensionswebhookshoot.DeleteWebhookConfig(ctx, a.client, cp.Namespace, a.providerName, shootWebhooksResourceName); err != nil {
+			return errors.Wrapf(err, "could not delete shoot webhooks for controlplane '%s'", util.ObjectName(cp))
 		}
 	}
 
@@ -477,27 +510,50 @@ func (a *actuator) computeChecksums(
 	return controlplane.ComputeChecksums(csSecrets, csConfigMaps), nil
 }
 
-func marshalWebhooks(webhooks []admissionregistrationv1beta1.Webhook, name string) ([]byte, error) {
+// reportShootWebhooksHealth reports the ShootWebhooksHealthy condition of the controlplane depending on whether the
+// endpoints of the given shoot webhooks are reachable, both from the extension and, according to its network policy,
+// from the kube-apiserver. Failures are only logged as the condition reports are not essential for the reconciliation.
+func (a *actuator) reportShootWebhooksHealth(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, shootWebhooks []admissionregistrationv1beta1.Webhook) {
 	var (
-		buf     = new(bytes.Buffer)
-		encoder = json.NewYAMLSerializer(json.DefaultMetaFactory, nil, nil)
-
-		apiVersion, kind             = admissionregistrationv1beta1.SchemeGroupVersion.WithKind("MutatingWebhookConfiguration").ToAPIVersionAndKind()
-		mutatingWebhookConfiguration = admissionregistrationv1beta1.MutatingWebhookConfiguration{
-			TypeMeta: metav1.TypeMeta{
-				APIVersion: apiVersion,
-				Kind:       kind,
-			},
-			ObjectMeta: metav1.ObjectMeta{
-				Name: fmt.Sprintf("gardener-extension-%s-shoot", name),
-			},
-			Webhooks: webhooks,
-		}
+		status  = gardencorev1alpha1.ConditionTrue
+		reason  = "EndpointsReachable"
+		message = "All shoot webhook endpoints are reachable."
 	)
+	if err := checkShootWebhooksNetworkPolicy(ctx, a.client, cp.Namespace, a.providerName, a.webhookServerPort); err != nil {
+		a.logger.Error(err, "Shoot webhook endpoints are unreachable from the kube-apiserver", "controlplane", util.ObjectName(cp))
+		status, reason, message = gardencorev1alpha1.ConditionFalse, "NetworkPolicyMissing", err.Error()
+	} else if err := checkShootWebhookEndpoints(ctx, shootWebhooks); err != nil {
+		a.logger.Error(err, "Shoot webhook endpoint is unreachable", "controlplane", util.ObjectName(cp))
+		status, reason, message = gardencorev1alpha1.ConditionFalse, "EndpointUnreachable", err.Error()
+	}
 
-	if err := encoder.Encode(&mutatingWebhookConfiguration, buf); err != nil {
-		return nil, err
+	if err := extensionscontroller.ReportCondition(ctx, controlplane.ConditionTypeShootWebhooksHealthy, status, reason, message); err != nil {
+		a.logger.Error(err, "Could not report the shoot webhooks condition", "controlplane", util.ObjectName(cp))
+	}
+}
+
+// reportControlPlaneHealth reports the ControlPlaneHealthy condition of the controlplane depending on the result of
+// its reconciliation.
+func (a *actuator) reportControlPlaneHealth(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, reconcileErr error) {
+	var (
+		status  = gardencorev1alpha1.ConditionTrue
+		reason  = "ControlPlaneDeployed"
+		message = "The control plane components have been deployed successfully."
+	)
+	if reconcileErr != nil {
+		status, reason, message = gardencorev1alpha1.ConditionFalse, "ControlPlaneDeploymentFailed", reconcileErr.Error()
 	}
 
-	return buf.Bytes(), nil
+	if err := extensionscontroller.ReportCondition(ctx, controlplane.ConditionTypeControlPlaneHealthy, status, reason, message); err != nil {
+		a.logger.Error(err, "Could not report the control plane condition", "controlplane", util.ObjectName(cp))
+	}
+}
+
+// getShootWebhooks returns the currently desired shoot webhooks. They might change over time, e.g. when the CA bundle
+// of the webhook server was rotated.
+func (a *actuator) getShootWebhooks() []admissionregistrationv1beta1.Webhook {
+	if a.shootWebhooks == nil {
+		return nil
+	}
+	return a.shootWebhooks()
 }
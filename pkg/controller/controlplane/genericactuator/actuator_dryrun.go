@@ -0,0 +1,207 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
+	"github.com/gardener/gardener-extensions/pkg/util"
+	extensionswebhookshoot "github.com/gardener/gardener-extensions/pkg/webhook/shoot"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DryRun computes the changes a reconciliation of the given controlplane and cluster would make. The charts are
+// rendered with the values of the values provider and compared with the objects in the seed and with the managed
+// resources of the shoot. Secrets are not generated, hence the checksums are computed from the existing secrets.
+func (a *actuator) DryRun(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (*extensionscontroller.DryRunResult, error) {
+	if cp.Spec.Purpose != nil && *cp.Spec.Purpose == extensionsv1alpha1.Exposure {
+		return a.dryRunControlPlaneExposure(ctx, cp, cluster)
+	}
+	return a.dryRunControlPlane(ctx, cp, cluster)
+}
+
+func (a *actuator) dryRunControlPlaneExposure(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (*extensionscontroller.DryRunResult, error) {
+	result := &extensionscontroller.DryRunResult{}
+	if a.controlPlaneExposureChart == nil {
+		return result, nil
+	}
+
+	checksums, err := a.computeExistingChecksums(ctx, cp.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := a.vp.GetControlPlaneExposureChartValues(ctx, cp, cluster, checksums)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := a.dryRunChart(ctx, a.controlPlaneExposureChart, cp.Namespace, a.imageVector, a.gardenerClientset.Version(), cluster.Shoot.Spec.Kubernetes.Version, values)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dry-run control plane exposure chart for controlplane '%s'", util.ObjectName(cp))
+	}
+	result.Add(changes...)
+
+	return result, nil
+}
+
+func (a *actuator) dryRunControlPlane(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (*extensionscontroller.DryRunResult, error) {
+	result := &extensionscontroller.DryRunResult{}
+
+	if shootWebhooks := a.getShootWebhooks(); len(shootWebhooks) > 0 {
+		webhookConfiguration, err := extensionswebhookshoot.MarshalWebhooks(shootWebhooks, a.providerName)
+		if err != nil {
+			return nil, err
+		}
+
+		changes, err := extensionscontroller.DiffManagedResource(ctx, a.client, cp.Namespace, shootWebhooksResourceName, extensionswebhookshoot.WebhookConfigKey, webhookConfiguration)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not dry-run managed resource '%s/%s' containing shoot webhooks", cp.Namespace, shootWebhooksResourceName)
+		}
+		result.Add(changes...)
+	}
+
+	if a.configChart != nil {
+		values, err := a.vp.GetConfigChartValues(ctx, cp, cluster)
+		if err != nil {
+			return nil, err
+		}
+
+		changes, err := a.dryRunChart(ctx, a.configChart, cp.Namespace, nil, "", "", values)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not dry-run configuration chart for controlplane '%s'", util.ObjectName(cp))
+		}
+		result.Add(changes...)
+	}
+
+	checksums, err := a.computeExistingChecksums(ctx, cp.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	scaledDown := false
+	if extensionscontroller.IsHibernated(cluster.Shoot) && cluster.Shoot.DeletionTimestamp == nil {
+		dep := &appsv1.Deployment{}
+		if err := a.client.Get(ctx, client.ObjectKey{Namespace: cp.Namespace, Name: v1alpha1constants.DeploymentNameKubeAPIServer}, dep); client.IgnoreNotFound(err) != nil {
+			return nil, errors.Wrapf(err, "could not get deployment '%s/%s'", cp.Namespace, v1alpha1constants.DeploymentNameKubeAPIServer)
+		}
+		scaledDown = dep.Spec.Replicas == nil || *dep.Spec.Replicas == 0
+	}
+
+	values, err := a.vp.GetControlPlaneChartValues(ctx, cp, cluster, checksums, scaledDown)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := a.dryRunChart(ctx, a.controlPlaneChart, cp.Namespace, a.imageVector, a.gardenerClientset.Version(), cluster.Shoot.Spec.Kubernetes.Version, values)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dry-run control plane chart for controlplane '%s'", util.ObjectName(cp))
+	}
+	result.Add(changes...)
+
+	chartRenderer, err := a.chartRendererFactory.NewChartRendererForShoot(cluster.Shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not create chart renderer for shoot '%s'", cp.Namespace)
+	}
+
+	for _, shootChart := range []struct {
+		name      string
+		chart     util.Chart
+		getValues func(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) (map[string]interface{}, error)
+	}{
+		{controlPlaneShootChartResourceName, a.controlPlaneShootChart, a.vp.GetControlPlaneShootChartValues},
+		{storageClassesChartResourceName, a.storageClassesChart, a.vp.GetStorageClassesChartValues},
+	} {
+		values, err := shootChart.getValues(ctx, cp, cluster)
+		if err != nil {
+			return nil, err
+		}
+
+		chartName, data, err := shootChart.chart.Render(chartRenderer, metav1.NamespaceSystem, a.imageVector, cluster.Shoot.Spec.Kubernetes.Version, cluster.Shoot.Spec.Kubernetes.Version, values)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not render chart of managed resource '%s' for controlplane '%s'", shootChart.name, util.ObjectName(cp))
+		}
+
+		changes, err := extensionscontroller.DiffManagedResource(ctx, a.client, cp.Namespace, shootChart.name, chartName, data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not dry-run managed resource '%s' for controlplane '%s'", shootChart.name, util.ObjectName(cp))
+		}
+		result.Add(changes...)
+	}
+
+	return result, nil
+}
+
+// dryRunChart renders the given chart for the seed and compares its objects with the ones in the seed.
+func (a *actuator) dryRunChart(
+	ctx context.Context,
+	chart util.Chart,
+	namespace string,
+	imageVector imagevector.ImageVector,
+	runtimeVersion, targetVersion string,
+	values map[string]interface{},
+) ([]extensionscontroller.DryRunChange, error) {
+	_, manifest, err := chart.Render(a.chartRenderer, namespace, imageVector, runtimeVersion, targetVersion, values)
+	if err != nil {
+		return nil, err
+	}
+	return extensionscontroller.DiffManifestWithCluster(ctx, a.client, manifest)
+}
+
+// computeExistingChecksums computes the checksums of all secrets and config maps that exist in the given namespace.
+// In contrast to computeChecksums, secrets that would be generated by a reconciliation are not yet considered.
+func (a *actuator) computeExistingChecksums(ctx context.Context, namespace string) (map[string]string, error) {
+	secretList := &corev1.SecretList{}
+	if err := a.client.List(ctx, secretList, client.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrapf(err, "could not list secrets in namespace '%s'", namespace)
+	}
+	secrets := make(map[string]*corev1.Secret, len(secretList.Items))
+	for i, secret := range secretList.Items {
+		secrets[secret.Name] = &secretList.Items[i]
+	}
+
+	configMapList := &corev1.ConfigMapList{}
+	if err := a.client.List(ctx, configMapList, client.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrapf(err, "could not list config maps in namespace '%s'", namespace)
+	}
+	configMaps := make(map[string]*corev1.ConfigMap, len(configMapList.Items))
+	for i, configMap := range configMapList.Items {
+		configMaps[configMap.Name] = &configMapList.Items[i]
+	}
+
+	return controlplane.ComputeChecksums(secrets, configMaps), nil
+}
@@ -114,6 +114,8 @@ var _ = Describe("Actuator", func() {
 			},
 		}
 
+		cpKey          = client.ObjectKey{Namespace: namespace, Name: "control-plane"}
+		cpExposureKey  = client.ObjectKey{Namespace: namespace, Name: "control-plane-exposure"}
 		cpSecretKey    = client.ObjectKey{Namespace: namespace, Name: v1alpha1constants.SecretNameCloudProvider}
 		cpConfigMapKey = client.ObjectKey{Namespace: namespace, Name: cloudProviderConfigName}
 		cpSecret       = &corev1.Secret{
@@ -270,6 +272,8 @@ var _ = Describe("Actuator", func() {
 
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
+		checkShootWebhookEndpoints = func(context.Context, []admissionregistrationv1beta1.Webhook) error { return nil }
+		checkShootWebhooksNetworkPolicy = func(context.Context, client.Client, string, string, int) error { return nil }
 	})
 
 	AfterEach(func() {
@@ -287,10 +291,10 @@ var _ = Describe("Actuator", func() {
 				client.EXPECT().Get(ctx, resourceKeyShootWebhooksNetworkPolicy, gomock.AssignableToTypeOf(&networkingv1.NetworkPolicy{})).Return(errNotFound)
 				client.EXPECT().Create(ctx, createdNetworkPolicyForShootWebhooks).Return(nil)
 
-				data, _ := marshalWebhooks(webhooks, providerName)
+				data, _ := extensionswebhookshoot.MarshalWebhooks(webhooks, providerName)
 				createdMRSecretForShootWebhooks := &corev1.Secret{
 					ObjectMeta: metav1.ObjectMeta{Name: shootWebhooksResourceName, Namespace: namespace},
-					Data:       map[string][]byte{"mutatingwebhookconfiguration.yaml": data},
+					Data:       map[string][]byte{extensionswebhookshoot.WebhookConfigKey: data},
 					Type:       corev1.SecretTypeOpaque,
 				}
 				client.EXPECT().Get(ctx, resourceKeyShootWebhooks, gomock.AssignableToTypeOf(&corev1.Secret{})).Return(errNotFound)
@@ -299,6 +303,11 @@ var _ = Describe("Actuator", func() {
 				client.EXPECT().Create(ctx, createdMRForShootWebhooks).Return(nil)
 			}
 
+			cp.Status.State = ""
+			client.EXPECT().Status().Return(client)
+			client.EXPECT().Get(ctx, cpKey, cp)
+			client.EXPECT().Update(ctx, cp)
+
 			client.EXPECT().Get(ctx, cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
 			if configName != "" {
 				client.EXPECT().Get(ctx, cpConfigMapKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cpConfigMap))
@@ -350,7 +359,7 @@ var _ = Describe("Actuator", func() {
 			vp.EXPECT().GetStorageClassesChartValues(ctx, cp, cluster).Return(storageClassesChartValues, nil)
 
 			// Create actuator
-			a := NewActuator(providerName, secrets, nil, configChart, ccmChart, ccmShootChart, storageClassesChart, nil, vp, crf, imageVector, configName, webhooks, webhookServerPort, logger)
+			a := NewActuator(providerName, secrets, nil, configChart, ccmChart, ccmShootChart, storageClassesChart, nil, vp, crf, imageVector, configName, func() []admissionregistrationv1beta1.Webhook { return webhooks }, webhookServerPort, logger)
 			err := a.(inject.Client).InjectClient(client)
 			Expect(err).NotTo(HaveOccurred())
 			a.(*actuator).gardenerClientset = gardenerClientset
@@ -402,7 +411,7 @@ var _ = Describe("Actuator", func() {
 			}
 
 			// Create actuator
-			a := NewActuator(providerName, secrets, nil, configChart, ccmChart, nil, nil, nil, nil, nil, nil, configName, webhooks, webhookServerPort, logger)
+			a := NewActuator(providerName, secrets, nil, configChart, ccmChart, nil, nil, nil, nil, nil, nil, configName, func() []admissionregistrationv1beta1.Webhook { return webhooks }, webhookServerPort, logger)
 			err := a.(inject.Client).InjectClient(client)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -419,6 +428,13 @@ var _ = Describe("Actuator", func() {
 		func() {
 			ctx := context.TODO()
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			cpExposure.Status.State = ""
+			client.EXPECT().Status().Return(client)
+			client.EXPECT().Get(ctx, cpExposureKey, cpExposure)
+			client.EXPECT().Update(ctx, cpExposure)
+
 			// Create mock Gardener clientset and chart applier
 			gardenerClientset := mockkubernetes.NewMockInterface(ctrl)
 			gardenerClientset.EXPECT().Version().Return(seedVersion)
@@ -436,6 +452,8 @@ var _ = Describe("Actuator", func() {
 
 			// Create actuator
 			a := NewActuator(providerName, nil, exposureSecrets, nil, nil, nil, nil, cpExposureChart, vp, nil, imageVector, "", nil, 0, logger)
+			err := a.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
 			a.(*actuator).gardenerClientset = gardenerClientset
 			a.(*actuator).chartApplier = chartApplier
 
@@ -473,6 +491,65 @@ var _ = Describe("Actuator", func() {
 		Entry("should delete secrets and charts"),
 	)
 
+	DescribeTable("#Migrate",
+		func(configName string, webhooks []admissionregistrationv1beta1.Webhook) {
+			ctx := context.TODO()
+
+			// Create mock clients
+			client := mockclient.NewMockClient(ctrl)
+
+			client.EXPECT().Get(ctx, resourceKeyStorageClassesChart, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(nil)
+			client.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{}), gomock.Any()).Return(nil)
+			client.EXPECT().Get(ctx, resourceKeyCPShootChart, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(nil)
+			client.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{}), gomock.Any()).Return(nil)
+			if len(webhooks) > 0 {
+				client.EXPECT().Get(ctx, resourceKeyShootWebhooks, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(nil)
+				client.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{}), gomock.Any()).Return(nil)
+			} else {
+				client.EXPECT().Get(ctx, resourceKeyShootWebhooks, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(errors.NewNotFound(schema.GroupResource{}, deletedMRForShootWebhooks.Name))
+			}
+
+			client.EXPECT().Delete(ctx, deleteMRForStorageClassesChart).Return(nil)
+			client.EXPECT().Delete(ctx, deletedMRSecretForStorageClassesChart).Return(nil)
+
+			client.EXPECT().Delete(ctx, deleteMRForCPShootChart).Return(nil)
+			client.EXPECT().Delete(ctx, deletedMRSecretForCPShootChart).Return(nil)
+
+			client.EXPECT().Get(gomock.Any(), resourceKeyStorageClassesChart, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(errors.NewNotFound(schema.GroupResource{}, deleteMRForStorageClassesChart.Name))
+			client.EXPECT().Get(gomock.Any(), resourceKeyCPShootChart, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(errors.NewNotFound(schema.GroupResource{}, deleteMRForCPShootChart.Name))
+
+			// Create mock secrets and charts
+			secrets := mockutil.NewMockSecrets(ctrl)
+			secrets.EXPECT().Delete(gomock.Any(), namespace).Return(nil)
+			var configChart util.Chart
+			if configName != "" {
+				cc := mockutil.NewMockChart(ctrl)
+				cc.EXPECT().Delete(ctx, client, namespace).Return(nil)
+				configChart = cc
+			}
+			ccmChart := mockutil.NewMockChart(ctrl)
+			ccmChart.EXPECT().Delete(ctx, client, namespace).Return(nil)
+
+			if len(webhooks) > 0 {
+				client.EXPECT().Delete(ctx, deletedNetworkPolicyForShootWebhooks).Return(nil)
+				client.EXPECT().Delete(ctx, deletedMRForShootWebhooks).Return(nil)
+				client.EXPECT().Delete(ctx, deletedMRSecretForShootWebhooks).Return(nil)
+				client.EXPECT().Get(gomock.Any(), resourceKeyShootWebhooks, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(errors.NewNotFound(schema.GroupResource{}, deletedMRForShootWebhooks.Name))
+			}
+
+			// Create actuator
+			a := NewActuator(providerName, secrets, nil, configChart, ccmChart, nil, nil, nil, nil, nil, nil, configName, func() []admissionregistrationv1beta1.Webhook { return webhooks }, webhookServerPort, logger)
+			err := a.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call Migrate method and check the result
+			err = a.Migrate(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+		},
+		Entry("should keep shoot objects and delete secrets and charts", cloudProviderConfigName, []admissionregistrationv1beta1.Webhook{{}}),
+		Entry("should keep shoot objects and delete secrets and charts (no config)", "", []admissionregistrationv1beta1.Webhook{{}}),
+		Entry("should keep shoot objects and delete secrets and charts (no webhook)", cloudProviderConfigName, nil),
+	)
 })
 
 func clientGet(result runtime.Object) interface{} {
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -28,6 +29,7 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -41,6 +43,12 @@ const (
 	EventControlPlaneReconciliation string = "ControlPlaneReconciliation"
 	// EventControlPlaneDeletion an event reason to describe control plane deletion.
 	EventControlPlaneDeletion string = "ControlPlaneDeletion"
+	// EventControlPlaneMigration an event reason to describe control plane migration.
+	EventControlPlaneMigration string = "ControlPlaneMigration"
+	// EventControlPlaneRestoration an event reason to describe control plane restoration.
+	EventControlPlaneRestoration string = "ControlPlaneRestoration"
+	// EventControlPlaneDryRun an event reason to describe a control plane dry-run.
+	EventControlPlaneDryRun string = "ControlPlaneDryRun"
 
 	// RequeueAfter is the duration to requeue a controlplane reconciliation if indicated by the actuator.
 	RequeueAfter time.Duration = 2 * time.Second
@@ -49,6 +57,7 @@ const (
 type reconciler struct {
 	logger   logr.Logger
 	actuator Actuator
+	dryRun   bool
 
 	ctx      context.Context
 	client   client.Client
@@ -57,12 +66,14 @@ type reconciler struct {
 
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // controlplane resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+// If dryRun is true, all controlplane resources are only dry-run instead of being reconciled.
+func NewReconciler(mgr manager.Manager, actuator Actuator, dryRun bool) reconcile.Reconciler {
 	return extensionscontroller.OperationAnnotationWrapper(
 		&extensionsv1alpha1.ControlPlane{},
 		&reconciler{
 			logger:   log.Log.WithName(ControllerName),
 			actuator: actuator,
+			dryRun:   dryRun,
 			recorder: mgr.GetEventRecorderFor(ControllerName),
 		})
 }
@@ -95,10 +106,22 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
-	if cp.DeletionTimestamp != nil {
+	operationType := extensionscontroller.ComputeOperationType(cp.ObjectMeta, cp.Status.LastOperation)
+
+	switch {
+	case r.dryRun || extensionscontroller.IsDryRun(cp.ObjectMeta):
+		return r.dryRunControlPlane(r.ctx, cp, cluster)
+	case operationType == extensionscontroller.LastOperationTypeMigrate:
+		return r.migrate(r.ctx, cp, cluster)
+	case operationType == extensionscontroller.LastOperationTypeRestore:
+		return r.restore(r.ctx, cp, cluster)
+	case extensionscontroller.IsMigrated(cp):
+		return reconcile.Result{}, nil
+	case cp.DeletionTimestamp != nil:
 		return r.delete(r.ctx, cp, cluster)
+	default:
+		return r.reconcile(r.ctx, cp, cluster)
 	}
-	return r.reconcile(r.ctx, cp, cluster)
 }
 
 func (r *reconciler) reconcile(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
@@ -106,14 +129,18 @@ func (r *reconciler) reconcile(ctx context.Context, cp *extensionsv1alpha1.Contr
 		return reconcile.Result{}, err
 	}
 
-	operationType := v1alpha1constantshelper.ComputeOperationType(cp.ObjectMeta, cp.Status.LastOperation)
+	operationType := extensionscontroller.ComputeOperationType(cp.ObjectMeta, cp.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, cp, operationType, "Reconciling the controlplane"); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	r.logger.Info("Starting the reconciliation of controlplane", "controlplane", cp.Name)
 	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneReconciliation, "Reconciling the controlplane")
-	requeue, err := r.actuator.Reconcile(ctx, cp, cluster)
+	progressCtx, flushProgress := extensionscontroller.NewProgressContext(ctx, r.client, cp)
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.ControlPlaneResource, cp.Spec.Type, operationType)
+	requeue, err := r.actuator.Reconcile(progressCtx, cp, cluster)
+	observe(err)
+	flushProgress()
 	if err != nil {
 		msg := "Error reconciling controlplane"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
@@ -145,14 +172,18 @@ func (r *reconciler) delete(ctx context.Context, cp *extensionsv1alpha1.ControlP
 		return reconcile.Result{}, nil
 	}
 
-	operationType := v1alpha1constantshelper.ComputeOperationType(cp.ObjectMeta, cp.Status.LastOperation)
+	operationType := extensionscontroller.ComputeOperationType(cp.ObjectMeta, cp.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, cp, operationType, "Deleting the controlplane"); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	r.logger.Info("Starting the deletion of controlplane", "controlplane", cp.Name)
 	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneDeletion, "Deleting the cp")
-	if err := r.actuator.Delete(r.ctx, cp, cluster); err != nil {
+	progressCtx, flushProgress := extensionscontroller.NewProgressContext(r.ctx, r.client, cp)
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.ControlPlaneResource, cp.Spec.Type, operationType)
+	err = observe(r.actuator.Delete(progressCtx, cp, cluster))
+	flushProgress()
+	if err != nil {
 		msg := "Error deleting controlplane"
 		r.recorder.Eventf(cp, corev1.EventTypeWarning, EventControlPlaneDeletion, "%s: %+v", msg, err)
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
@@ -176,6 +207,128 @@ func (r *reconciler) delete(ctx context.Context, cp *extensionsv1alpha1.ControlP
 	return reconcile.Result{}, nil
 }
 
+func (r *reconciler) migrate(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	operationType := extensionscontroller.LastOperationTypeMigrate
+	if err := r.updateStatusProcessing(ctx, cp, operationType, "Migrating the controlplane"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the migration of controlplane", "controlplane", cp.Name)
+	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneMigration, "Migrating the controlplane")
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.ControlPlaneResource, cp.Spec.Type, operationType)
+	if err := observe(r.actuator.Migrate(ctx, cp, cluster)); err != nil {
+		msg := "Error migrating controlplane"
+		r.recorder.Eventf(cp, corev1.EventTypeWarning, EventControlPlaneMigration, "%s: %+v", msg, err)
+		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
+		r.logger.Error(err, msg, "controlplane", cp.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully migrated controlplane"
+	r.logger.Info(msg, "controlplane", cp.Name)
+	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneMigration, msg)
+	if err := r.updateStatusSuccess(ctx, cp, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Removing finalizer.", "controlplane", cp.Name)
+	if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, cp); err != nil {
+		r.logger.Error(err, "Error removing finalizer from ControlPlane", "controlplane", cp.Name)
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, cp); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) restore(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, FinalizerName, cp); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	operationType := extensionscontroller.LastOperationTypeRestore
+	if err := r.updateStatusProcessing(ctx, cp, operationType, "Restoring the controlplane"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the restoration of controlplane", "controlplane", cp.Name)
+	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneRestoration, "Restoring the controlplane")
+	progressCtx, flushProgress := extensionscontroller.NewProgressContext(ctx, r.client, cp)
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.ControlPlaneResource, cp.Spec.Type, operationType)
+	requeue, err := r.actuator.Restore(progressCtx, cp, cluster)
+	observe(err)
+	flushProgress()
+	if err != nil {
+		msg := "Error restoring controlplane"
+		r.recorder.Eventf(cp, corev1.EventTypeWarning, EventControlPlaneRestoration, "%s: %+v", msg, err)
+		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
+		r.logger.Error(err, msg, "controlplane", cp.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully restored controlplane"
+	r.logger.Info(msg, "controlplane", cp.Name)
+	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneRestoration, msg)
+	if err := r.updateStatusSuccess(ctx, cp, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, cp); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if requeue {
+		return reconcile.Result{RequeueAfter: RequeueAfter}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) dryRunControlPlane(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	result := &extensionscontroller.DryRunResult{Time: metav1.Now()}
+
+	if cp.DeletionTimestamp != nil {
+		result.Add(extensionscontroller.DryRunChange{
+			Kind:      extensionsv1alpha1.ControlPlaneResource,
+			Namespace: cp.Namespace,
+			Name:      cp.Name,
+			Action:    extensionscontroller.DryRunActionDelete,
+		})
+	} else {
+		dryRunner, ok := r.actuator.(DryRunner)
+		if !ok {
+			msg := "Dry-run is not supported for this controlplane"
+			r.logger.Info(msg, "controlplane", cp.Name)
+			r.recorder.Event(cp, corev1.EventTypeWarning, EventControlPlaneDryRun, msg)
+			return reconcile.Result{}, extensionscontroller.RemoveDryRunOperationAnnotation(ctx, r.client, cp)
+		}
+
+		r.logger.Info("Starting the dry-run of controlplane", "controlplane", cp.Name)
+		changes, err := dryRunner.DryRun(ctx, cp, cluster)
+		if err != nil {
+			msg := "Error during dry-run of controlplane"
+			r.recorder.Eventf(cp, corev1.EventTypeWarning, EventControlPlaneDryRun, "%s: %+v", msg, err)
+			r.logger.Error(err, msg, "controlplane", cp.Name)
+			return extensionscontroller.ReconcileErr(err)
+		}
+		if changes != nil {
+			result.Add(changes.Changes...)
+		}
+	}
+
+	if err := extensionscontroller.SaveDryRunResult(ctx, r.client, cp, result); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	msg := fmt.Sprintf("Finished dry-run of controlplane: %s", result.Summary())
+	r.logger.Info(msg, "controlplane", cp.Name)
+	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneDryRun, msg)
+
+	return reconcile.Result{}, extensionscontroller.RemoveDryRunOperationAnnotation(ctx, r.client, cp)
+}
+
 func (r *reconciler) updateStatusProcessing(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	cp.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
 	return r.client.Status().Update(ctx, cp)
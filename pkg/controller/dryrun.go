@@ -0,0 +1,327 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GardenerOperationDryRun is a constant for the value of the operation annotation describing a dry-run
+// operation. A dry-run computes the changes a reconciliation would make without applying them.
+const GardenerOperationDryRun = "dry-run"
+
+// DryRunAction is the kind of change a reconciliation would make to an object.
+type DryRunAction string
+
+const (
+	// DryRunActionCreate means that the object would be created.
+	DryRunActionCreate DryRunAction = "Create"
+	// DryRunActionUpdate means that the object would be updated.
+	DryRunActionUpdate DryRunAction = "Update"
+	// DryRunActionDelete means that the object would be deleted.
+	DryRunActionDelete DryRunAction = "Delete"
+)
+
+// DryRunChange is a single change a reconciliation would make.
+type DryRunChange struct {
+	// Kind is the kind of the changed object, e.g. a Kubernetes kind or a Terraform resource type.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the changed object, if any.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the changed object.
+	Name string `json:"name"`
+	// Action is the kind of the change.
+	Action DryRunAction `json:"action"`
+	// Fields are the paths of the fields that would change. It is only set for updates.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+}
+
+// DryRunResult is the outcome of a dry-run. It is persisted in the status of the extension resource under the
+// StateKeyDryRun key.
+type DryRunResult struct {
+	// Time is the time the dry-run was executed.
+	Time metav1.Time `json:"time"`
+	// Changes are the changes a reconciliation would make.
+	// +optional
+	Changes []DryRunChange `json:"changes,omitempty"`
+}
+
+// Add adds the given changes to the result.
+func (r *DryRunResult) Add(changes ...DryRunChange) {
+	r.Changes = append(r.Changes, changes...)
+}
+
+// Summary returns a short human readable description of the result.
+func (r *DryRunResult) Summary() string {
+	var create, update, del int
+	for _, change := range r.Changes {
+		switch change.Action {
+		case DryRunActionCreate:
+			create++
+		case DryRunActionUpdate:
+			update++
+		case DryRunActionDelete:
+			del++
+		}
+	}
+	return fmt.Sprintf("%d to create, %d to update, %d to delete", create, update, del)
+}
+
+// IsDryRun checks whether the Gardener operation annotation of the given object requests a dry-run.
+func IsDryRun(meta metav1.ObjectMeta) bool {
+	return meta.Annotations[v1alpha1constants.GardenerOperation] == GardenerOperationDryRun
+}
+
+// RemoveDryRunOperationAnnotation removes the Gardener operation annotation from the given object if it requests a
+// dry-run. Other values of the annotation are kept, so that e.g. a requested migration is executed once the
+// controller leaves the dry-run mode.
+func RemoveDryRunOperationAnnotation(ctx context.Context, c client.Client, obj runtime.Object) error {
+	acc, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	if acc.GetAnnotations()[v1alpha1constants.GardenerOperation] != GardenerOperationDryRun {
+		return nil
+	}
+	return RemoveOperationAnnotation(ctx, c, obj)
+}
+
+// GetDryRunResult returns the result of the last dry-run persisted in the status of the given extension object,
+// or nil if no dry-run was executed yet.
+func GetDryRunResult(obj extensionsv1alpha1.Object) (*DryRunResult, error) {
+	result := &DryRunResult{}
+	ok, err := GetStateObject(obj, StateKeyDryRun, result)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SaveDryRunResult persists the given dry-run result in the status of the given extension object.
+func SaveDryRunResult(ctx context.Context, c client.Client, obj extensionsv1alpha1.Object, result *DryRunResult) error {
+	return SaveStateObject(ctx, c, obj, StateKeyDryRun, result)
+}
+
+// DiffManifestWithCluster compares the objects of the given manifest, e.g. a rendered chart, with their
+// counterparts in the cluster. It returns a change for every object that does not exist yet or whose fields
+// differ. Fields that are only present in the cluster, e.g. defaulted fields or the status, are ignored.
+func DiffManifestWithCluster(ctx context.Context, c client.Client, manifest []byte) ([]DryRunChange, error) {
+	desiredObjects, err := readManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []DryRunChange
+	for _, desired := range desiredObjects {
+		current := &unstructured.Unstructured{}
+		current.SetGroupVersionKind(desired.GroupVersionKind())
+		if err := c.Get(ctx, client.ObjectKey{Namespace: desired.GetNamespace(), Name: desired.GetName()}, current); err != nil {
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				changes = append(changes, newDryRunChange(desired, DryRunActionCreate, nil))
+				continue
+			}
+			return nil, err
+		}
+
+		if fields := DiffObject(desired, current, false); len(fields) > 0 {
+			changes = append(changes, newDryRunChange(desired, DryRunActionUpdate, fields))
+		}
+	}
+	return changes, nil
+}
+
+// DiffManifests compares the objects of the given manifests, e.g. the data of a managed resource secret before and
+// after rendering its chart again. It returns a change for every object that would be created, updated or deleted.
+func DiffManifests(current, desired []byte) ([]DryRunChange, error) {
+	currentObjects, err := readManifest(current)
+	if err != nil {
+		return nil, err
+	}
+	desiredObjects, err := readManifest(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		changes      []DryRunChange
+		currentByKey = make(map[string]*unstructured.Unstructured, len(currentObjects))
+		desiredKeys  = make(map[string]struct{}, len(desiredObjects))
+	)
+	for _, obj := range currentObjects {
+		currentByKey[objectKey(obj)] = obj
+	}
+
+	for _, obj := range desiredObjects {
+		key := objectKey(obj)
+		desiredKeys[key] = struct{}{}
+
+		currentObj, ok := currentByKey[key]
+		if !ok {
+			changes = append(changes, newDryRunChange(obj, DryRunActionCreate, nil))
+			continue
+		}
+		if fields := DiffObject(obj, currentObj, true); len(fields) > 0 {
+			changes = append(changes, newDryRunChange(obj, DryRunActionUpdate, fields))
+		}
+	}
+
+	for _, obj := range currentObjects {
+		if _, ok := desiredKeys[objectKey(obj)]; !ok {
+			changes = append(changes, newDryRunChange(obj, DryRunActionDelete, nil))
+		}
+	}
+
+	return changes, nil
+}
+
+// DiffManagedResource compares the given manifest with the data stored under the given key in the secret of the
+// managed resource with the given name, see DiffManifests. All objects of the manifest are considered new if the
+// managed resource does not exist yet.
+func DiffManagedResource(ctx context.Context, c client.Client, namespace, name, key string, manifest []byte) ([]DryRunChange, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret); client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	return DiffManifests(secret.Data[key], manifest)
+}
+
+// DiffObject returns the sorted paths of the fields of the desired object that differ from the current object.
+// If strict is false, fields that are only present in the current object are ignored. The values of the fields
+// are never returned, so that the paths can safely be published even for secrets.
+func DiffObject(desired, current *unstructured.Unstructured, strict bool) []string {
+	var fields []string
+	diffValues("", desired.Object, current.Object, strict, &fields)
+	sort.Strings(fields)
+	return fields
+}
+
+func diffValues(path string, desired, current interface{}, strict bool, fields *[]string) {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		c, ok := current.(map[string]interface{})
+		if !ok {
+			*fields = append(*fields, path)
+			return
+		}
+		for key, value := range d {
+			diffValues(joinFieldPath(path, key), value, c[key], strict, fields)
+		}
+		if strict {
+			for key := range c {
+				if _, ok := d[key]; !ok {
+					*fields = append(*fields, joinFieldPath(path, key))
+				}
+			}
+		}
+
+	case []interface{}:
+		c, ok := current.([]interface{})
+		if !ok || len(c) != len(d) {
+			*fields = append(*fields, path)
+			return
+		}
+		for i := range d {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), d[i], c[i], strict, fields)
+		}
+
+	default:
+		if !equalScalars(desired, current) {
+			*fields = append(*fields, path)
+		}
+	}
+}
+
+// equalScalars compares the given scalar values. Numbers are compared by value since decoded YAML yields
+// float64 while objects read from the API server yield int64.
+func equalScalars(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func readManifest(manifest []byte) ([]*unstructured.Unstructured, error) {
+	var (
+		objects []*unstructured.Unstructured
+		reader  = kubernetes.NewManifestReader(manifest)
+	)
+	for {
+		obj, err := reader.Read()
+		if err == io.EOF {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+}
+
+func objectKey(obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s/%s", obj.GroupVersionKind().GroupKind(), obj.GetNamespace(), obj.GetName())
+}
+
+func newDryRunChange(obj *unstructured.Unstructured, action DryRunAction, fields []string) DryRunChange {
+	return DryRunChange{
+		Kind:      obj.GetKind(),
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Action:    action,
+		Fields:    fields,
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	configMapFoo = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+  namespace: shoot--foo--bar
+data:
+  key: value
+`
+	configMapFooChanged = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+  namespace: shoot--foo--bar
+data:
+  key: other-value
+  new-key: value
+`
+	deploymentBar = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: bar
+  namespace: shoot--foo--bar
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: bar
+        image: bar:v1
+`
+)
+
+var _ = Describe("DryRun", func() {
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+		ctx  context.Context
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+		ctx = context.TODO()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#IsDryRun", func() {
+		It("should only return true for the dry-run operation annotation", func() {
+			Expect(controller.IsDryRun(metav1.ObjectMeta{Annotations: map[string]string{v1alpha1constants.GardenerOperation: controller.GardenerOperationDryRun}})).To(BeTrue())
+			Expect(controller.IsDryRun(metav1.ObjectMeta{Annotations: map[string]string{v1alpha1constants.GardenerOperation: v1alpha1constants.GardenerOperationReconcile}})).To(BeFalse())
+			Expect(controller.IsDryRun(metav1.ObjectMeta{})).To(BeFalse())
+		})
+	})
+
+	Describe("#Summary", func() {
+		It("should count the changes per action", func() {
+			result := &controller.DryRunResult{}
+			result.Add(
+				controller.DryRunChange{Action: controller.DryRunActionCreate},
+				controller.DryRunChange{Action: controller.DryRunActionCreate},
+				controller.DryRunChange{Action: controller.DryRunActionDelete},
+			)
+
+			Expect(result.Summary()).To(Equal("2 to create, 0 to update, 1 to delete"))
+		})
+	})
+
+	Describe("#DiffObject", func() {
+		var desired, current *unstructured.Unstructured
+
+		BeforeEach(func() {
+			desired = &unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": float64(2),
+					"containers": []interface{}{
+						map[string]interface{}{"name": "foo", "image": "foo:v2"},
+					},
+				},
+			}}
+			current = &unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": int64(2),
+					"containers": []interface{}{
+						map[string]interface{}{"name": "foo", "image": "foo:v1", "imagePullPolicy": "IfNotPresent"},
+					},
+				},
+				"status": map[string]interface{}{"replicas": int64(2)},
+			}}
+		})
+
+		It("should ignore fields only present in the current object if not strict", func() {
+			Expect(controller.DiffObject(desired, current, false)).To(Equal([]string{"spec.containers[0].image"}))
+		})
+
+		It("should consider fields only present in the current object if strict", func() {
+			Expect(controller.DiffObject(desired, current, true)).To(Equal([]string{
+				"spec.containers[0].image",
+				"spec.containers[0].imagePullPolicy",
+				"status",
+			}))
+		})
+	})
+
+	Describe("#DiffManifests", func() {
+		It("should detect created, updated and deleted objects", func() {
+			changes, err := controller.DiffManifests([]byte(configMapFoo+"---\n"+deploymentBar), []byte(configMapFooChanged))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(ConsistOf(
+				controller.DryRunChange{Kind: "ConfigMap", Namespace: "shoot--foo--bar", Name: "foo", Action: controller.DryRunActionUpdate, Fields: []string{"data.key", "data.new-key"}},
+				controller.DryRunChange{Kind: "Deployment", Namespace: "shoot--foo--bar", Name: "bar", Action: controller.DryRunActionDelete},
+			))
+		})
+
+		It("should consider all objects new if there is no current manifest", func() {
+			changes, err := controller.DiffManifests(nil, []byte(deploymentBar))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(ConsistOf(
+				controller.DryRunChange{Kind: "Deployment", Namespace: "shoot--foo--bar", Name: "bar", Action: controller.DryRunActionCreate},
+			))
+		})
+	})
+
+	Describe("#DiffManifestWithCluster", func() {
+		It("should compare the objects with the ones in the cluster", func() {
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: "shoot--foo--bar", Name: "foo"}, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *unstructured.Unstructured) error {
+					obj.Object["data"] = map[string]interface{}{"key": "value", "new-key": "value"}
+					obj.Object["metadata"] = map[string]interface{}{"name": "foo", "namespace": "shoot--foo--bar", "resourceVersion": "1"}
+					return nil
+				})
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: "shoot--foo--bar", Name: "bar"}, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{Resource: "deployments"}, "bar"))
+
+			changes, err := controller.DiffManifestWithCluster(ctx, c, []byte(configMapFooChanged+"---\n"+deploymentBar))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(ConsistOf(
+				controller.DryRunChange{Kind: "ConfigMap", Namespace: "shoot--foo--bar", Name: "foo", Action: controller.DryRunActionUpdate, Fields: []string{"data.key"}},
+				controller.DryRunChange{Kind: "Deployment", Namespace: "shoot--foo--bar", Name: "bar", Action: controller.DryRunActionCreate},
+			))
+		})
+	})
+
+	Describe("#DiffManagedResource", func() {
+		It("should compare the manifest with the data of the managed resource secret", func() {
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: "shoot--foo--bar", Name: "mr"}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, secret *corev1.Secret) error {
+					secret.Data = map[string][]byte{"chart": []byte(configMapFoo)}
+					return nil
+				})
+
+			changes, err := controller.DiffManagedResource(ctx, c, "shoot--foo--bar", "mr", "chart", []byte(configMapFoo))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(BeEmpty())
+		})
+	})
+
+	Describe("#RemoveDryRunOperationAnnotation", func() {
+		It("should keep other values of the operation annotation", func() {
+			infra := &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{v1alpha1constants.GardenerOperation: v1alpha1constants.GardenerOperationMigrate},
+			}}
+
+			Expect(controller.RemoveDryRunOperationAnnotation(ctx, c, infra)).To(Succeed())
+			Expect(infra.Annotations).To(HaveKeyWithValue(v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationMigrate))
+		})
+
+		It("should remove the dry-run operation annotation", func() {
+			infra := &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{v1alpha1constants.GardenerOperation: controller.GardenerOperationDryRun},
+			}}
+			c.EXPECT().Patch(ctx, infra, gomock.Any())
+
+			Expect(controller.RemoveDryRunOperationAnnotation(ctx, c, infra)).To(Succeed())
+			Expect(infra.Annotations).NotTo(HaveKey(v1alpha1constants.GardenerOperation))
+		})
+	})
+})
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
 	"github.com/gardener/gardener-extensions/pkg/util"
@@ -65,7 +66,11 @@ type AddArgs struct {
 }
 
 // Add adds an Extension controller to the given manager using the given AddArgs.
+// A resync interval of the Tuning of the manager takes precedence over the one of the AddArgs.
 func Add(mgr manager.Manager, args AddArgs) error {
+	if tuning, ok := extensionscontroller.TuningFrom(mgr); ok && tuning.Resync > 0 {
+		args.Resync = tuning.Resync
+	}
 	args.ControllerOptions.Reconciler = NewReconciler(args)
 	return add(mgr, args)
 }
@@ -94,7 +99,7 @@ func DefaultPredicates(extensionType string, ignoreOperationAnnotation bool) []p
 }
 
 func add(mgr manager.Manager, args AddArgs) error {
-	ctrl, err := controller.New(args.Name, mgr, args.ControllerOptions)
+	ctrl, err := extensionscontroller.NewController(args.Name, mgr, &extensionsv1alpha1.Extension{}, args.ControllerOptions)
 	if err != nil {
 		return err
 	}
@@ -166,16 +171,20 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
-	var (
-		result reconcile.Result
-		err    error
-	)
+	operationType := extensionscontroller.ComputeOperationType(ex.ObjectMeta, ex.Status.LastOperation)
 
-	if ex.DeletionTimestamp != nil {
+	switch {
+	case operationType == extensionscontroller.LastOperationTypeMigrate:
+		return r.migrate(r.ctx, ex)
+	case operationType == extensionscontroller.LastOperationTypeRestore:
+		return r.restore(r.ctx, ex)
+	case extensionscontroller.IsMigrated(ex):
+		return reconcile.Result{}, nil
+	case ex.DeletionTimestamp != nil:
 		return r.delete(r.ctx, ex)
 	}
 
-	result, err = r.reconcile(r.ctx, ex)
+	result, err := r.reconcile(r.ctx, ex)
 	if err != nil {
 		return result, err
 	}
@@ -189,12 +198,13 @@ func (r *reconciler) reconcile(ctx context.Context, ex *extensionsv1alpha1.Exten
 
 	msg := "Reconciling Extension resource"
 	r.logger.Info("Reconciling Extension resource", "extension", ex.Name, "namespace", ex.Namespace)
-	operationType := v1alpha1constantshelper.ComputeOperationType(ex.ObjectMeta, ex.Status.LastOperation)
+	operationType := extensionscontroller.ComputeOperationType(ex.ObjectMeta, ex.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, ex, operationType, msg); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	if err := r.actuator.Reconcile(ctx, ex); err != nil {
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.ExtensionResource, ex.Spec.Type, operationType)
+	if err := observe(r.actuator.Reconcile(ctx, ex)); err != nil {
 		msg := "Unable to reconcile Extension resource"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
 		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
@@ -221,12 +231,13 @@ func (r *reconciler) delete(ctx context.Context, ex *extensionsv1alpha1.Extensio
 		return reconcile.Result{}, nil
 	}
 
-	operationType := v1alpha1constantshelper.ComputeOperationType(ex.ObjectMeta, ex.Status.LastOperation)
+	operationType := extensionscontroller.ComputeOperationType(ex.ObjectMeta, ex.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, ex, operationType, "Deleting Extension resource."); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	if err := r.actuator.Delete(ctx, ex); err != nil {
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.ExtensionResource, ex.Spec.Type, operationType)
+	if err := observe(r.actuator.Delete(ctx, ex)); err != nil {
 		msg := "Error deleting Extension resource"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
 		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
@@ -247,6 +258,72 @@ func (r *reconciler) delete(ctx context.Context, ex *extensionsv1alpha1.Extensio
 	return reconcile.Result{}, nil
 }
 
+func (r *reconciler) migrate(ctx context.Context, ex *extensionsv1alpha1.Extension) (reconcile.Result, error) {
+	operationType := extensionscontroller.LastOperationTypeMigrate
+	if err := r.updateStatusProcessing(ctx, ex, operationType, "Migrating the Extension resource"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the migration of Extension resource", "extension", ex.Name, "namespace", ex.Namespace)
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.ExtensionResource, ex.Spec.Type, operationType)
+	if err := observe(r.actuator.Migrate(ctx, ex)); err != nil {
+		msg := "Error migrating Extension resource"
+		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
+		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully migrated Extension resource"
+	r.logger.Info(msg, "extension", ex.Name, "namespace", ex.Namespace)
+	if err := r.updateStatusSuccess(ctx, ex, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Removing finalizer.", "extension", ex.Name, "namespace", ex.Namespace)
+	if err := extensionscontroller.DeleteFinalizer(ctx, r.client, r.finalizerName, ex); err != nil {
+		r.logger.Error(err, "Error removing finalizer from Extension resource", "extension", ex.Name, "namespace", ex.Namespace)
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, ex); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) restore(ctx context.Context, ex *extensionsv1alpha1.Extension) (reconcile.Result, error) {
+	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, r.finalizerName, ex); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	operationType := extensionscontroller.LastOperationTypeRestore
+	if err := r.updateStatusProcessing(ctx, ex, operationType, "Restoring the Extension resource"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the restoration of Extension resource", "extension", ex.Name, "namespace", ex.Namespace)
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.ExtensionResource, ex.Spec.Type, operationType)
+	if err := observe(r.actuator.Restore(ctx, ex)); err != nil {
+		msg := "Error restoring Extension resource"
+		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
+		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully restored Extension resource"
+	r.logger.Info(msg, "extension", ex.Name, "namespace", ex.Namespace)
+	if err := r.updateStatusSuccess(ctx, ex, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, ex); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
 func (r *reconciler) updateStatusProcessing(ctx context.Context, ex *extensionsv1alpha1.Extension, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, ex, func() error {
 		ex.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
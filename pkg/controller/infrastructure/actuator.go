@@ -27,4 +27,16 @@ type Actuator interface {
 	Reconcile(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
 	// Delete the Infrastructure config.
 	Delete(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
+	// Migrate releases all seed-local resources of the Infrastructure config while keeping the
+	// resources in the cloud provider account intact.
+	Migrate(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
+	// Restore rebuilds the seed-local resources of the Infrastructure config after a migration.
+	Restore(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
+}
+
+// DryRunner is implemented by Actuators that are able to compute the changes a reconciliation of an
+// Infrastructure config would make, without changing anything in the seed or the cloud provider account.
+type DryRunner interface {
+	// DryRun computes the changes a reconciliation of the Infrastructure config would make.
+	DryRun(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) (*extensionscontroller.DryRunResult, error)
 }
@@ -0,0 +1,44 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	"github.com/go-logr/logr"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// ReportInfrastructureReady reports the InfrastructureReady condition of the given Infrastructure. Failures are only
+// logged as the condition reports are not essential for the reconciliation.
+func ReportInfrastructureReady(ctx context.Context, logger logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure, status gardencorev1alpha1.ConditionStatus, reason, message string) {
+	if err := extensionscontroller.ReportCondition(ctx, ConditionTypeInfrastructureReady, status, reason, message); err != nil {
+		logger.Error(err, "could not report the infrastructure condition", "infrastructure", infrastructure.Name)
+	}
+}
+
+// ReportTerraformApplyResult reports the InfrastructureReady condition of the given Infrastructure depending on the
+// result of the Terraform apply.
+func ReportTerraformApplyResult(ctx context.Context, logger logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure, applyErr error) {
+	if applyErr != nil {
+		ReportInfrastructureReady(ctx, logger, infrastructure, gardencorev1alpha1.ConditionFalse, "TerraformApplyFailed", applyErr.Error())
+		return
+	}
+	ReportInfrastructureReady(ctx, logger, infrastructure, gardencorev1alpha1.ConditionTrue, "TerraformApplySucceeded", "The Terraform configuration has been applied successfully.")
+}
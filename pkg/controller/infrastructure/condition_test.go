@@ -0,0 +1,80 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	. "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type conditionRecorder struct {
+	conditionType gardencorev1alpha1.ConditionType
+	status        gardencorev1alpha1.ConditionStatus
+	reason        string
+	message       string
+}
+
+func (r *conditionRecorder) Progress(context.Context, int, string) error { return nil }
+func (r *conditionRecorder) Condition(_ context.Context, conditionType gardencorev1alpha1.ConditionType, status gardencorev1alpha1.ConditionStatus, reason, message string) error {
+	r.conditionType, r.status, r.reason, r.message = conditionType, status, reason, message
+	return nil
+}
+func (r *conditionRecorder) Flush(context.Context) error { return nil }
+
+var _ = Describe("Condition", func() {
+	Describe("#ReportTerraformApplyResult", func() {
+		var (
+			ctx            context.Context
+			recorder       *conditionRecorder
+			logger         logr.Logger
+			infrastructure *extensionsv1alpha1.Infrastructure
+		)
+
+		BeforeEach(func() {
+			recorder = &conditionRecorder{}
+			ctx = extensionscontroller.WithProgressReporter(context.TODO(), recorder)
+			logger = log.Log.WithName("test")
+			infrastructure = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "infra"}}
+		})
+
+		It("should report a ready infrastructure if the apply succeeded", func() {
+			ReportTerraformApplyResult(ctx, logger, infrastructure, nil)
+
+			Expect(recorder.conditionType).To(Equal(ConditionTypeInfrastructureReady))
+			Expect(recorder.status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(recorder.reason).To(Equal("TerraformApplySucceeded"))
+		})
+
+		It("should report an infrastructure that is not ready if the apply failed", func() {
+			ReportTerraformApplyResult(ctx, logger, infrastructure, fmt.Errorf("apply failed"))
+
+			Expect(recorder.conditionType).To(Equal(ConditionTypeInfrastructureReady))
+			Expect(recorder.status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(recorder.reason).To(Equal("TerraformApplyFailed"))
+			Expect(recorder.message).To(Equal("apply failed"))
+		})
+	})
+})
@@ -15,9 +15,12 @@
 package infrastructure
 
 import (
+	"time"
+
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -32,6 +35,10 @@ const (
 	FinalizerName = "extensions.gardener.cloud/infrastructure"
 	// ControllerName is the name of the controller.
 	ControllerName = "infrastructure_controller"
+
+	// ConditionTypeInfrastructureReady is the type of the condition that describes whether the infrastructure has
+	// been created and is ready to be used.
+	ConditionTypeInfrastructureReady gardencorev1alpha1.ConditionType = "InfrastructureReady"
 )
 
 // AddArgs are arguments for adding an infrastructure controller to a manager.
@@ -47,6 +54,15 @@ type AddArgs struct {
 	Predicates []predicate.Predicate
 	// WatchBuilder defines additional watches on controllers that should be set up.
 	WatchBuilder extensionscontroller.WatchBuilder
+	// DryRun specifies whether all Infrastructure resources are only dry-run instead of being reconciled.
+	DryRun bool
+	// DriftDetectionInterval is the interval in which up-to-date Infrastructure resources are dry-run in order to
+	// detect drift between the actual and the desired infrastructure. Drift detection is disabled if the interval
+	// is zero or if the actuator does not implement DryRunner.
+	DriftDetectionInterval time.Duration
+	// DriftDetectionPredicates are the predicates to use for the drift detection controller.
+	// If unset, all Infrastructure resources are considered.
+	DriftDetectionPredicates []predicate.Predicate
 }
 
 // DefaultPredicates returns the default predicates for an infrastructure reconciler.
@@ -76,13 +92,20 @@ func DefaultPredicates(typeName string, ignoreOperationAnnotation bool) []predic
 // Add creates a new Infrastructure Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
-	return add(mgr, args)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, args.DryRun)
+	if err := add(mgr, args); err != nil {
+		return err
+	}
+
+	if dryRunner, ok := args.Actuator.(DryRunner); ok && args.DriftDetectionInterval > 0 {
+		return addDriftDetection(mgr, dryRunner, args.DriftDetectionInterval, args.DriftDetectionPredicates)
+	}
+	return nil
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
 func add(mgr manager.Manager, args AddArgs) error {
-	ctrl, err := controller.New(ControllerName, mgr, args.ControllerOptions)
+	ctrl, err := extensionscontroller.NewController(ControllerName, mgr, &extensionsv1alpha1.Infrastructure{}, args.ControllerOptions)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,200 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/go-logr/logr"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// DriftDetectionControllerName is the name of the controller that detects drift of infrastructures.
+	DriftDetectionControllerName = "infrastructure_drift_controller"
+
+	// ConditionTypeInfrastructureInSync is the type of the condition that describes whether the actual infrastructure
+	// matches the desired infrastructure.
+	ConditionTypeInfrastructureInSync gardencorev1alpha1.ConditionType = "InfrastructureInSync"
+
+	// ConditionReasonNoDriftDetected is the reason of the InfrastructureInSync condition if no drift was detected.
+	ConditionReasonNoDriftDetected = "NoDriftDetected"
+	// ConditionReasonDriftDetected is the reason of the InfrastructureInSync condition if drift was detected.
+	ConditionReasonDriftDetected = "DriftDetected"
+	// ConditionReasonReconciled is the reason of the InfrastructureInSync condition after a successful reconciliation.
+	ConditionReasonReconciled = "Reconciled"
+
+	// EventInfrastructureDriftDetection an event reason to describe an infrastructure drift detection.
+	EventInfrastructureDriftDetection string = "InfrastructureDriftDetection"
+
+	// maxDriftChangesInMessage is the maximum number of changes listed in the message of the InfrastructureInSync
+	// condition.
+	maxDriftChangesInMessage = 10
+)
+
+// DefaultDriftDetectionPredicates returns the default predicates for an infrastructure drift detection controller.
+// Status updates are ignored as the drift detection updates the status itself and is requeued periodically anyway.
+func DefaultDriftDetectionPredicates(typeName string) []predicate.Predicate {
+	return []predicate.Predicate{
+		extensionspredicate.HasType(typeName),
+		extensionspredicate.GenerationChanged(),
+	}
+}
+
+// addDriftDetection adds a controller to mgr that periodically dry-runs all infrastructures with the given
+// DryRunner and surfaces the result as InfrastructureInSync condition.
+func addDriftDetection(mgr manager.Manager, dryRunner DryRunner, interval time.Duration, predicates []predicate.Predicate) error {
+	ctrl, err := extensionscontroller.NewController(DriftDetectionControllerName, mgr, &extensionsv1alpha1.Infrastructure{}, controller.Options{
+		Reconciler: &driftReconciler{
+			logger:    log.Log.WithName(DriftDetectionControllerName),
+			dryRunner: dryRunner,
+			interval:  interval,
+			recorder:  mgr.GetEventRecorderFor(DriftDetectionControllerName),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.Watch(&source.Kind{Type: &extensionsv1alpha1.Infrastructure{}}, &handler.EnqueueRequestForObject{}, predicates...)
+}
+
+type driftReconciler struct {
+	logger    logr.Logger
+	dryRunner DryRunner
+	interval  time.Duration
+
+	ctx      context.Context
+	client   client.Client
+	recorder record.EventRecorder
+}
+
+func (r *driftReconciler) InjectFunc(f inject.Func) error {
+	return f(r.dryRunner)
+}
+
+func (r *driftReconciler) InjectClient(client client.Client) error {
+	r.client = client
+	return nil
+}
+
+func (r *driftReconciler) InjectStopChannel(stopCh <-chan struct{}) error {
+	r.ctx = util.ContextFromStopChannel(stopCh)
+	return nil
+}
+
+func (r *driftReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	infrastructure := &extensionsv1alpha1.Infrastructure{}
+	if err := r.client.Get(r.ctx, request.NamespacedName, infrastructure); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !IsUpToDate(infrastructure) {
+		r.logger.Info("Skipping drift detection as infrastructure is not up-to-date", "infrastructure", infrastructure.Name)
+		return reconcile.Result{RequeueAfter: r.interval}, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(r.ctx, r.client, infrastructure.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the drift detection of infrastructure", "infrastructure", infrastructure.Name)
+	result, err := r.dryRunner.DryRun(r.ctx, infrastructure, cluster)
+	if err != nil {
+		r.logger.Error(err, "Error detecting drift of infrastructure", "infrastructure", infrastructure.Name)
+	}
+
+	condition := DriftCondition(gardencorev1alpha1helper.GetOrInitCondition(infrastructure.Status.Conditions, ConditionTypeInfrastructureInSync), result, err)
+	if condition.Reason == ConditionReasonDriftDetected {
+		r.recorder.Event(infrastructure, corev1.EventTypeWarning, EventInfrastructureDriftDetection, condition.Message)
+	}
+
+	if err := extensionscontroller.TryUpdateStatus(r.ctx, retry.DefaultBackoff, r.client, infrastructure, func() error {
+		infrastructure.Status.Conditions = gardencorev1alpha1helper.MergeConditions(infrastructure.Status.Conditions, condition)
+		return nil
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.interval}, nil
+}
+
+// IsUpToDate checks whether the last operation of the given infrastructure succeeded for its current generation and
+// no further operation has been requested, i.e. whether the actual infrastructure is expected to match the
+// desired infrastructure.
+func IsUpToDate(infrastructure *extensionsv1alpha1.Infrastructure) bool {
+	lastOperation := infrastructure.Status.LastOperation
+	if infrastructure.DeletionTimestamp != nil || lastOperation == nil || extensionscontroller.IsMigrated(infrastructure) {
+		return false
+	}
+	if _, ok := infrastructure.Annotations[v1alpha1constants.GardenerOperation]; ok {
+		return false
+	}
+
+	return lastOperation.State == gardencorev1alpha1.LastOperationStateSucceeded &&
+		infrastructure.Status.ObservedGeneration == infrastructure.Generation
+}
+
+// DriftCondition returns the given InfrastructureInSync condition updated with the given dry-run result or error.
+func DriftCondition(condition gardencorev1alpha1.Condition, result *extensionscontroller.DryRunResult, err error) gardencorev1alpha1.Condition {
+	if err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err)
+	}
+	if result == nil || len(result.Changes) == 0 {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, ConditionReasonNoDriftDetected, "The infrastructure matches its desired state.")
+	}
+
+	var changes []string
+	for i, change := range result.Changes {
+		if i == maxDriftChangesInMessage {
+			changes = append(changes, fmt.Sprintf("and %d more", len(result.Changes)-maxDriftChangesInMessage))
+			break
+		}
+		changes = append(changes, fmt.Sprintf("%s %s", strings.ToLower(string(change.Action)), change.Name))
+	}
+
+	message := fmt.Sprintf("The infrastructure drifted from its desired state (%s): %s", result.Summary(), strings.Join(changes, ", "))
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, ConditionReasonDriftDetected, message)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	. "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Drift", func() {
+	Describe("#IsUpToDate", func() {
+		var infrastructure *extensionsv1alpha1.Infrastructure
+
+		BeforeEach(func() {
+			infrastructure = &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status: extensionsv1alpha1.InfrastructureStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						ObservedGeneration: 2,
+						LastOperation: &gardencorev1alpha1.LastOperation{
+							Type:  gardencorev1alpha1.LastOperationTypeReconcile,
+							State: gardencorev1alpha1.LastOperationStateSucceeded,
+						},
+					},
+				},
+			}
+		})
+
+		It("should return true if the last reconciliation succeeded for the current generation", func() {
+			Expect(IsUpToDate(infrastructure)).To(BeTrue())
+		})
+
+		It("should return false if the last operation did not succeed", func() {
+			infrastructure.Status.LastOperation.State = gardencorev1alpha1.LastOperationStateProcessing
+
+			Expect(IsUpToDate(infrastructure)).To(BeFalse())
+		})
+
+		It("should return false if the current generation was not observed yet", func() {
+			infrastructure.Generation = 3
+
+			Expect(IsUpToDate(infrastructure)).To(BeFalse())
+		})
+
+		It("should return false if an operation was requested", func() {
+			infrastructure.Annotations = map[string]string{v1alpha1constants.GardenerOperation: v1alpha1constants.GardenerOperationReconcile}
+
+			Expect(IsUpToDate(infrastructure)).To(BeFalse())
+		})
+
+		It("should return false if the infrastructure was migrated", func() {
+			infrastructure.Status.LastOperation.Type = extensionscontroller.LastOperationTypeMigrate
+
+			Expect(IsUpToDate(infrastructure)).To(BeFalse())
+		})
+	})
+
+	Describe("#DriftCondition", func() {
+		condition := gardencorev1alpha1helper.InitCondition(ConditionTypeInfrastructureInSync)
+
+		It("should mark the infrastructure in sync if there are no changes", func() {
+			updated := DriftCondition(condition, &extensionscontroller.DryRunResult{}, nil)
+
+			Expect(updated.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(updated.Reason).To(Equal(ConditionReasonNoDriftDetected))
+		})
+
+		It("should list the detected changes", func() {
+			updated := DriftCondition(condition, &extensionscontroller.DryRunResult{Changes: []extensionscontroller.DryRunChange{
+				{Kind: "aws_vpc", Name: "aws_vpc.vpc", Action: extensionscontroller.DryRunActionUpdate},
+				{Kind: "aws_subnet", Name: "aws_subnet.nodes_z0", Action: extensionscontroller.DryRunActionCreate},
+			}}, nil)
+
+			Expect(updated.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(updated.Reason).To(Equal(ConditionReasonDriftDetected))
+			Expect(updated.Message).To(Equal("The infrastructure drifted from its desired state (1 to create, 1 to update, 0 to delete): update aws_vpc.vpc, create aws_subnet.nodes_z0"))
+		})
+
+		It("should truncate the list of detected changes", func() {
+			result := &extensionscontroller.DryRunResult{}
+			for i := 0; i < 12; i++ {
+				result.Add(extensionscontroller.DryRunChange{Name: fmt.Sprintf("r%d", i), Action: extensionscontroller.DryRunActionDelete})
+			}
+
+			updated := DriftCondition(condition, result, nil)
+
+			Expect(updated.Message).To(HaveSuffix("delete r9, and 2 more"))
+		})
+
+		It("should set the condition to unknown if the drift could not be detected", func() {
+			updated := DriftCondition(condition, nil, fmt.Errorf("foo"))
+
+			Expect(updated.Status).To(Equal(gardencorev1alpha1.ConditionUnknown))
+			Expect(updated.Message).To(Equal("foo"))
+		})
+	})
+})
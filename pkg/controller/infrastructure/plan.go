@@ -0,0 +1,97 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TerraformPlanPurpose returns the Terraformer purpose that is used to plan the Terraform configuration of the given
+// purpose. Plans use a dedicated purpose so that they never touch the configuration, variables and state of the
+// Terraformer that applies the configuration.
+func TerraformPlanPurpose(purpose string) string {
+	return purpose + "-plan"
+}
+
+// CurrentTerraformState returns the Terraform state persisted in the status of the given Infrastructure. If the
+// status does not contain a state yet, the state is read from the seed.
+func CurrentTerraformState(infrastructure *extensionsv1alpha1.Infrastructure, tf TerraformState) ([]byte, error) {
+	state, err := GetTerraformState(infrastructure)
+	if err != nil || len(state) > 0 {
+		return state, err
+	}
+
+	state, err = tf.GetState()
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	return state, nil
+}
+
+// PlanTerraform plans the configuration produced by the given Initializer against the given Terraform state without
+// applying it. The given Terraformer must use a purpose computed by TerraformPlanPurpose since its configuration,
+// variables and state are removed before and after the plan.
+func PlanTerraform(ctx context.Context, c client.Client, tf terraformer.Interface, initializer terraformer.Initializer, state []byte) (*extensionscontroller.DryRunResult, error) {
+	if err := tf.CleanupConfiguration(ctx); err != nil {
+		return nil, err
+	}
+	defer tf.CleanupConfiguration(ctx)
+
+	plan, err := tf.InitializeWith(terraformer.WithState(c, initializer, state)).Plan()
+	if err != nil {
+		return nil, err
+	}
+
+	return DryRunResultFromTerraformPlan(plan), nil
+}
+
+// DryRunResultFromTerraformPlan converts the given Terraform plan into a dry-run result. Replaced resources are
+// reported as a deletion and a creation, just like Terraform counts them.
+func DryRunResultFromTerraformPlan(plan *terraformer.Plan) *extensionscontroller.DryRunResult {
+	result := &extensionscontroller.DryRunResult{Time: metav1.Now()}
+
+	for _, change := range plan.Changes {
+		dryRunChange := extensionscontroller.DryRunChange{Kind: change.Type, Name: change.Address}
+
+		switch change.Action {
+		case terraformer.ResourceChangeActionCreate:
+			dryRunChange.Action = extensionscontroller.DryRunActionCreate
+		case terraformer.ResourceChangeActionUpdate:
+			dryRunChange.Action = extensionscontroller.DryRunActionUpdate
+		case terraformer.ResourceChangeActionDelete:
+			dryRunChange.Action = extensionscontroller.DryRunActionDelete
+		case terraformer.ResourceChangeActionReplace:
+			deletion := dryRunChange
+			deletion.Action = extensionscontroller.DryRunActionDelete
+			result.Add(deletion)
+			dryRunChange.Action = extensionscontroller.DryRunActionCreate
+		default:
+			continue
+		}
+
+		result.Add(dryRunChange)
+	}
+
+	return result
+}
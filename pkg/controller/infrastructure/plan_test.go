@@ -0,0 +1,83 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	. "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	mockterraformer "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/gardener/terraformer"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	var ctrl *gomock.Controller
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#PlanTerraform", func() {
+		It("should plan with a clean configuration and remove it afterwards", func() {
+			var (
+				ctx         = context.TODO()
+				c           = mockclient.NewMockClient(ctrl)
+				tf          = mockterraformer.NewMockInterface(ctrl)
+				initializer = mockterraformer.NewMockInitializer(ctrl)
+			)
+
+			gomock.InOrder(
+				tf.EXPECT().CleanupConfiguration(ctx),
+				tf.EXPECT().InitializeWith(initializer).Return(tf),
+				tf.EXPECT().Plan().Return(&terraformer.Plan{Changes: []terraformer.ResourceChange{
+					{Address: "aws_vpc.vpc", Type: "aws_vpc", Action: terraformer.ResourceChangeActionCreate},
+				}}, nil),
+				tf.EXPECT().CleanupConfiguration(ctx),
+			)
+
+			result, err := PlanTerraform(ctx, c, tf, initializer, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Changes).To(Equal([]extensionscontroller.DryRunChange{
+				{Kind: "aws_vpc", Name: "aws_vpc.vpc", Action: extensionscontroller.DryRunActionCreate},
+			}))
+		})
+	})
+
+	Describe("#DryRunResultFromTerraformPlan", func() {
+		It("should report replacements as deletion and creation", func() {
+			result := DryRunResultFromTerraformPlan(&terraformer.Plan{Changes: []terraformer.ResourceChange{
+				{Address: "aws_subnet.nodes_z0", Type: "aws_subnet", Action: terraformer.ResourceChangeActionReplace},
+				{Address: "aws_eip.eip_natgw_z0", Type: "aws_eip", Action: terraformer.ResourceChangeActionDelete},
+			}})
+
+			Expect(result.Changes).To(Equal([]extensionscontroller.DryRunChange{
+				{Kind: "aws_subnet", Name: "aws_subnet.nodes_z0", Action: extensionscontroller.DryRunActionDelete},
+				{Kind: "aws_subnet", Name: "aws_subnet.nodes_z0", Action: extensionscontroller.DryRunActionCreate},
+				{Kind: "aws_eip", Name: "aws_eip.eip_natgw_z0", Action: extensionscontroller.DryRunActionDelete},
+			}))
+			Expect(result.Summary()).To(Equal("1 to create, 0 to update, 2 to delete"))
+		})
+	})
+})
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -29,6 +30,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
@@ -45,11 +47,18 @@ const (
 	EventInfrastructureReconciliation string = "InfrastructureReconciliation"
 	// EventInfrastructureDeleton an event reason to describe infrastructure deletion.
 	EventInfrastructureDeleton string = "InfrastructureDeleton"
+	// EventInfrastructureMigration an event reason to describe infrastructure migration.
+	EventInfrastructureMigration string = "InfrastructureMigration"
+	// EventInfrastructureRestoration an event reason to describe infrastructure restoration.
+	EventInfrastructureRestoration string = "InfrastructureRestoration"
+	// EventInfrastructureDryRun an event reason to describe an infrastructure dry-run.
+	EventInfrastructureDryRun string = "InfrastructureDryRun"
 )
 
 type reconciler struct {
 	logger   logr.Logger
 	actuator Actuator
+	dryRun   bool
 
 	ctx      context.Context
 	client   client.Client
@@ -58,12 +67,14 @@ type reconciler struct {
 
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // infrastructure resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+// If dryRun is true, all infrastructure resources are only dry-run instead of being reconciled.
+func NewReconciler(mgr manager.Manager, actuator Actuator, dryRun bool) reconcile.Reconciler {
 	return extensionscontroller.OperationAnnotationWrapper(
 		&extensionsv1alpha1.Infrastructure{},
 		&reconciler{
 			logger:   log.Log.WithName(ControllerName),
 			actuator: actuator,
+			dryRun:   dryRun,
 			recorder: mgr.GetEventRecorderFor(ControllerName),
 		},
 	)
@@ -97,10 +108,22 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
-	if infrastructure.DeletionTimestamp != nil {
+	operationType := extensionscontroller.ComputeOperationType(infrastructure.ObjectMeta, infrastructure.Status.LastOperation)
+
+	switch {
+	case r.dryRun || extensionscontroller.IsDryRun(infrastructure.ObjectMeta):
+		return r.dryRunInfrastructure(r.ctx, infrastructure, cluster)
+	case operationType == extensionscontroller.LastOperationTypeMigrate:
+		return r.migrate(r.ctx, infrastructure, cluster)
+	case operationType == extensionscontroller.LastOperationTypeRestore:
+		return r.restore(r.ctx, infrastructure, cluster)
+	case extensionscontroller.IsMigrated(infrastructure):
+		return reconcile.Result{}, nil
+	case infrastructure.DeletionTimestamp != nil:
 		return r.delete(r.ctx, infrastructure, cluster)
+	default:
+		return r.reconcile(r.ctx, infrastructure, cluster)
 	}
-	return r.reconcile(r.ctx, infrastructure, cluster)
 }
 
 func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
@@ -108,14 +131,18 @@ func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1
 		return reconcile.Result{}, err
 	}
 
-	operationType := v1alpha1constantshelper.ComputeOperationType(infrastructure.ObjectMeta, infrastructure.Status.LastOperation)
+	operationType := extensionscontroller.ComputeOperationType(infrastructure.ObjectMeta, infrastructure.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, infrastructure, operationType, "Reconciling the infrastructure"); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	r.logger.Info("Starting the reconciliation of infrastructure", "infrastructure", infrastructure.Name)
 	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureReconciliation, "Reconciling the infrastructure")
-	if err := r.actuator.Reconcile(ctx, infrastructure, cluster); err != nil {
+	progressCtx, flushProgress := extensionscontroller.NewProgressContext(ctx, r.client, infrastructure)
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.InfrastructureResource, infrastructure.Spec.Type, operationType)
+	err := observe(r.actuator.Reconcile(progressCtx, infrastructure, cluster))
+	flushProgress()
+	if err != nil {
 		msg := "Error reconciling infrastructure"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
 		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
@@ -143,14 +170,18 @@ func (r *reconciler) delete(ctx context.Context, infrastructure *extensionsv1alp
 		return reconcile.Result{}, nil
 	}
 
-	operationType := v1alpha1constantshelper.ComputeOperationType(infrastructure.ObjectMeta, infrastructure.Status.LastOperation)
+	operationType := extensionscontroller.ComputeOperationType(infrastructure.ObjectMeta, infrastructure.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, infrastructure, operationType, "Deleting the infrastructure"); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	r.logger.Info("Starting the deletion of infrastructure", "infrastructure", infrastructure.Name)
 	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureDeleton, "Deleting the infrastructure")
-	if err := r.actuator.Delete(r.ctx, infrastructure, cluster); err != nil {
+	progressCtx, flushProgress := extensionscontroller.NewProgressContext(r.ctx, r.client, infrastructure)
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.InfrastructureResource, infrastructure.Spec.Type, operationType)
+	err = observe(r.actuator.Delete(progressCtx, infrastructure, cluster))
+	flushProgress()
+	if err != nil {
 		msg := "Error deleting infrastructure"
 		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureDeleton, "%s: %+v", msg, err)
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
@@ -174,6 +205,124 @@ func (r *reconciler) delete(ctx context.Context, infrastructure *extensionsv1alp
 	return reconcile.Result{}, nil
 }
 
+func (r *reconciler) migrate(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	operationType := extensionscontroller.LastOperationTypeMigrate
+	if err := r.updateStatusProcessing(ctx, infrastructure, operationType, "Migrating the infrastructure"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the migration of infrastructure", "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureMigration, "Migrating the infrastructure")
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.InfrastructureResource, infrastructure.Spec.Type, operationType)
+	if err := observe(r.actuator.Migrate(ctx, infrastructure, cluster)); err != nil {
+		msg := "Error migrating infrastructure"
+		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureMigration, "%s: %+v", msg, err)
+		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
+		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully migrated infrastructure"
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureMigration, msg)
+	if err := r.updateStatusSuccess(ctx, infrastructure, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Removing finalizer.", "infrastructure", infrastructure.Name)
+	if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, infrastructure); err != nil {
+		r.logger.Error(err, "Error removing finalizer from Infrastructure", "infrastructure", infrastructure.Name)
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, infrastructure); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) restore(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, FinalizerName, infrastructure); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	operationType := extensionscontroller.LastOperationTypeRestore
+	if err := r.updateStatusProcessing(ctx, infrastructure, operationType, "Restoring the infrastructure"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the restoration of infrastructure", "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureRestoration, "Restoring the infrastructure")
+	progressCtx, flushProgress := extensionscontroller.NewProgressContext(ctx, r.client, infrastructure)
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.InfrastructureResource, infrastructure.Spec.Type, operationType)
+	err := observe(r.actuator.Restore(progressCtx, infrastructure, cluster))
+	flushProgress()
+	if err != nil {
+		msg := "Error restoring infrastructure"
+		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureRestoration, "%s: %+v", msg, err)
+		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
+		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully restored infrastructure"
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureRestoration, msg)
+	if err := r.updateStatusSuccess(ctx, infrastructure, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, infrastructure); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) dryRunInfrastructure(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	result := &extensionscontroller.DryRunResult{Time: metav1.Now()}
+
+	if infrastructure.DeletionTimestamp != nil {
+		result.Add(extensionscontroller.DryRunChange{
+			Kind:      extensionsv1alpha1.InfrastructureResource,
+			Namespace: infrastructure.Namespace,
+			Name:      infrastructure.Name,
+			Action:    extensionscontroller.DryRunActionDelete,
+		})
+	} else {
+		dryRunner, ok := r.actuator.(DryRunner)
+		if !ok {
+			msg := "Dry-run is not supported for this infrastructure"
+			r.logger.Info(msg, "infrastructure", infrastructure.Name)
+			r.recorder.Event(infrastructure, corev1.EventTypeWarning, EventInfrastructureDryRun, msg)
+			return reconcile.Result{}, extensionscontroller.RemoveDryRunOperationAnnotation(ctx, r.client, infrastructure)
+		}
+
+		r.logger.Info("Starting the dry-run of infrastructure", "infrastructure", infrastructure.Name)
+		changes, err := dryRunner.DryRun(ctx, infrastructure, cluster)
+		if err != nil {
+			msg := "Error during dry-run of infrastructure"
+			r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureDryRun, "%s: %+v", msg, err)
+			r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
+			return extensionscontroller.ReconcileErr(err)
+		}
+		if changes != nil {
+			result.Add(changes.Changes...)
+		}
+	}
+
+	if err := extensionscontroller.SaveDryRunResult(ctx, r.client, infrastructure, result); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	msg := fmt.Sprintf("Finished dry-run of infrastructure: %s", result.Summary())
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureDryRun, msg)
+
+	return reconcile.Result{}, extensionscontroller.RemoveDryRunOperationAnnotation(ctx, r.client, infrastructure)
+}
+
 func (r *reconciler) updateStatusProcessing(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, infrastructure, func() error {
 		infrastructure.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
@@ -193,6 +342,11 @@ func (r *reconciler) updateStatusSuccess(ctx context.Context, infrastructure *ex
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, infrastructure, func() error {
 		infrastructure.Status.ObservedGeneration = infrastructure.Generation
 		infrastructure.Status.LastOperation, infrastructure.Status.LastError = extensionscontroller.ReconcileSucceeded(lastOperationType, description)
+		if condition := v1alpha1constantshelper.GetCondition(infrastructure.Status.Conditions, ConditionTypeInfrastructureInSync); condition != nil && lastOperationType != gardencorev1alpha1.LastOperationTypeDelete && lastOperationType != extensionscontroller.LastOperationTypeMigrate {
+			// The actual infrastructure matches the desired infrastructure right after it has been reconciled.
+			updated := v1alpha1constantshelper.UpdatedCondition(*condition, gardencorev1alpha1.ConditionTrue, ConditionReasonReconciled, "The infrastructure has been reconciled.")
+			infrastructure.Status.Conditions = v1alpha1constantshelper.MergeConditions(infrastructure.Status.Conditions, updated)
+		}
 		return nil
 	})
 }
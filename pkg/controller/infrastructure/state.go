@@ -0,0 +1,80 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TerraformState provides access to the Terraform state of an Infrastructure that is stored in the seed.
+type TerraformState interface {
+	// GetState returns the Terraform state.
+	GetState() ([]byte, error)
+	// CleanupConfiguration deletes the Terraform configuration, variables and state.
+	CleanupConfiguration(ctx context.Context) error
+}
+
+// GetTerraformState returns the Terraform state persisted in the status of the given Infrastructure. It is
+// meant to initialize the Terraform state in case it is missing in the seed.
+func GetTerraformState(infrastructure *extensionsv1alpha1.Infrastructure) ([]byte, error) {
+	return extensionscontroller.GetState(infrastructure, extensionscontroller.StateKeyTerraform)
+}
+
+// ReadTerraformState reads the typed Terraform state of the given Infrastructure. It is read via the given
+// Terraformer if the Terraform configuration exists in the seed. Otherwise, e.g. after a migration, it is parsed from
+// the Terraform state persisted in the status of the Infrastructure, which results in an empty state if there is none.
+func ReadTerraformState(tf extensionsterraformer.StateGetter, configExists bool, infrastructure *extensionsv1alpha1.Infrastructure) (*extensionsterraformer.State, error) {
+	if configExists {
+		return extensionsterraformer.ReadState(tf)
+	}
+
+	state, err := GetTerraformState(infrastructure)
+	if err != nil {
+		return nil, err
+	}
+	return extensionsterraformer.ParseState(state)
+}
+
+// SaveTerraformState persists the Terraform state of the given Terraformer in the status of the given
+// Infrastructure. Nothing is persisted if the Terraform state does not exist in the seed.
+func SaveTerraformState(ctx context.Context, c client.Client, tf TerraformState, infrastructure *extensionsv1alpha1.Infrastructure) error {
+	state, err := tf.GetState()
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	return extensionscontroller.SaveState(ctx, c, infrastructure, extensionscontroller.StateKeyTerraform, state)
+}
+
+// MigrateTerraformState persists the Terraform state of the given Terraformer in the status of the given
+// Infrastructure and removes the Terraform configuration, variables and state from the seed afterwards.
+func MigrateTerraformState(ctx context.Context, c client.Client, tf TerraformState, infrastructure *extensionsv1alpha1.Infrastructure) error {
+	if err := SaveTerraformState(ctx, c, tf, infrastructure); err != nil {
+		return err
+	}
+
+	return tf.CleanupConfiguration(ctx)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	. "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	mockterraformer "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/gardener/terraformer"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("State", func() {
+	var (
+		ctrl  *gomock.Controller
+		tf    *mockterraformer.MockInterface
+		infra *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		tf = mockterraformer.NewMockInterface(ctrl)
+		infra = &extensionsv1alpha1.Infrastructure{}
+		Expect(extensionscontroller.SetState(infra, extensionscontroller.StateKeyTerraform, []byte(`{"version":4,"outputs":{"vpc_id":{"value":"vpc-1234"}}}`))).To(Succeed())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#ReadTerraformState", func() {
+		It("should read the state via the Terraformer if the configuration exists in the seed", func() {
+			tf.EXPECT().GetState().Return([]byte(`{"version":4,"outputs":{"vpc_id":{"value":"vpc-5678"}}}`), nil)
+
+			state, err := ReadTerraformState(tf, true, infra)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.OutputString("vpc_id")).To(Equal("vpc-5678"))
+		})
+
+		It("should return an empty state if the configuration does not exist and no state is persisted", func() {
+			state, err := ReadTerraformState(tf, false, &extensionsv1alpha1.Infrastructure{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.Outputs).To(BeEmpty())
+			Expect(state.Resources).To(BeEmpty())
+		})
+
+		It("should return the persisted state if the configuration does not exist in the seed", func() {
+			state, err := ReadTerraformState(tf, false, infra)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.OutputString("vpc_id")).To(Equal("vpc-1234"))
+		})
+	})
+})
@@ -24,7 +24,9 @@ import (
 	"github.com/gardener/gardener-resource-manager/pkg/manager"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -126,6 +128,29 @@ func DeleteManagedResource(ctx context.Context, client client.Client, namespace
 	return nil
 }
 
+// SetKeepObjects updates the keepObjects field of the managed resource with the given <name>. If the managed
+// resource does not exist then nothing happens.
+func SetKeepObjects(ctx context.Context, c client.Client, namespace, name string, keepObjects bool) error {
+	mr := &resourcesv1alpha1.ManagedResource{}
+	if err := c.Get(ctx, kutil.Key(namespace, name), mr); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "could not get managed resource '%s/%s'", namespace, name)
+	}
+
+	if mr.Spec.KeepObjects != nil && *mr.Spec.KeepObjects == keepObjects {
+		return nil
+	}
+
+	withOldKeepObjects := mr.DeepCopy()
+	mr.Spec.KeepObjects = &keepObjects
+	if err := c.Patch(ctx, mr, client.MergeFrom(withOldKeepObjects)); err != nil {
+		return errors.Wrapf(err, "could not update keepObjects of managed resource '%s/%s'", namespace, name)
+	}
+	return nil
+}
+
 // WaitUntilManagedResourceDeleted waits until the given managed resource is deleted.
 func WaitUntilManagedResourceDeleted(ctx context.Context, client client.Client, namespace, name string) error {
 	mr := &resourcesv1alpha1.ManagedResource{
@@ -0,0 +1,177 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strings"
+	"time"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// ResultSuccess is the result label value for operations that succeeded.
+	ResultSuccess = "success"
+	// ResultError is the result label value for operations that failed.
+	ResultError = "error"
+	// ResultRequeue is the result label value for operations that requested to be requeued without failing.
+	ResultRequeue = "requeue"
+
+	// ErrorClassUnknown is the error class label value for failed operations whose error could not be classified.
+	ErrorClassUnknown = "unknown"
+)
+
+var (
+	operationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gardener_extensions_operations_total",
+			Help: "Total number of operations of the extension actuators, partitioned by kind, type, operation, result, and error class.",
+		},
+		[]string{"kind", "type", "operation", "result", "error_class"},
+	)
+
+	operationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "gardener_extensions_operation_duration_seconds",
+			Help:    "Duration of the operations of the extension actuators in seconds, partitioned by kind, type, operation, and result.",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+		},
+		[]string{"kind", "type", "operation", "result"},
+	)
+
+	terraformApplyDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "gardener_extensions_terraform_apply_duration_seconds",
+			Help:    "Duration of Terraform applies in seconds, partitioned by purpose and result.",
+			Buckets: prometheus.ExponentialBuckets(5, 2, 10),
+		},
+		[]string{"purpose", "result"},
+	)
+
+	machineDeploymentsReadyDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "gardener_extensions_machine_deployments_ready_duration_seconds",
+			Help:    "Duration of waiting until all machine deployments of a worker are ready in seconds, partitioned by type and result.",
+			Buckets: prometheus.ExponentialBuckets(5, 2, 8),
+		},
+		[]string{"type", "result"},
+	)
+
+	backoffReconcileFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gardener_extensions_backoff_reconcile_failures_total",
+			Help: "Total number of failed reconciliations that were requeued with the failure backoff of the controller tuning instead of being returned to the controller, partitioned by controller.",
+		},
+		[]string{"controller"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(operationsTotal, operationDuration, terraformApplyDuration, machineDeploymentsReadyDuration, backoffReconcileFailuresTotal)
+}
+
+// ObserveFunc records the outcome of an operation that has been started before and returns the given error.
+type ObserveFunc func(err error) error
+
+// ObserveOperation starts the observation of an operation of the given kind, e.g. `Infrastructure`, and extension
+// type, e.g. `aws`. The returned ObserveFunc has to be called with the error of the operation once it finished.
+func ObserveOperation(kind, extensionType string, operation gardencorev1alpha1.LastOperationType) ObserveFunc {
+	start := time.Now()
+	op := strings.ToLower(string(operation))
+
+	return func(err error) error {
+		result := Result(err)
+		operationsTotal.WithLabelValues(kind, extensionType, op, result, ErrorClass(err)).Inc()
+		operationDuration.WithLabelValues(kind, extensionType, op, result).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+// ObserveTerraformApply starts the observation of a Terraform apply with the given purpose, e.g. `infra`. The
+// returned ObserveFunc has to be called with the error of the apply once it finished.
+func ObserveTerraformApply(purpose string) ObserveFunc {
+	start := time.Now()
+
+	return func(err error) error {
+		terraformApplyDuration.WithLabelValues(purpose, Result(err)).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+// ObserveMachineDeploymentsReady starts the observation of waiting until all machine deployments of a worker of the
+// given type are ready. The returned ObserveFunc has to be called with the error of the wait once it finished.
+func ObserveMachineDeploymentsReady(workerType string) ObserveFunc {
+	start := time.Now()
+
+	return func(err error) error {
+		machineDeploymentsReadyDuration.WithLabelValues(workerType, Result(err)).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+// ObserveBackoffReconcileFailure records a failed reconciliation of the controller with the given name that is
+// requeued with a failure backoff. Such failures are not counted by the controller-runtime as the error is not
+// returned to the controller.
+func ObserveBackoffReconcileFailure(controller string) {
+	backoffReconcileFailuresTotal.WithLabelValues(controller).Inc()
+}
+
+// Result returns the result label value for the given error.
+func Result(err error) string {
+	switch {
+	case err == nil:
+		return ResultSuccess
+	case isRequeue(err):
+		return ResultRequeue
+	default:
+		return ResultError
+	}
+}
+
+// ErrorClass returns the error class label value for the given error. It is the first error code that is either
+// attached to the error or detected in its message. It is empty if the error is nil and ErrorClassUnknown if no
+// error code could be determined.
+func ErrorClass(err error) string {
+	if err == nil || isRequeue(err) {
+		return ""
+	}
+	if requeueAfter, ok := err.(*controllererror.RequeueAfterError); ok {
+		err = requeueAfter.Cause
+	}
+
+	codes := gardencorev1alpha1helper.ExtractErrorCodes(err)
+	if len(codes) == 0 {
+		if coder, ok := gardencorev1alpha1helper.DetermineError(err.Error()).(gardencorev1alpha1helper.Coder); ok {
+			codes = append(codes, coder.Code())
+		}
+	}
+
+	if len(codes) == 0 {
+		return ErrorClassUnknown
+	}
+	return string(codes[0])
+}
+
+// isRequeue checks whether the given error only requests a requeue without being caused by a failure.
+func isRequeue(err error) bool {
+	requeueAfter, ok := err.(*controllererror.RequeueAfterError)
+	return ok && requeueAfter.Cause == nil
+}
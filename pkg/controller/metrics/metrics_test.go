@@ -0,0 +1,172 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"errors"
+	"time"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	. "github.com/gardener/gardener-extensions/pkg/controller/metrics"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// findMetric returns the metric of the family with the given name whose labels equal the given ones.
+func findMetric(name string, labels map[string]string) *dto.Metric {
+	families, err := metrics.Registry.Gather()
+	Expect(err).NotTo(HaveOccurred())
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	metrics:
+		for _, metric := range family.GetMetric() {
+			if len(metric.GetLabel()) != len(labels) {
+				continue
+			}
+			for _, label := range metric.GetLabel() {
+				if labels[label.GetName()] != label.GetValue() {
+					continue metrics
+				}
+			}
+			return metric
+		}
+	}
+	return nil
+}
+
+var _ = Describe("Metrics", func() {
+	Describe("#Result", func() {
+		It("should return success if there is no error", func() {
+			Expect(Result(nil)).To(Equal(ResultSuccess))
+		})
+
+		It("should return requeue if the error only requests a requeue", func() {
+			Expect(Result(&controllererror.RequeueAfterError{RequeueAfter: time.Minute})).To(Equal(ResultRequeue))
+		})
+
+		It("should return error if the requeue is caused by an error", func() {
+			Expect(Result(&controllererror.RequeueAfterError{Cause: errors.New("foo")})).To(Equal(ResultError))
+		})
+
+		It("should return error for any other error", func() {
+			Expect(Result(errors.New("foo"))).To(Equal(ResultError))
+		})
+	})
+
+	Describe("#ErrorClass", func() {
+		It("should return an empty class if there is no error", func() {
+			Expect(ErrorClass(nil)).To(BeEmpty())
+		})
+
+		It("should return an empty class if the error only requests a requeue", func() {
+			Expect(ErrorClass(&controllererror.RequeueAfterError{RequeueAfter: time.Minute})).To(BeEmpty())
+		})
+
+		It("should return the code attached to the error", func() {
+			err := gardencorev1alpha1helper.DetermineError("Quota exceeded")
+
+			Expect(ErrorClass(err)).To(Equal(string(gardencorev1alpha1.ErrorInfraQuotaExceeded)))
+		})
+
+		It("should return the code attached to the cause of a requeue", func() {
+			err := &controllererror.RequeueAfterError{Cause: gardencorev1alpha1helper.DetermineError("AccessDenied")}
+
+			Expect(ErrorClass(err)).To(Equal(string(gardencorev1alpha1.ErrorInfraInsufficientPrivileges)))
+		})
+
+		It("should detect the code from the error message", func() {
+			Expect(ErrorClass(errors.New("request failed: Unauthorized"))).To(Equal(string(gardencorev1alpha1.ErrorInfraUnauthorized)))
+		})
+
+		It("should return unknown if no code can be determined", func() {
+			Expect(ErrorClass(errors.New("foo"))).To(Equal(ErrorClassUnknown))
+		})
+	})
+
+	Describe("#ObserveOperation", func() {
+		It("should return the given error and record the operation", func() {
+			err := errors.New("DependencyViolation")
+
+			Expect(ObserveOperation("Infrastructure", "test-observe", gardencorev1alpha1.LastOperationTypeReconcile)(err)).To(BeIdenticalTo(err))
+
+			counter := findMetric("gardener_extensions_operations_total", map[string]string{
+				"kind":        "Infrastructure",
+				"type":        "test-observe",
+				"operation":   "reconcile",
+				"result":      ResultError,
+				"error_class": string(gardencorev1alpha1.ErrorInfraDependencies),
+			})
+			Expect(counter).NotTo(BeNil())
+			Expect(counter.GetCounter().GetValue()).To(Equal(float64(1)))
+
+			histogram := findMetric("gardener_extensions_operation_duration_seconds", map[string]string{
+				"kind":      "Infrastructure",
+				"type":      "test-observe",
+				"operation": "reconcile",
+				"result":    ResultError,
+			})
+			Expect(histogram).NotTo(BeNil())
+			Expect(histogram.GetHistogram().GetSampleCount()).To(Equal(uint64(1)))
+		})
+	})
+
+	Describe("#ObserveBackoffReconcileFailure", func() {
+		It("should count the failure for the controller", func() {
+			ObserveBackoffReconcileFailure("test-observe")
+			ObserveBackoffReconcileFailure("test-observe")
+
+			counter := findMetric("gardener_extensions_backoff_reconcile_failures_total", map[string]string{
+				"controller": "test-observe",
+			})
+			Expect(counter).NotTo(BeNil())
+			Expect(counter.GetCounter().GetValue()).To(Equal(float64(2)))
+		})
+	})
+
+	Describe("#ObserveTerraformApply", func() {
+		It("should return the given error and record the apply", func() {
+			Expect(ObserveTerraformApply("test-observe")(nil)).To(Succeed())
+
+			histogram := findMetric("gardener_extensions_terraform_apply_duration_seconds", map[string]string{
+				"purpose": "test-observe",
+				"result":  ResultSuccess,
+			})
+			Expect(histogram).NotTo(BeNil())
+			Expect(histogram.GetHistogram().GetSampleCount()).To(Equal(uint64(1)))
+		})
+	})
+
+	Describe("#ObserveMachineDeploymentsReady", func() {
+		It("should return the given error and record the wait", func() {
+			Expect(ObserveMachineDeploymentsReady("test-observe")(nil)).To(Succeed())
+
+			histogram := findMetric("gardener_extensions_machine_deployments_ready_duration_seconds", map[string]string{
+				"type":   "test-observe",
+				"result": ResultSuccess,
+			})
+			Expect(histogram).NotTo(BeNil())
+			Expect(histogram.GetHistogram().GetSampleCount()).To(Equal(uint64(1)))
+		})
+	})
+})
@@ -28,4 +28,8 @@ type Actuator interface {
 	Reconcile(context.Context, *extensionsv1alpha1.Network, *extensioncontroller.Cluster) error
 	// Delete deletes the Network resource.
 	Delete(context.Context, *extensionsv1alpha1.Network, *extensioncontroller.Cluster) error
+	// Migrate releases all seed-local resources of the Network resource while keeping the objects in the shoot.
+	Migrate(context.Context, *extensionsv1alpha1.Network, *extensioncontroller.Cluster) error
+	// Restore rebuilds the seed-local resources of the Network resource after a migration.
+	Restore(context.Context, *extensionsv1alpha1.Network, *extensioncontroller.Cluster) error
 }
@@ -15,6 +15,7 @@
 package network
 
 import (
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -79,7 +80,7 @@ func Add(mgr manager.Manager, args AddArgs) error {
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
 func add(mgr manager.Manager, args AddArgs) error {
-	ctrl, err := controller.New(ControllerName, mgr, args.ControllerOptions)
+	ctrl, err := extensionscontroller.NewController(ControllerName, mgr, &extensionsv1alpha1.Network{}, args.ControllerOptions)
 	if err != nil {
 		return err
 	}
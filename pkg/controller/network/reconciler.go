@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -42,6 +43,10 @@ const (
 	EventNetworkReconciliation string = "NetworkReconciliation"
 	// EventNetworkDeletion an event reason to describe network deletion.
 	EventNetworkDeletion string = "NetworkDeletion"
+	// EventNetworkMigration an event reason to describe network migration.
+	EventNetworkMigration string = "NetworkMigration"
+	// EventNetworkRestoration an event reason to describe network restoration.
+	EventNetworkRestoration string = "NetworkRestoration"
 )
 
 type reconciler struct {
@@ -94,11 +99,20 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
-	if network.DeletionTimestamp != nil {
+	operationType := extensionscontroller.ComputeOperationType(network.ObjectMeta, network.Status.LastOperation)
+
+	switch {
+	case operationType == extensionscontroller.LastOperationTypeMigrate:
+		return r.migrate(r.ctx, network, cluster)
+	case operationType == extensionscontroller.LastOperationTypeRestore:
+		return r.restore(r.ctx, network, cluster)
+	case extensionscontroller.IsMigrated(network):
+		return reconcile.Result{}, nil
+	case network.DeletionTimestamp != nil:
 		return r.delete(r.ctx, network, cluster)
+	default:
+		return r.reconcile(r.ctx, network, cluster)
 	}
-
-	return r.reconcile(r.ctx, network, cluster)
 }
 
 func (r *reconciler) reconcile(ctx context.Context, network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
@@ -106,14 +120,15 @@ func (r *reconciler) reconcile(ctx context.Context, network *extensionsv1alpha1.
 		return reconcile.Result{}, err
 	}
 
-	operationType := v1alpha1constantshelper.ComputeOperationType(network.ObjectMeta, network.Status.LastOperation)
+	operationType := extensionscontroller.ComputeOperationType(network.ObjectMeta, network.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, network, operationType, "Reconciling the network"); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	r.logger.Info("Starting the reconciliation of network", "network", network.Name)
 	r.recorder.Event(network, corev1.EventTypeNormal, EventNetworkReconciliation, "Reconciling the network")
-	if err := r.actuator.Reconcile(ctx, network, cluster); err != nil {
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.NetworkResource, network.Spec.Type, operationType)
+	if err := observe(r.actuator.Reconcile(ctx, network, cluster)); err != nil {
 		msg := "Error reconciling network"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), network, operationType, msg))
 		r.logger.Error(err, msg, "network", network.Name)
@@ -141,14 +156,15 @@ func (r *reconciler) delete(ctx context.Context, network *extensionsv1alpha1.Net
 		return reconcile.Result{}, nil
 	}
 
-	operationType := v1alpha1constantshelper.ComputeOperationType(network.ObjectMeta, network.Status.LastOperation)
+	operationType := extensionscontroller.ComputeOperationType(network.ObjectMeta, network.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, network, operationType, "Deleting the network"); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	r.logger.Info("Starting the deletion of network", "network", network.Name)
 	r.recorder.Event(network, corev1.EventTypeNormal, EventNetworkDeletion, "Deleting the network")
-	if err := r.actuator.Delete(r.ctx, network, cluster); err != nil {
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.NetworkResource, network.Spec.Type, operationType)
+	if err := observe(r.actuator.Delete(r.ctx, network, cluster)); err != nil {
 		msg := "Error deleting network"
 		r.recorder.Eventf(network, corev1.EventTypeWarning, EventNetworkDeletion, "%s: %+v", msg, err)
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), network, operationType, msg))
@@ -172,6 +188,78 @@ func (r *reconciler) delete(ctx context.Context, network *extensionsv1alpha1.Net
 	return reconcile.Result{}, nil
 }
 
+func (r *reconciler) migrate(ctx context.Context, network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	operationType := extensionscontroller.LastOperationTypeMigrate
+	if err := r.updateStatusProcessing(ctx, network, operationType, "Migrating the network"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the migration of network", "network", network.Name)
+	r.recorder.Event(network, corev1.EventTypeNormal, EventNetworkMigration, "Migrating the network")
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.NetworkResource, network.Spec.Type, operationType)
+	if err := observe(r.actuator.Migrate(ctx, network, cluster)); err != nil {
+		msg := "Error migrating network"
+		r.recorder.Eventf(network, corev1.EventTypeWarning, EventNetworkMigration, "%s: %+v", msg, err)
+		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), network, operationType, msg))
+		r.logger.Error(err, msg, "network", network.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully migrated network"
+	r.logger.Info(msg, "network", network.Name)
+	r.recorder.Event(network, corev1.EventTypeNormal, EventNetworkMigration, msg)
+	if err := r.updateStatusSuccess(ctx, network, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Removing finalizer.", "network", network.Name)
+	if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, network); err != nil {
+		r.logger.Error(err, "Error removing finalizer from the Network resource", "network", network.Name)
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, network); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) restore(ctx context.Context, network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, FinalizerName, network); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	operationType := extensionscontroller.LastOperationTypeRestore
+	if err := r.updateStatusProcessing(ctx, network, operationType, "Restoring the network"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the restoration of network", "network", network.Name)
+	r.recorder.Event(network, corev1.EventTypeNormal, EventNetworkRestoration, "Restoring the network")
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.NetworkResource, network.Spec.Type, operationType)
+	if err := observe(r.actuator.Restore(ctx, network, cluster)); err != nil {
+		msg := "Error restoring network"
+		r.recorder.Eventf(network, corev1.EventTypeWarning, EventNetworkRestoration, "%s: %+v", msg, err)
+		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), network, operationType, msg))
+		r.logger.Error(err, msg, "network", network.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully restored network"
+	r.logger.Info(msg, "network", network.Name)
+	r.recorder.Event(network, corev1.EventTypeNormal, EventNetworkRestoration, msg)
+	if err := r.updateStatusSuccess(ctx, network, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, network); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
 func (r *reconciler) updateStatusProcessing(ctx context.Context, network *extensionsv1alpha1.Network, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, network, func() error {
 		network.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
@@ -15,6 +15,7 @@
 package operatingsystemconfig
 
 import (
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
@@ -78,7 +79,7 @@ func DefaultPredicates(typeName string, ignoreOperationAnnotation bool) []predic
 }
 
 func add(mgr manager.Manager, options controller.Options, predicates []predicate.Predicate) error {
-	ctrl, err := controller.New(ControllerName, mgr, options)
+	ctrl, err := extensionscontroller.NewController(ControllerName, mgr, &extensionsv1alpha1.OperatingSystemConfig{}, options)
 	if err != nil {
 		return err
 	}
@@ -28,14 +28,23 @@ var (
 // DescribeTest returns a function which can be used in tests for the
 // template generator implementation. It receives an instance of a template
 // generator and a packr Box with the test files to be used in the tests.
+// The expected output is read from the `cloud-init` test file.
 var DescribeTest = func(g generator.Generator, box packr.Box) func() {
+	return DescribeFormatTest(g, box, "cloud-init")
+}
+
+// DescribeFormatTest returns a function which can be used in tests for
+// generator implementations of any format. It receives an instance of a
+// generator, a packr Box with the test files and the name of the test file
+// with the expected output.
+var DescribeFormatTest = func(g generator.Generator, box packr.Box, file string) func() {
 	return func() {
 
 		ginkgo.It("should render correctly", func() {
-			expectedCloudInit, err := box.Find("cloud-init")
+			expected, err := box.Find(file)
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 
-			cloudInit, _, err := g.Generate(&generator.OperatingSystemConfig{
+			actual, _, err := g.Generate(&generator.OperatingSystemConfig{
 				Files: []*generator.File{
 					{
 						Path:        "/foo",
@@ -60,7 +69,7 @@ var DescribeTest = func(g generator.Generator, box packr.Box) func() {
 			})
 
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
-			gomega.Expect(cloudInit).To(gomega.Equal(expectedCloudInit))
+			gomega.Expect(actual).To(gomega.Equal(expected))
 		})
 	}
 }
@@ -0,0 +1,148 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignition
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
+)
+
+// Version is a version of the Ignition configuration specification.
+type Version string
+
+const (
+	// V2 is the version 2 of the Ignition configuration specification, e.g. used by CoreOS Container Linux and
+	// Flatcar Container Linux.
+	V2 Version = "2.2.0"
+	// V3 is the version 3 of the Ignition configuration specification, e.g. used by Fedora CoreOS.
+	V3 Version = "3.0.0"
+
+	// rootFilesystem is the name of the root filesystem in version 2 of the specification.
+	rootFilesystem = "root"
+)
+
+type config struct {
+	Ignition ignition `json:"ignition"`
+	Storage  storage  `json:"storage"`
+	Systemd  systemd  `json:"systemd"`
+}
+
+type ignition struct {
+	Version Version `json:"version"`
+}
+
+type storage struct {
+	Files []file `json:"files,omitempty"`
+}
+
+type file struct {
+	Filesystem string       `json:"filesystem,omitempty"`
+	Path       string       `json:"path"`
+	Overwrite  *bool        `json:"overwrite,omitempty"`
+	Mode       *int32       `json:"mode,omitempty"`
+	Contents   fileContents `json:"contents"`
+}
+
+type fileContents struct {
+	Source string `json:"source"`
+}
+
+type systemd struct {
+	Units []unit `json:"units,omitempty"`
+}
+
+type unit struct {
+	Name     string   `json:"name"`
+	Enabled  *bool    `json:"enabled,omitempty"`
+	Contents string   `json:"contents,omitempty"`
+	Dropins  []dropIn `json:"dropins,omitempty"`
+}
+
+type dropIn struct {
+	Name     string `json:"name"`
+	Contents string `json:"contents"`
+}
+
+// Generator generates Ignition configurations.
+type Generator struct {
+	version Version
+	cmd     string
+}
+
+// NewGenerator creates a new Generator for the given version of the Ignition configuration specification.
+func NewGenerator(version Version, cmd string) (*Generator, error) {
+	if version != V2 && version != V3 {
+		return nil, fmt.Errorf("unsupported ignition version %q", version)
+	}
+	return &Generator{version, cmd}, nil
+}
+
+func dataURL(data []byte) string {
+	return "data:;base64," + base64.StdEncoding.EncodeToString(data)
+}
+
+// Generate generates an Ignition configuration from the given OperatingSystemConfig.
+func (g *Generator) Generate(data *generator.OperatingSystemConfig) ([]byte, *string, error) {
+	var (
+		enabled   = true
+		overwrite = true
+		cfg       = config{Ignition: ignition{Version: g.version}}
+	)
+
+	for _, f := range data.Files {
+		iFile := file{
+			Path:     f.Path,
+			Mode:     f.Permissions,
+			Contents: fileContents{Source: dataURL(f.Content)},
+		}
+		if g.version == V2 {
+			iFile.Filesystem = rootFilesystem
+		} else {
+			iFile.Overwrite = &overwrite
+		}
+		cfg.Storage.Files = append(cfg.Storage.Files, iFile)
+	}
+
+	for _, u := range data.Units {
+		iUnit := unit{
+			Name:     u.Name,
+			Enabled:  &enabled,
+			Contents: string(u.Content),
+		}
+		for _, d := range u.DropIns {
+			iUnit.Dropins = append(iUnit.Dropins, dropIn{
+				Name:     d.Name,
+				Contents: string(d.Content),
+			})
+		}
+		cfg.Systemd.Units = append(cfg.Systemd.Units, iUnit)
+	}
+
+	out, err := json.Marshal(&cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var cmd *string
+	if data.Path != nil {
+		c := fmt.Sprintf(g.cmd, *data.Path)
+		cmd = &c
+	}
+
+	return out, cmd, nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignition_test
+
+import (
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator/test"
+	. "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/ignition"
+
+	"github.com/gobuffalo/packr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/runtime"
+)
+
+var _ = Describe("Ignition Generator Test", func() {
+	var box = packr.NewBox("./testfiles")
+
+	newGenerator := func(version Version) *Generator {
+		g, err := NewGenerator(version, "")
+		runtime.Must(err)
+		return g
+	}
+
+	Describe("Conformance Tests V2", func() {
+		test.DescribeFormatTest(newGenerator(V2), box, "ignition-v2")()
+	})
+
+	Describe("Conformance Tests V3", func() {
+		test.DescribeFormatTest(newGenerator(V3), box, "ignition-v3")()
+	})
+
+	It("should fail for unsupported versions", func() {
+		_, err := NewGenerator("1.0.0", "")
+
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,103 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multipart
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
+)
+
+const (
+	// ContentTypeCloudConfig is the content type of cloud-config parts.
+	ContentTypeCloudConfig = "text/cloud-config"
+	// ContentTypeShellScript is the content type of shell script parts.
+	ContentTypeShellScript = "text/x-shellscript"
+	// ContentTypeCloudBoothook is the content type of cloud-boothook parts.
+	ContentTypeCloudBoothook = "text/cloud-boothook"
+
+	// Boundary is the boundary that separates the parts of the generated user data.
+	Boundary = "==GARDENER_BOUNDARY=="
+)
+
+// Part is a part of the combined user data.
+type Part struct {
+	// ContentType is the content type of the part, e.g. ContentTypeCloudConfig.
+	ContentType string
+	// Generator generates the content of the part.
+	Generator generator.Generator
+}
+
+// Generator generates combined-config user data, i.e. a MIME multipart message whose parts are generated by
+// other generators.
+type Generator struct {
+	parts []Part
+	cmd   string
+}
+
+// NewGenerator creates a new Generator for the given parts.
+func NewGenerator(cmd string, parts ...Part) *Generator {
+	return &Generator{parts, cmd}
+}
+
+// Generate generates combined-config user data from the given OperatingSystemConfig.
+func (g *Generator) Generate(data *generator.OperatingSystemConfig) ([]byte, *string, error) {
+	var (
+		buf bytes.Buffer
+		w   = multipart.NewWriter(&buf)
+	)
+
+	if err := w.SetBoundary(Boundary); err != nil {
+		return nil, nil, err
+	}
+
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n", Boundary)
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n\r\n")
+
+	for i, part := range g.parts {
+		content, _, err := part.Generator.Generate(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not generate part %d of type %s: %v", i, part.ContentType, err)
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", fmt.Sprintf("%s; charset=%q", part.ContentType, "us-ascii"))
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Transfer-Encoding", "7bit")
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := pw.Write(content); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	var cmd *string
+	if data.Path != nil {
+		c := fmt.Sprintf(g.cmd, *data.Path)
+		cmd = &c
+	}
+
+	return buf.Bytes(), cmd, nil
+}
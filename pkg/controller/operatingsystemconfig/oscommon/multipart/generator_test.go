@@ -0,0 +1,52 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multipart_test
+
+import (
+	"text/template"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator/test"
+	. "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/multipart"
+	templategen "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/template"
+
+	"github.com/gobuffalo/packr"
+	. "github.com/onsi/ginkgo"
+)
+
+var cloudConfigTemplate = template.Must(template.New("cloud-config").Parse(`#cloud-config
+write_files:
+{{ range $_, $file := .Files -}}
+- path: '{{ $file.Path }}'
+  encoding: b64
+  content: |
+    {{ $file.Content }}
+{{ end -}}
+`))
+
+var _ = Describe("Multipart Generator Test", func() {
+	Describe("Conformance Tests", func() {
+		var box = packr.NewBox("./testfiles")
+		test.DescribeFormatTest(NewGenerator("",
+			Part{
+				ContentType: ContentTypeCloudConfig,
+				Generator:   templategen.NewCloudInitGenerator(cloudConfigTemplate, templategen.DefaultUnitsPath, ""),
+			},
+			Part{
+				ContentType: ContentTypeShellScript,
+				Generator:   templategen.NewScriptGenerator(nil, templategen.DefaultUnitsPath, ""),
+			},
+		), box, "multipart")()
+	})
+})
@@ -0,0 +1,68 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"text/template"
+)
+
+// DefaultScriptCommand is the default command that executes a shell script generated by the ScriptGenerator.
+const DefaultScriptCommand = "/bin/bash %s"
+
+var scriptTemplate = `#!/bin/bash
+set -o errexit
+set -o pipefail
+
+{{ range $_, $file := .Files -}}
+mkdir -p '{{ $file.Dirname }}'
+echo '{{ $file.Content }}' | base64 -d > '{{ $file.Path }}'
+{{ if $file.Permissions -}}
+chmod '{{ $file.Permissions }}' '{{ $file.Path }}'
+{{ end -}}
+{{ end -}}
+{{ range $_, $unit := .Units -}}
+{{ if $unit.Content -}}
+echo '{{ $unit.Content }}' | base64 -d > '{{ $unit.Path }}'
+{{ end -}}
+{{ if $unit.DropIns -}}
+mkdir -p '{{ $unit.DropIns.Path }}'
+{{ range $_, $dropIn := $unit.DropIns.Items -}}
+echo '{{ $dropIn.Content }}' | base64 -d > '{{ $dropIn.Path }}'
+{{ end -}}
+{{ end -}}
+{{ end -}}
+systemctl daemon-reload
+{{ range $_, $unit := .Units -}}
+systemctl enable '{{ $unit.Name }}' && systemctl restart '{{ $unit.Name }}'
+{{ end -}}
+`
+
+// DefaultScriptTemplate is the default template of the ScriptGenerator. It writes all files, units and drop-ins
+// and (re)starts all units.
+var DefaultScriptTemplate = template.Must(template.New("script").Parse(scriptTemplate))
+
+// ScriptGenerator generates plain shell scripts.
+type ScriptGenerator struct {
+	*CloudInitGenerator
+}
+
+// NewScriptGenerator creates a new ScriptGenerator with the given units path. The template gets the same data as
+// the templates of the CloudInitGenerator. If it is nil, the DefaultScriptTemplate is used.
+func NewScriptGenerator(template *template.Template, unitsPath string, cmd string) *ScriptGenerator {
+	if template == nil {
+		template = DefaultScriptTemplate
+	}
+	return &ScriptGenerator{NewCloudInitGenerator(template, unitsPath, cmd)}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template_test
+
+import (
+	"text/template"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator/test"
+	. "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/template"
+
+	"github.com/gobuffalo/packr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("Script Generator Test", func() {
+	Describe("Conformance Tests", func() {
+		var box = packr.NewBox("./testfiles")
+		test.DescribeFormatTest(NewScriptGenerator(nil, DefaultUnitsPath, DefaultScriptCommand), box, "script")()
+	})
+
+	It("should render the given template", func() {
+		tmpl := template.Must(template.New("script").Parse(`{{ range $_, $unit := .Units }}{{ $unit.Path }}{{ end }}`))
+
+		script, _, err := NewScriptGenerator(tmpl, "/usr/lib/systemd/system", DefaultScriptCommand).Generate(&generator.OperatingSystemConfig{
+			Units: []*generator.Unit{{Name: "docker.service"}},
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(script)).To(Equal("/usr/lib/systemd/system/docker.service"))
+	})
+
+	It("should return the command for the given path", func() {
+		path := "/var/lib/osc/script.sh"
+
+		_, command, err := NewScriptGenerator(nil, DefaultUnitsPath, DefaultScriptCommand).Generate(&generator.OperatingSystemConfig{Path: &path})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(command).To(PointTo(Equal("/bin/bash /var/lib/osc/script.sh")))
+	})
+})
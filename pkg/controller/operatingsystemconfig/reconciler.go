@@ -21,6 +21,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constantshelper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -113,7 +114,9 @@ func (r *reconciler) reconcile(ctx context.Context, osc *extensionsv1alpha1.Oper
 	}
 
 	r.logger.Info("Starting the reconciliation of operating system config", "osc", osc.Name)
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.OperatingSystemConfigResource, osc.Spec.Type, operationType)
 	userData, command, units, err := r.actuator.Reconcile(ctx, osc)
+	observe(err)
 	if err != nil {
 		msg := "Error reconciling operating system config"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), osc, operationType, msg))
@@ -173,7 +176,8 @@ func (r *reconciler) delete(ctx context.Context, osc *extensionsv1alpha1.Operati
 	}
 
 	r.logger.Info("Starting the deletion of operating system config", "osc", osc.Name)
-	if err := r.actuator.Delete(ctx, osc); err != nil {
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.OperatingSystemConfigResource, osc.Spec.Type, operationType)
+	if err := observe(r.actuator.Delete(ctx, osc)); err != nil {
 		msg := "Error deleting operating system config"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), osc, operationType, msg))
 		r.logger.Error(err, msg, "osc", osc.Name)
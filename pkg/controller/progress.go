@@ -0,0 +1,208 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultProgressReportInterval is the default minimum interval between two status updates of a ProgressReporter.
+const DefaultProgressReportInterval = 10 * time.Second
+
+// ProgressReporter reports the progress of long-running operations of actuators at checkpoints. Reports are
+// written to the status of the extension resource, but not more often than the configured interval. Reports that
+// arrive within the interval are kept and written with the next report after the interval or with Flush.
+type ProgressReporter interface {
+	// Progress updates the progress (in percent) and the description of the last operation if it is processing.
+	Progress(ctx context.Context, progress int, description string) error
+	// Condition updates the status condition with the given type.
+	Condition(ctx context.Context, conditionType gardencorev1alpha1.ConditionType, status gardencorev1alpha1.ConditionStatus, reason, message string) error
+	// Flush writes all pending reports to the status of the extension resource.
+	Flush(ctx context.Context) error
+}
+
+type progressReporterContextKey struct{}
+
+// WithProgressReporter returns a copy of the given context that carries the given ProgressReporter.
+func WithProgressReporter(ctx context.Context, reporter ProgressReporter) context.Context {
+	return context.WithValue(ctx, progressReporterContextKey{}, reporter)
+}
+
+// ProgressReporterFromContext returns the ProgressReporter of the given context. If the context does not carry a
+// ProgressReporter, a reporter that discards all reports is returned.
+func ProgressReporterFromContext(ctx context.Context) ProgressReporter {
+	if reporter, ok := ctx.Value(progressReporterContextKey{}).(ProgressReporter); ok {
+		return reporter
+	}
+	return nopProgressReporter{}
+}
+
+// ReportProgress reports the given progress with the ProgressReporter of the given context.
+func ReportProgress(ctx context.Context, progress int, description string) error {
+	return ProgressReporterFromContext(ctx).Progress(ctx, progress, description)
+}
+
+// ReportCondition reports the given condition with the ProgressReporter of the given context.
+func ReportCondition(ctx context.Context, conditionType gardencorev1alpha1.ConditionType, status gardencorev1alpha1.ConditionStatus, reason, message string) error {
+	return ProgressReporterFromContext(ctx).Condition(ctx, conditionType, status, reason, message)
+}
+
+type nopProgressReporter struct{}
+
+func (nopProgressReporter) Progress(context.Context, int, string) error { return nil }
+func (nopProgressReporter) Condition(context.Context, gardencorev1alpha1.ConditionType, gardencorev1alpha1.ConditionStatus, string, string) error {
+	return nil
+}
+func (nopProgressReporter) Flush(context.Context) error { return nil }
+
+type progressReport struct {
+	progress    int
+	description string
+}
+
+type conditionReport struct {
+	status          gardencorev1alpha1.ConditionStatus
+	reason, message string
+}
+
+type progressReporter struct {
+	client   client.Client
+	obj      runtime.Object
+	interval time.Duration
+	clock    clock.Clock
+
+	lock       sync.Mutex
+	lastUpdate time.Time
+	progress   *progressReport
+	conditions map[gardencorev1alpha1.ConditionType]conditionReport
+	order      []gardencorev1alpha1.ConditionType
+}
+
+// NewProgressReporter creates a new ProgressReporter for the given extension resource that updates its status
+// at most once per the given interval.
+func NewProgressReporter(c client.Client, obj extensionsv1alpha1.Object, interval time.Duration) ProgressReporter {
+	return NewProgressReporterWithClock(c, obj, interval, clock.RealClock{})
+}
+
+// NewProgressReporterWithClock creates a new ProgressReporter like NewProgressReporter that uses the given clock.
+func NewProgressReporterWithClock(c client.Client, obj extensionsv1alpha1.Object, interval time.Duration, clock clock.Clock) ProgressReporter {
+	return &progressReporter{
+		client:     c,
+		obj:        obj.(runtime.Object).DeepCopyObject(),
+		interval:   interval,
+		clock:      clock,
+		conditions: make(map[gardencorev1alpha1.ConditionType]conditionReport),
+	}
+}
+
+// Progress implements ProgressReporter.
+func (r *progressReporter) Progress(ctx context.Context, progress int, description string) error {
+	switch {
+	case progress < 0:
+		progress = 0
+	case progress > 100:
+		progress = 100
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.progress = &progressReport{progress, description}
+	return r.updateIfDue(ctx)
+}
+
+// Condition implements ProgressReporter.
+func (r *progressReporter) Condition(ctx context.Context, conditionType gardencorev1alpha1.ConditionType, status gardencorev1alpha1.ConditionStatus, reason, message string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.conditions[conditionType]; !ok {
+		r.order = append(r.order, conditionType)
+	}
+	r.conditions[conditionType] = conditionReport{status, reason, message}
+	return r.updateIfDue(ctx)
+}
+
+// Flush implements ProgressReporter.
+func (r *progressReporter) Flush(ctx context.Context) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.update(ctx)
+}
+
+func (r *progressReporter) updateIfDue(ctx context.Context) error {
+	if !r.lastUpdate.IsZero() && r.clock.Since(r.lastUpdate) < r.interval {
+		return nil
+	}
+	return r.update(ctx)
+}
+
+func (r *progressReporter) update(ctx context.Context) error {
+	if r.progress == nil && len(r.order) == 0 {
+		return nil
+	}
+
+	if err := TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, r.obj, func() error {
+		status, err := defaultStatus(r.obj.(extensionsv1alpha1.Object))
+		if err != nil {
+			return err
+		}
+
+		if lastOperation := status.LastOperation; r.progress != nil && lastOperation != nil && lastOperation.State == gardencorev1alpha1.LastOperationStateProcessing {
+			lastOperation.Progress = r.progress.progress
+			lastOperation.Description = r.progress.description
+			lastOperation.LastUpdateTime = metav1.NewTime(r.clock.Now())
+		}
+
+		for _, conditionType := range r.order {
+			report := r.conditions[conditionType]
+			condition := gardencorev1alpha1helper.GetOrInitCondition(status.Conditions, conditionType)
+			status.Conditions = gardencorev1alpha1helper.MergeConditions(status.Conditions, gardencorev1alpha1helper.UpdatedCondition(condition, report.status, report.reason, report.message))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	r.lastUpdate = r.clock.Now()
+	r.progress = nil
+	r.conditions = make(map[gardencorev1alpha1.ConditionType]conditionReport)
+	r.order = nil
+	return nil
+}
+
+// NewProgressContext returns a copy of the given context that carries a new ProgressReporter for the given extension
+// resource with the DefaultProgressReportInterval, and a function that flushes the pending reports of the reporter.
+// Errors while flushing are only logged as the reports are not essential.
+func NewProgressContext(ctx context.Context, c client.Client, obj extensionsv1alpha1.Object) (context.Context, func()) {
+	reporter := NewProgressReporter(c, obj, DefaultProgressReportInterval)
+	return WithProgressReporter(ctx, reporter), func() {
+		utilruntime.HandleError(reporter.Flush(ctx))
+	}
+}
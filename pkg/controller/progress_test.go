@@ -0,0 +1,121 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Progress", func() {
+	var (
+		ctx = context.TODO()
+
+		c         client.Client
+		fakeClock *clock.FakeClock
+		reporter  controller.ProgressReporter
+
+		infra *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					LastOperation: controller.LastOperation(gardencorev1alpha1.LastOperationTypeReconcile, gardencorev1alpha1.LastOperationStateProcessing, 1, "Reconciling the infrastructure"),
+				},
+			},
+		}
+
+		c = fake.NewFakeClientWithScheme(scheme, infra.DeepCopy())
+		fakeClock = clock.NewFakeClock(time.Now())
+		reporter = controller.NewProgressReporterWithClock(c, infra, time.Minute, fakeClock)
+	})
+
+	get := func() *extensionsv1alpha1.Infrastructure {
+		out := &extensionsv1alpha1.Infrastructure{}
+		Expect(c.Get(ctx, kutil.Key("foo", "bar"), out)).To(Succeed())
+		return out
+	}
+
+	It("should write the first report immediately", func() {
+		Expect(reporter.Progress(ctx, 30, "Applying")).To(Succeed())
+
+		lastOperation := get().Status.LastOperation
+		Expect(lastOperation.Progress).To(Equal(30))
+		Expect(lastOperation.Description).To(Equal("Applying"))
+	})
+
+	It("should rate-limit reports and write them after the interval or when flushed", func() {
+		Expect(reporter.Progress(ctx, 30, "Applying")).To(Succeed())
+		Expect(reporter.Progress(ctx, 50, "Waiting")).To(Succeed())
+		Expect(reporter.Condition(ctx, "InfrastructureReady", gardencorev1alpha1.ConditionFalse, "Processing", "Waiting")).To(Succeed())
+
+		infra := get()
+		Expect(infra.Status.LastOperation.Progress).To(Equal(30))
+		Expect(infra.Status.Conditions).To(BeEmpty())
+
+		fakeClock.Step(time.Minute)
+		Expect(reporter.Progress(ctx, 60, "Still waiting")).To(Succeed())
+
+		infra = get()
+		Expect(infra.Status.LastOperation.Progress).To(Equal(60))
+		Expect(infra.Status.Conditions).To(HaveLen(1))
+		Expect(infra.Status.Conditions[0].Type).To(Equal(gardencorev1alpha1.ConditionType("InfrastructureReady")))
+		Expect(infra.Status.Conditions[0].Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+
+		Expect(reporter.Condition(ctx, "InfrastructureReady", gardencorev1alpha1.ConditionTrue, "Ready", "Ready")).To(Succeed())
+		Expect(get().Status.Conditions[0].Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+
+		Expect(reporter.Flush(ctx)).To(Succeed())
+		Expect(get().Status.Conditions[0].Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+	})
+
+	It("should not update the progress of finished operations", func() {
+		infra := get()
+		infra.Status.LastOperation.State = gardencorev1alpha1.LastOperationStateSucceeded
+		infra.Status.LastOperation.Progress = 100
+		Expect(c.Status().Update(ctx, infra)).To(Succeed())
+
+		Expect(reporter.Progress(ctx, 30, "Applying")).To(Succeed())
+
+		Expect(get().Status.LastOperation.Progress).To(Equal(100))
+	})
+
+	It("should discard reports if the context does not carry a reporter", func() {
+		Expect(controller.ReportProgress(ctx, 30, "Applying")).To(Succeed())
+		Expect(get().Status.LastOperation.Progress).To(Equal(1))
+
+		Expect(controller.ReportProgress(controller.WithProgressReporter(ctx, reporter), 30, "Applying")).To(Succeed())
+		Expect(get().Status.LastOperation.Progress).To(Equal(30))
+	})
+})
@@ -18,14 +18,29 @@ import (
 	"context"
 
 	"github.com/gardener/gardener-extensions/pkg/util"
+	"github.com/gardener/gardener/pkg/api/extensions"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	v1alpha1constantshelper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
 )
 
+const (
+	// GardenerOperationRestore is a constant for the value of the operation annotation describing a restore
+	// operation.
+	GardenerOperationRestore = "restore"
+
+	// LastOperationTypeMigrate indicates a 'migrate' operation.
+	LastOperationTypeMigrate gardencorev1alpha1.LastOperationType = "Migrate"
+	// LastOperationTypeRestore indicates a 'restore' operation.
+	LastOperationTypeRestore gardencorev1alpha1.LastOperationType = "Restore"
+)
+
 type operationAnnotationWrapper struct {
 	reconcile.Reconciler
 	client     client.Client
@@ -37,6 +52,8 @@ type operationAnnotationWrapper struct {
 // removes the Gardener operation annotation before `Reconcile` is called.
 //
 // This is useful in conjunction with the HasOperationAnnotationPredicate.
+// The migrate and restore values of the annotation are kept, they are removed by the inner reconciler after the
+// respective operation has been completed.
 func OperationAnnotationWrapper(objectType runtime.Object, reconciler reconcile.Reconciler) reconcile.Reconciler {
 	return &operationAnnotationWrapper{
 		objectType: objectType,
@@ -84,3 +101,50 @@ func (o *operationAnnotationWrapper) Reconcile(request reconcile.Request) (recon
 	}
 	return o.Reconciler.Reconcile(request)
 }
+
+// ComputeOperationType determines the type of the operation that has to be executed for the given object.
+// In contrast to the Gardener helper of the same name it also considers the migrate and restore values of the
+// Gardener operation annotation. A requested migration takes precedence over a deletion.
+func ComputeOperationType(meta metav1.ObjectMeta, lastOperation *gardencorev1alpha1.LastOperation) gardencorev1alpha1.LastOperationType {
+	switch meta.Annotations[v1alpha1constants.GardenerOperation] {
+	case v1alpha1constants.GardenerOperationMigrate:
+		return LastOperationTypeMigrate
+	case GardenerOperationRestore:
+		if meta.DeletionTimestamp == nil {
+			return LastOperationTypeRestore
+		}
+	}
+	return v1alpha1constantshelper.ComputeOperationType(meta, lastOperation)
+}
+
+// IsMigrated checks whether the last operation of the given object was a successful migration. Objects that
+// have been migrated to another seed must neither be reconciled nor deleted anymore.
+func IsMigrated(obj runtime.Object) bool {
+	acc, err := extensions.Accessor(obj)
+	if err != nil {
+		return false
+	}
+
+	lastOp := acc.GetExtensionStatus().GetLastOperation()
+	return lastOp != nil &&
+		lastOp.GetType() == LastOperationTypeMigrate &&
+		lastOp.GetState() == gardencorev1alpha1.LastOperationStateSucceeded
+}
+
+// RemoveOperationAnnotation removes the Gardener operation annotation from the given object, if present.
+func RemoveOperationAnnotation(ctx context.Context, c client.Client, obj runtime.Object) error {
+	acc, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	annotations := acc.GetAnnotations()
+	if _, ok := annotations[v1alpha1constants.GardenerOperation]; !ok {
+		return nil
+	}
+
+	withOpAnnotation := obj.DeepCopyObject()
+	delete(annotations, v1alpha1constants.GardenerOperation)
+	acc.SetAnnotations(annotations)
+	return c.Patch(ctx, obj, client.MergeFrom(withOpAnnotation))
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// StateKeyTerraform is the key under which the Terraform state of an actuator is persisted.
+	StateKeyTerraform = "terraform"
+	// StateKeyMachines is the key under which the machine objects of an actuator are persisted.
+	StateKeyMachines = "machines"
+	// StateKeyRollingUpdate is the key under which the rolling update progress of an actuator is persisted.
+	StateKeyRollingUpdate = "rollingUpdate"
+	// StateKeyDryRun is the key under which the result of the last dry-run of an actuator is persisted.
+	StateKeyDryRun = "dryRun"
+	// StateKeySecrets is the key under which the secrets generated by an actuator are persisted.
+	StateKeySecrets = "secrets"
+)
+
+// ExtensionState is the opaque state of an actuator that is persisted in the `.status.state` field of its
+// extension resource. It maps a key identifying the kind of state (see the StateKey* constants) to the
+// serialized state, so that an actuator can restore it in case it is missing in the seed.
+type ExtensionState map[string][]byte
+
+// GetExtensionState decodes the ExtensionState of the given extension object. An empty ExtensionState is
+// returned if the object does not carry any state yet.
+func GetExtensionState(obj extensionsv1alpha1.Object) (ExtensionState, error) {
+	status, err := defaultStatus(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	state := ExtensionState{}
+	if len(status.State) == 0 {
+		return state, nil
+	}
+
+	if err := json.Unmarshal([]byte(status.State), &state); err != nil {
+		return nil, fmt.Errorf("could not decode state of %s/%s: %v", obj.GetNamespace(), obj.GetName(), err)
+	}
+	return state, nil
+}
+
+// GetState returns the state stored under the given key in the status of the given extension object.
+// It returns nil if no such state exists.
+func GetState(obj extensionsv1alpha1.Object, key string) ([]byte, error) {
+	state, err := GetExtensionState(obj)
+	if err != nil {
+		return nil, err
+	}
+	return state[key], nil
+}
+
+// SetState stores the given data under the given key in the status of the given extension object. If data
+// is empty, the key is removed. It only modifies the given object, see SaveState for also updating it.
+func SetState(obj extensionsv1alpha1.Object, key string, data []byte) error {
+	status, err := defaultStatus(obj)
+	if err != nil {
+		return err
+	}
+
+	state, err := GetExtensionState(obj)
+	if err != nil {
+		return err
+	}
+
+	if len(data) == 0 {
+		delete(state, key)
+	} else {
+		state[key] = data
+	}
+
+	if len(state) == 0 {
+		status.State = ""
+		return nil
+	}
+
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	status.State = string(raw)
+	return nil
+}
+
+// SaveState stores the given data under the given key in the status of the given extension object and
+// updates its status. If data is empty, the key is removed.
+func SaveState(ctx context.Context, c client.Client, obj extensionsv1alpha1.Object, key string, data []byte) error {
+	runtimeObj, ok := obj.(runtime.Object)
+	if !ok {
+		return fmt.Errorf("extension object %T is not a runtime object", obj)
+	}
+
+	return TryUpdateStatus(ctx, retry.DefaultBackoff, c, runtimeObj, func() error {
+		return SetState(obj, key, data)
+	})
+}
+
+// SaveStateObject serializes the given value and stores it under the given key in the status of the given
+// extension object, see SaveState.
+func SaveStateObject(ctx context.Context, c client.Client, obj extensionsv1alpha1.Object, key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return SaveState(ctx, c, obj, key, data)
+}
+
+// GetStateObject decodes the state stored under the given key in the status of the given extension object
+// into the given value. It returns false if no such state exists.
+func GetStateObject(obj extensionsv1alpha1.Object, key string, value interface{}) (bool, error) {
+	data, err := GetState(obj, key)
+	if err != nil || data == nil {
+		return false, err
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return false, fmt.Errorf("could not decode state %q of %s/%s: %v", key, obj.GetNamespace(), obj.GetName(), err)
+	}
+	return true, nil
+}
+
+// secretState is the persisted form of a secret, see SaveSecretsState.
+type secretState struct {
+	Type corev1.SecretType `json:"type"`
+	Data map[string][]byte `json:"data"`
+}
+
+// SaveSecretsState persists the data of the given secrets in the status of the given extension object so that they
+// can be restored by RestoreSecrets in case they are lost in the seed. The status is only updated if the data of the
+// secrets changed.
+func SaveSecretsState(ctx context.Context, c client.Client, obj extensionsv1alpha1.Object, secrets map[string]*corev1.Secret) error {
+	state := make(map[string]secretState, len(secrets))
+	for _, secret := range secrets {
+		state[secret.Name] = secretState{Type: secret.Type, Data: secret.Data}
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	current, err := GetState(obj, StateKeySecrets)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(current, data) {
+		return nil
+	}
+
+	return SaveState(ctx, c, obj, StateKeySecrets, data)
+}
+
+// RestoreSecrets creates the secrets persisted by SaveSecretsState in the given namespace unless they already exist,
+// so that they are reused instead of being generated again.
+func RestoreSecrets(ctx context.Context, c client.Client, obj extensionsv1alpha1.Object, namespace string) error {
+	state := map[string]secretState{}
+	if _, err := GetStateObject(obj, StateKeySecrets, &state); err != nil {
+		return err
+	}
+
+	for name, secretState := range state {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Type: secretState.Type,
+			Data: secretState.Data,
+		}
+		if err := c.Create(ctx, secret); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func defaultStatus(obj extensionsv1alpha1.Object) (*extensionsv1alpha1.DefaultStatus, error) {
+	switch o := obj.(type) {
+	case *extensionsv1alpha1.BackupBucket:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.BackupEntry:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.ControlPlane:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.Extension:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.Infrastructure:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.Network:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.OperatingSystemConfig:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.Worker:
+		return &o.Status.DefaultStatus, nil
+	}
+	return nil, fmt.Errorf("unsupported extension object %T", obj)
+}
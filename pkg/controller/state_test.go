@@ -0,0 +1,161 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("State", func() {
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		infra *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+
+		infra = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"}}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#GetState", func() {
+		It("should return nil if no state exists", func() {
+			Expect(controller.GetState(infra, controller.StateKeyTerraform)).To(BeNil())
+		})
+
+		It("should fail if the state cannot be decoded", func() {
+			infra.Status.State = "{"
+
+			_, err := controller.GetState(infra, controller.StateKeyTerraform)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#SetState", func() {
+		It("should set and keep the state of different keys", func() {
+			Expect(controller.SetState(infra, controller.StateKeyTerraform, []byte("tfstate"))).To(Succeed())
+			Expect(controller.SetState(infra, controller.StateKeyMachines, []byte("machines"))).To(Succeed())
+
+			Expect(controller.GetState(infra, controller.StateKeyTerraform)).To(Equal([]byte("tfstate")))
+			Expect(controller.GetState(infra, controller.StateKeyMachines)).To(Equal([]byte("machines")))
+		})
+
+		It("should remove the state if the given data is empty", func() {
+			Expect(controller.SetState(infra, controller.StateKeyTerraform, []byte("tfstate"))).To(Succeed())
+			Expect(controller.SetState(infra, controller.StateKeyTerraform, nil)).To(Succeed())
+
+			Expect(infra.Status.State).To(BeEmpty())
+		})
+	})
+
+	Describe("#SaveStateObject", func() {
+		It("should persist the given object in the status", func() {
+			var (
+				ctx   = context.TODO()
+				value = map[string]string{"foo": "bar"}
+			)
+
+			gomock.InOrder(
+				c.EXPECT().Status().Return(c),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: "foo", Name: "bar"}, infra),
+				c.EXPECT().Update(ctx, infra),
+			)
+
+			Expect(controller.SaveStateObject(ctx, c, infra, controller.StateKeyMachines, value)).To(Succeed())
+
+			actual := map[string]string{}
+			Expect(controller.GetStateObject(infra, controller.StateKeyMachines, &actual)).To(BeTrue())
+			Expect(actual).To(Equal(value))
+		})
+	})
+
+	Describe("#SaveSecretsState", func() {
+		var (
+			ctx     = context.TODO()
+			secrets = map[string]*corev1.Secret{
+				"foo": {
+					ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "foo"},
+					Type:       corev1.SecretTypeTLS,
+					Data:       map[string][]byte{"tls.crt": []byte("crt")},
+				},
+			}
+		)
+
+		It("should persist the secrets in the status", func() {
+			gomock.InOrder(
+				c.EXPECT().Status().Return(c),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: "foo", Name: "bar"}, infra),
+				c.EXPECT().Update(ctx, infra),
+			)
+
+			Expect(controller.SaveSecretsState(ctx, c, infra, secrets)).To(Succeed())
+			Expect(controller.GetState(infra, controller.StateKeySecrets)).NotTo(BeEmpty())
+		})
+
+		It("should not update the status if the secrets did not change", func() {
+			c.EXPECT().Status().Return(c)
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: "foo", Name: "bar"}, infra)
+			c.EXPECT().Update(ctx, infra)
+
+			Expect(controller.SaveSecretsState(ctx, c, infra, secrets)).To(Succeed())
+			Expect(controller.SaveSecretsState(ctx, c, infra, secrets)).To(Succeed())
+		})
+	})
+
+	Describe("#RestoreSecrets", func() {
+		var ctx = context.TODO()
+
+		It("should do nothing if no secrets were persisted", func() {
+			Expect(controller.RestoreSecrets(ctx, c, infra, "foo")).To(Succeed())
+		})
+
+		It("should create the persisted secrets unless they exist", func() {
+			Expect(controller.SetState(infra, controller.StateKeySecrets, []byte(`{"foo":{"type":"kubernetes.io/tls","data":{"tls.crt":"Y3J0"}},"bar":{"type":"Opaque","data":{}}}`))).To(Succeed())
+
+			c.EXPECT().Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "foo"},
+				Type:       corev1.SecretTypeTLS,
+				Data:       map[string][]byte{"tls.crt": []byte("crt")},
+			})
+			c.EXPECT().Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "foo"},
+				Type:       corev1.SecretTypeOpaque,
+				Data:       map[string][]byte{},
+			}).Return(apierrors.NewAlreadyExists(schema.GroupResource{Resource: "secrets"}, "bar"))
+
+			Expect(controller.RestoreSecrets(ctx, c, infra, "foo")).To(Succeed())
+		})
+	})
+})
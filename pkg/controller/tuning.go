@@ -0,0 +1,269 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+const (
+	// DefaultRateLimiterBaseDelay is the base delay of the failure backoff if only the maximum delay is configured.
+	DefaultRateLimiterBaseDelay = 5 * time.Millisecond
+	// DefaultRateLimiterMaxDelay is the maximum delay of the failure backoff if only the base delay is configured.
+	DefaultRateLimiterMaxDelay = 1000 * time.Second
+)
+
+// Tuning are options to tune the throughput of a controller beyond what controller.Options offer.
+type Tuning struct {
+	// MaxConcurrentReconciles is the maximum number of concurrent reconciliations. If zero, the value of the
+	// controller.Options is kept.
+	MaxConcurrentReconciles int
+	// Resync is the interval after which successfully reconciled objects are reconciled again. Zero disables
+	// the resync.
+	Resync time.Duration
+	// RateLimiter are the options of the rate limiter of the reconciliations.
+	RateLimiter RateLimiterOptions
+	// ReconcileTimeout is the duration after which a single reconciliation is considered failed. A timed out
+	// reconciliation keeps running in the background, and the object is not reconciled again before it finished.
+	// Zero disables the timeout.
+	ReconcileTimeout time.Duration
+}
+
+// RateLimiterOptions are the options of the rate limiter of the reconciliations.
+type RateLimiterOptions struct {
+	// BaseDelay is the delay after which an object is reconciled again after its first failed reconciliation.
+	// The delay is doubled with each consecutive failure. If both delays are zero, failed reconciliations are
+	// retried with the default backoff of the controller.
+	BaseDelay time.Duration
+	// MaxDelay is the maximum delay after which an object is reconciled again after failed reconciliations.
+	MaxDelay time.Duration
+	// QPS is the overall number of reconciliations per second the controller may start. Zero disables the limit.
+	QPS float32
+	// Burst is the maximum number of reconciliations that may be started at once if QPS is set. Defaults to 1.
+	Burst int
+}
+
+// wrapsReconciler checks whether the Tuning requires the reconciler of a controller to be wrapped.
+func (t Tuning) wrapsReconciler() bool {
+	return t.Resync > 0 || t.ReconcileTimeout > 0 || t.RateLimiter != RateLimiterOptions{}
+}
+
+type tunedManager struct {
+	manager.Manager
+	tuning Tuning
+}
+
+// WithTuning returns a manager.Manager that applies the given Tuning to all controllers that are created with
+// NewController on it.
+func WithTuning(mgr manager.Manager, tuning Tuning) manager.Manager {
+	return &tunedManager{mgr, tuning}
+}
+
+// TuningFrom returns the Tuning of the given manager.Manager, if any.
+func TuningFrom(mgr manager.Manager) (Tuning, bool) {
+	if tuned, ok := mgr.(*tunedManager); ok {
+		return tuned.tuning, true
+	}
+	return Tuning{}, false
+}
+
+// NewController creates a new controller with the given name and options and adds it to the given manager.
+// If the manager carries a Tuning (see WithTuning), the tuning is applied to the given options for
+// reconciliations of objects of the given type.
+func NewController(name string, mgr manager.Manager, objectType runtime.Object, options controller.Options) (controller.Controller, error) {
+	if tuning, ok := TuningFrom(mgr); ok {
+		if tuning.MaxConcurrentReconciles > 0 {
+			options.MaxConcurrentReconciles = tuning.MaxConcurrentReconciles
+		}
+		if options.Reconciler != nil && tuning.wrapsReconciler() {
+			options.Reconciler = TuningWrapper(name, objectType, tuning, options.Reconciler)
+		}
+	}
+
+	return controller.New(name, mgr, options)
+}
+
+type tuningWrapper struct {
+	reconcile.Reconciler
+	name       string
+	objectType runtime.Object
+	tuning     Tuning
+	logger     logr.Logger
+	client     client.Client
+	ctx        context.Context
+
+	limiter  flowcontrol.RateLimiter
+	failures workqueue.RateLimiter
+
+	lock     sync.Mutex
+	inFlight sets.String
+}
+
+// TuningWrapper is a wrapper for a reconciler that applies the resync, rate limiting and reconcile timeout of
+// the given Tuning to the reconciliations of objects of the given type.
+//
+// As the workqueue of a controller cannot be configured, the failure backoff is implemented by requeueing
+// failed requests after the respective delay instead of returning their errors to the controller. These failures
+// are logged and counted by the `gardener_extensions_backoff_reconcile_failures_total` metric instead.
+func TuningWrapper(name string, objectType runtime.Object, tuning Tuning, reconciler reconcile.Reconciler) reconcile.Reconciler {
+	t := &tuningWrapper{
+		Reconciler: reconciler,
+		name:       name,
+		objectType: objectType,
+		tuning:     tuning,
+		logger:     log.Log.WithName(name),
+		ctx:        context.TODO(),
+		inFlight:   sets.NewString(),
+	}
+
+	if opts := tuning.RateLimiter; opts.QPS > 0 {
+		burst := opts.Burst
+		if burst <= 0 {
+			burst = 1
+		}
+		t.limiter = flowcontrol.NewTokenBucketRateLimiter(opts.QPS, burst)
+	}
+
+	if opts := tuning.RateLimiter; opts.BaseDelay > 0 || opts.MaxDelay > 0 {
+		baseDelay, maxDelay := opts.BaseDelay, opts.MaxDelay
+		if baseDelay <= 0 {
+			baseDelay = DefaultRateLimiterBaseDelay
+		}
+		if maxDelay <= 0 {
+			maxDelay = DefaultRateLimiterMaxDelay
+		}
+		t.failures = workqueue.NewItemExponentialFailureRateLimiter(baseDelay, maxDelay)
+	}
+
+	return t
+}
+
+// InjectClient implements inject.Client.
+func (t *tuningWrapper) InjectClient(client client.Client) error {
+	t.client = client
+	return nil
+}
+
+// InjectFunc implements inject.Injector.
+func (t *tuningWrapper) InjectFunc(f inject.Func) error {
+	return f(t.Reconciler)
+}
+
+// InjectStopChannel is an implementation for getting the respective stop channel managed by the controller-runtime.
+func (t *tuningWrapper) InjectStopChannel(stopCh <-chan struct{}) error {
+	t.ctx = util.ContextFromStopChannel(stopCh)
+	return nil
+}
+
+// Reconcile calls the inner `Reconcile` with the configured rate limit and timeout and requeues the request
+// according to the configured failure backoff and resync.
+func (t *tuningWrapper) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	if t.limiter != nil {
+		t.limiter.Accept()
+	}
+
+	result, err := t.reconcileWithTimeout(request)
+	if err != nil {
+		if t.failures == nil {
+			return result, err
+		}
+
+		requeueAfter := t.failures.When(request)
+		t.logger.Error(err, "Reconciliation failed, requeueing with backoff", "request", request, "requeueAfter", requeueAfter)
+		metrics.ObserveBackoffReconcileFailure(t.name)
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	if t.failures != nil {
+		t.failures.Forget(request)
+	}
+
+	if t.tuning.Resync > 0 && !result.Requeue && result.RequeueAfter == 0 && t.exists(request) {
+		result.RequeueAfter = t.tuning.Resync
+	}
+	return result, nil
+}
+
+type reconcileOutcome struct {
+	result reconcile.Result
+	err    error
+}
+
+func (t *tuningWrapper) reconcileWithTimeout(request reconcile.Request) (reconcile.Result, error) {
+	if t.tuning.ReconcileTimeout <= 0 {
+		return t.Reconciler.Reconcile(request)
+	}
+
+	key := request.String()
+
+	t.lock.Lock()
+	if t.inFlight.Has(key) {
+		t.lock.Unlock()
+		return reconcile.Result{}, fmt.Errorf("timed out reconciliation of %s is still running", key)
+	}
+	t.inFlight.Insert(key)
+	t.lock.Unlock()
+
+	done := make(chan reconcileOutcome, 1)
+	go func() {
+		defer func() {
+			t.lock.Lock()
+			defer t.lock.Unlock()
+			t.inFlight.Delete(key)
+		}()
+
+		result, err := t.Reconciler.Reconcile(request)
+		done <- reconcileOutcome{result, err}
+	}()
+
+	timer := time.NewTimer(t.tuning.ReconcileTimeout)
+	defer timer.Stop()
+
+	select {
+	case outcome := <-done:
+		return outcome.result, outcome.err
+	case <-timer.C:
+		return reconcile.Result{}, fmt.Errorf("reconciliation of %s did not finish within %s", key, t.tuning.ReconcileTimeout)
+	}
+}
+
+// exists checks whether the object of the given request still exists so that deleted objects are not resynced.
+func (t *tuningWrapper) exists(request reconcile.Request) bool {
+	if t.objectType == nil || t.client == nil {
+		return true
+	}
+
+	obj := t.objectType.DeepCopyObject()
+	return !apierrors.IsNotFound(t.client.Get(t.ctx, request.NamespacedName, obj))
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	mockmanager "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/manager"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+type reconcilerFunc func(reconcile.Request) (reconcile.Result, error)
+
+func (f reconcilerFunc) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	return f(request)
+}
+
+var _ = Describe("Tuning", func() {
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "shoot--foo--bar", Name: "bar"}}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#WithTuning", func() {
+		It("should return a manager that carries the tuning", func() {
+			var (
+				mgr    = mockmanager.NewMockManager(ctrl)
+				tuning = controller.Tuning{MaxConcurrentReconciles: 2}
+			)
+
+			_, ok := controller.TuningFrom(mgr)
+			Expect(ok).To(BeFalse())
+
+			tuned, ok := controller.TuningFrom(controller.WithTuning(mgr, tuning))
+			Expect(ok).To(BeTrue())
+			Expect(tuned).To(Equal(tuning))
+		})
+	})
+
+	Describe("#TuningWrapper", func() {
+		succeed := reconcilerFunc(func(reconcile.Request) (reconcile.Result, error) {
+			return reconcile.Result{}, nil
+		})
+
+		newWrapper := func(tuning controller.Tuning, reconciler reconcile.Reconciler) reconcile.Reconciler {
+			wrapper := controller.TuningWrapper("test", &extensionsv1alpha1.Infrastructure{}, tuning, reconciler)
+			_, err := inject.ClientInto(c, wrapper)
+			Expect(err).NotTo(HaveOccurred())
+			return wrapper
+		}
+
+		It("should requeue existing objects after the resync interval", func() {
+			c.EXPECT().Get(gomock.Any(), request.NamespacedName, gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{}))
+
+			Expect(newWrapper(controller.Tuning{Resync: time.Minute}, succeed).Reconcile(request)).
+				To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		})
+
+		It("should not requeue deleted objects after the resync interval", func() {
+			c.EXPECT().Get(gomock.Any(), request.NamespacedName, gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{}, request.Name))
+
+			Expect(newWrapper(controller.Tuning{Resync: time.Minute}, succeed).Reconcile(request)).
+				To(Equal(reconcile.Result{}))
+		})
+
+		It("should keep the requeue of the inner reconciler", func() {
+			requeue := reconcilerFunc(func(reconcile.Request) (reconcile.Result, error) {
+				return reconcile.Result{RequeueAfter: time.Second}, nil
+			})
+
+			Expect(newWrapper(controller.Tuning{Resync: time.Minute}, requeue).Reconcile(request)).
+				To(Equal(reconcile.Result{RequeueAfter: time.Second}))
+		})
+
+		It("should requeue failed requests with an exponential backoff", func() {
+			var fail = true
+			reconciler := reconcilerFunc(func(reconcile.Request) (reconcile.Result, error) {
+				if fail {
+					return reconcile.Result{}, fmt.Errorf("error")
+				}
+				return reconcile.Result{}, nil
+			})
+			wrapper := newWrapper(controller.Tuning{RateLimiter: controller.RateLimiterOptions{BaseDelay: time.Second, MaxDelay: 3 * time.Second}}, reconciler)
+
+			Expect(wrapper.Reconcile(request)).To(Equal(reconcile.Result{RequeueAfter: time.Second}))
+			Expect(wrapper.Reconcile(request)).To(Equal(reconcile.Result{RequeueAfter: 2 * time.Second}))
+			Expect(wrapper.Reconcile(request)).To(Equal(reconcile.Result{RequeueAfter: 3 * time.Second}))
+
+			fail = false
+			Expect(wrapper.Reconcile(request)).To(Equal(reconcile.Result{}))
+
+			fail = true
+			Expect(wrapper.Reconcile(request)).To(Equal(reconcile.Result{RequeueAfter: time.Second}))
+		})
+
+		It("should return the error if no backoff is configured", func() {
+			fail := reconcilerFunc(func(reconcile.Request) (reconcile.Result, error) {
+				return reconcile.Result{}, fmt.Errorf("error")
+			})
+
+			_, err := newWrapper(controller.Tuning{Resync: time.Minute}, fail).Reconcile(request)
+			Expect(err).To(MatchError("error"))
+		})
+
+		It("should fail reconciliations that exceed the timeout", func() {
+			release := make(chan struct{})
+			defer close(release)
+
+			blocking := reconcilerFunc(func(reconcile.Request) (reconcile.Result, error) {
+				<-release
+				return reconcile.Result{}, nil
+			})
+			wrapper := newWrapper(controller.Tuning{ReconcileTimeout: 10 * time.Millisecond}, blocking)
+
+			_, err := wrapper.Reconcile(request)
+			Expect(err).To(MatchError(ContainSubstring("did not finish within 10ms")))
+
+			_, err = wrapper.Reconcile(request)
+			Expect(err).To(MatchError(ContainSubstring("is still running")))
+		})
+
+		It("should return the result of reconciliations within the timeout", func() {
+			Expect(newWrapper(controller.Tuning{ReconcileTimeout: time.Minute}, succeed).Reconcile(request)).
+				To(Equal(reconcile.Result{}))
+		})
+	})
+})
@@ -28,4 +28,15 @@ type Actuator interface {
 	Reconcile(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) error
 	// Delete deletes the Worker.
 	Delete(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) error
+	// Migrate releases all seed-local resources of the Worker without deleting the machines.
+	Migrate(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) error
+	// Restore rebuilds the seed-local resources of the Worker after a migration.
+	Restore(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) error
+}
+
+// DryRunner is implemented by Actuators that are able to compute the changes a reconciliation of a Worker
+// would make, without changing anything in the seed or the cloud provider account.
+type DryRunner interface {
+	// DryRun computes the changes a reconciliation of the Worker would make.
+	DryRun(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) (*extensionscontroller.DryRunResult, error)
 }
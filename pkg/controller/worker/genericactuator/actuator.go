@@ -22,6 +22,7 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/chartrenderer"
 	gardenerkubernetes "github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
@@ -30,7 +31,6 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -50,6 +50,7 @@ type genericActuator struct {
 	clientset            kubernetes.Interface
 	gardenerClientset    gardenerkubernetes.Interface
 	chartApplier         gardenerkubernetes.ChartApplier
+	chartRenderer        chartrenderer.Interface
 	chartRendererFactory extensionscontroller.ChartRendererFactory
 }
 
@@ -96,6 +97,11 @@ func (a *genericActuator) InjectConfig(config *rest.Config) error {
 		return errors.Wrap(err, "could not create chart applier")
 	}
 
+	a.chartRenderer, err = chartrenderer.NewForConfig(config)
+	if err != nil {
+		return errors.Wrap(err, "could not create chart renderer")
+	}
+
 	return nil
 }
 
@@ -110,28 +116,6 @@ func (a *genericActuator) cleanupMachineDeployments(ctx context.Context, existin
 	return nil
 }
 
-func (a *genericActuator) listMachineClassNames(ctx context.Context, namespace string, machineClassList runtime.Object) (sets.String, error) {
-	if err := a.client.List(ctx, machineClassList, client.InNamespace(namespace)); err != nil {
-		return nil, err
-	}
-
-	classNames := sets.NewString()
-
-	if err := meta.EachListItem(machineClassList, func(machineClass runtime.Object) error {
-		accessor, err := meta.Accessor(machineClass)
-		if err != nil {
-			return err
-		}
-
-		classNames.Insert(accessor.GetName())
-		return nil
-	}); err != nil {
-		return nil, err
-	}
-
-	return classNames, nil
-}
-
 func (a *genericActuator) cleanupMachineClasses(ctx context.Context, namespace string, machineClassList runtime.Object, wantedMachineDeployments worker.MachineDeployments) error {
 	if err := a.client.List(ctx, machineClassList, client.InNamespace(namespace)); err != nil {
 		return err
@@ -0,0 +1,255 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DryRun computes the changes a reconciliation of the given worker would make. The machine-controller-manager charts
+// are rendered and compared with the objects in the seed and the shoot, and the machine deployments generated by the
+// worker delegate are compared with the existing machine classes and machine deployments.
+func (a *genericActuator) DryRun(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *controller.Cluster) (*extensionscontroller.DryRunResult, error) {
+	workerDelegate, err := a.delegateFactory.WorkerDelegate(ctx, worker, cluster)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not instantiate actuator context")
+	}
+
+	result := &extensionscontroller.DryRunResult{}
+
+	changes, err := a.dryRunMachineControllerManager(ctx, worker, cluster, workerDelegate)
+	if err != nil {
+		return nil, err
+	}
+	result.Add(changes...)
+
+	wantedMachineDeployments, err := workerDelegate.GenerateMachineDeployments(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate the machine deployments")
+	}
+
+	changes, err = a.dryRunMachineClasses(ctx, worker, workerDelegate, wantedMachineDeployments)
+	if err != nil {
+		return nil, err
+	}
+	result.Add(changes...)
+
+	changes, err = a.dryRunMachineDeployments(ctx, worker, cluster, workerDelegate.MachineClassKind(), wantedMachineDeployments)
+	if err != nil {
+		return nil, err
+	}
+	result.Add(changes...)
+
+	return result, nil
+}
+
+func (a *genericActuator) dryRunMachineControllerManager(ctx context.Context, workerObj *extensionsv1alpha1.Worker, cluster *controller.Cluster, workerDelegate WorkerDelegate) ([]extensionscontroller.DryRunChange, error) {
+	var changes []extensionscontroller.DryRunChange
+
+	mcmValues, err := workerDelegate.GetMachineControllerManagerChartValues(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The kubeconfig of the machine-controller-manager is only generated by a reconciliation.
+	mcmKubeconfigSecret := &corev1.Secret{}
+	if err := a.client.Get(ctx, kutil.Key(workerObj.Namespace, a.mcmName), mcmKubeconfigSecret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		changes = append(changes, extensionscontroller.DryRunChange{
+			Kind:      "Secret",
+			Namespace: workerObj.Namespace,
+			Name:      a.mcmName,
+			Action:    extensionscontroller.DryRunActionCreate,
+		})
+	}
+	injectPodAnnotation(mcmValues, "checksum/secret-machine-controller-manager", util.ComputeChecksum(mcmKubeconfigSecret.Data))
+
+	var replicas int32 = 1
+	if extensionscontroller.IsHibernated(cluster.Shoot) {
+		deployment := &appsv1.Deployment{}
+		if err := a.client.Get(ctx, kutil.Key(workerObj.Namespace, a.mcmName), deployment); client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+	}
+	mcmValues["replicas"] = replicas
+
+	_, manifest, err := a.mcmSeedChart.Render(a.chartRenderer, workerObj.Namespace, a.imageVector, a.gardenerClientset.Version(), cluster.Shoot.Spec.Kubernetes.Version, mcmValues)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not render MCM chart in seed for worker '%s'", util.ObjectName(workerObj))
+	}
+	seedChanges, err := extensionscontroller.DiffManifestWithCluster(ctx, a.client, manifest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dry-run MCM chart in seed for worker '%s'", util.ObjectName(workerObj))
+	}
+	changes = append(changes, seedChanges...)
+
+	chartRenderer, err := a.chartRendererFactory.NewChartRendererForShoot(cluster.Shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not create chart renderer for shoot '%s'", workerObj.Namespace)
+	}
+
+	values, err := workerDelegate.GetMachineControllerManagerShootChartValues(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chartName, data, err := a.mcmShootChart.Render(chartRenderer, metav1.NamespaceSystem, a.imageVector, cluster.Shoot.Spec.Kubernetes.Version, cluster.Shoot.Spec.Kubernetes.Version, values)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not render MCM chart in shoot for worker '%s'", util.ObjectName(workerObj))
+	}
+	shootChanges, err := extensionscontroller.DiffManagedResource(ctx, a.client, workerObj.Namespace, mcmShootResourceName, chartName, data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dry-run MCM chart in shoot for worker '%s'", util.ObjectName(workerObj))
+	}
+	changes = append(changes, shootChanges...)
+
+	return changes, nil
+}
+
+// dryRunMachineClasses determines the machine classes that would be created or deleted. Machine classes are never
+// updated as their names change whenever their specification changes.
+func (a *genericActuator) dryRunMachineClasses(ctx context.Context, workerObj *extensionsv1alpha1.Worker, workerDelegate WorkerDelegate, wantedMachineDeployments worker.MachineDeployments) ([]extensionscontroller.DryRunChange, error) {
+	machineClassList := workerDelegate.MachineClassList()
+	if err := a.client.List(ctx, machineClassList, client.InNamespace(workerObj.Namespace)); err != nil {
+		return nil, err
+	}
+
+	existingClassNames := sets.NewString()
+	if err := meta.EachListItem(machineClassList, func(machineClass runtime.Object) error {
+		accessor, err := meta.Accessor(machineClass)
+		if err != nil {
+			return err
+		}
+		existingClassNames.Insert(accessor.GetName())
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var (
+		changes          []extensionscontroller.DryRunChange
+		wantedClassNames = sets.NewString()
+		newChange        = func(name string, action extensionscontroller.DryRunAction) extensionscontroller.DryRunChange {
+			return extensionscontroller.DryRunChange{
+				Kind:      workerDelegate.MachineClassKind(),
+				Namespace: workerObj.Namespace,
+				Name:      name,
+				Action:    action,
+			}
+		}
+	)
+
+	for _, deployment := range wantedMachineDeployments {
+		wantedClassNames.Insert(deployment.ClassName)
+	}
+	for _, name := range wantedClassNames.Difference(existingClassNames).List() {
+		changes = append(changes, newChange(name, extensionscontroller.DryRunActionCreate))
+	}
+	for _, name := range existingClassNames.Difference(wantedClassNames).List() {
+		changes = append(changes, newChange(name, extensionscontroller.DryRunActionDelete))
+	}
+
+	return changes, nil
+}
+
+// dryRunMachineDeployments compares the specifications the wanted machine deployments would get with the existing
+// machine deployments.
+func (a *genericActuator) dryRunMachineDeployments(ctx context.Context, workerObj *extensionsv1alpha1.Worker, cluster *controller.Cluster, classKind string, wantedMachineDeployments worker.MachineDeployments) ([]extensionscontroller.DryRunChange, error) {
+	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.client.List(ctx, existingMachineDeployments, client.InNamespace(workerObj.Namespace)); err != nil {
+		return nil, err
+	}
+
+	var (
+		changes               []extensionscontroller.DryRunChange
+		clusterAutoscalerUsed = extensionsv1alpha1helper.ClusterAutoscalerRequired(workerObj.Spec.Pools)
+		newChange             = func(name string, action extensionscontroller.DryRunAction, fields []string) extensionscontroller.DryRunChange {
+			return extensionscontroller.DryRunChange{
+				Kind:      "MachineDeployment",
+				Namespace: workerObj.Namespace,
+				Name:      name,
+				Action:    action,
+				Fields:    fields,
+			}
+		}
+	)
+
+	for _, deployment := range wantedMachineDeployments {
+		existingMachineDeployment := getExistingMachineDeployment(existingMachineDeployments, deployment.Name)
+		if existingMachineDeployment == nil {
+			changes = append(changes, newChange(deployment.Name, extensionscontroller.DryRunActionCreate, nil))
+			continue
+		}
+
+		wantedSpec := generateMachineDeploymentSpec(cluster, existingMachineDeployments, deployment, classKind, clusterAutoscalerUsed)
+		fields, err := diffMachineDeploymentSpecs(&wantedSpec, &existingMachineDeployment.Spec)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) > 0 {
+			changes = append(changes, newChange(deployment.Name, extensionscontroller.DryRunActionUpdate, fields))
+		}
+	}
+
+	for _, existingMachineDeployment := range existingMachineDeployments.Items {
+		if !wantedMachineDeployments.HasDeployment(existingMachineDeployment.Name) {
+			changes = append(changes, newChange(existingMachineDeployment.Name, extensionscontroller.DryRunActionDelete, nil))
+		}
+	}
+
+	return changes, nil
+}
+
+func diffMachineDeploymentSpecs(wanted, existing *machinev1alpha1.MachineDeploymentSpec) ([]string, error) {
+	wantedSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(wanted)
+	if err != nil {
+		return nil, err
+	}
+	existingSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(existing)
+	if err != nil {
+		return nil, err
+	}
+
+	return extensionscontroller.DiffObject(
+		&unstructured.Unstructured{Object: map[string]interface{}{"spec": wantedSpec}},
+		&unstructured.Unstructured{Object: map[string]interface{}{"spec": existingSpec}},
+		true,
+	), nil
+}
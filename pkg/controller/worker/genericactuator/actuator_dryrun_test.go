@@ -0,0 +1,63 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("DryRun", func() {
+	Describe("#diffMachineDeploymentSpecs", func() {
+		var (
+			cluster    = &controller.Cluster{Shoot: &gardenv1beta1.Shoot{}}
+			deployment = worker.MachineDeployment{
+				Name:           "pool-z1",
+				ClassName:      "pool-z1-abcde",
+				Minimum:        2,
+				Maximum:        2,
+				MaxSurge:       intstr.FromInt(1),
+				MaxUnavailable: intstr.FromInt(0),
+			}
+			existingMachineDeployments = &machinev1alpha1.MachineDeploymentList{}
+		)
+
+		It("should not report changes if the specification is unchanged", func() {
+			wanted := generateMachineDeploymentSpec(cluster, existingMachineDeployments, deployment, "AWSMachineClass", false)
+			existing := generateMachineDeploymentSpec(cluster, existingMachineDeployments, deployment, "AWSMachineClass", false)
+
+			Expect(diffMachineDeploymentSpecs(&wanted, &existing)).To(BeEmpty())
+		})
+
+		It("should report the changed fields", func() {
+			existing := generateMachineDeploymentSpec(cluster, existingMachineDeployments, deployment, "AWSMachineClass", false)
+
+			deployment.ClassName = "pool-z1-fghij"
+			deployment.Minimum, deployment.Maximum = 3, 3
+			wanted := generateMachineDeploymentSpec(cluster, existingMachineDeployments, deployment, "AWSMachineClass", false)
+
+			Expect(diffMachineDeploymentSpecs(&wanted, &existing)).To(Equal([]string{
+				"spec.replicas",
+				"spec.template.spec.class.name",
+			}))
+		})
+	})
+})
@@ -0,0 +1,99 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Migrate releases all seed-local resources of the given worker. The machine-controller-manager is removed from the
+// seed first so that the machine objects can be deleted without terminating the machines in the cloud provider account.
+func (a *genericActuator) Migrate(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	workerDelegate, err := a.delegateFactory.WorkerDelegate(ctx, worker, cluster)
+	if err != nil {
+		return errors.Wrapf(err, "could not instantiate actuator context")
+	}
+
+	// Keep the resources of the machine-controller-manager in the shoot, they are still needed after the restoration.
+	if err := extensionscontroller.SetKeepObjects(ctx, a.client, worker.Namespace, mcmShootResourceName, true); err != nil {
+		return errors.Wrapf(err, "could not keep objects of managed resource containing mcm chart for worker '%s'", util.ObjectName(worker))
+	}
+
+	// Delete the machine-controller-manager before touching any machine objects.
+	if err := a.deleteMachineControllerManager(ctx, worker); err != nil {
+		return errors.Wrapf(err, "failed deleting machine-controller-manager")
+	}
+
+	// Persist the final state of the machine objects as they are required to restore the worker.
+	if err := a.saveMachineState(ctx, worker); err != nil {
+		return errors.Wrapf(err, "failed to persist the machine state in worker status")
+	}
+
+	a.logger.Info("Releasing all machine objects", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	for _, list := range []runtime.Object{
+		&machinev1alpha1.MachineList{},
+		&machinev1alpha1.MachineSetList{},
+		&machinev1alpha1.MachineDeploymentList{},
+		workerDelegate.MachineClassList(),
+	} {
+		if err := a.client.List(ctx, list, client.InNamespace(worker.Namespace)); err != nil {
+			return err
+		}
+		if err := a.releaseObjects(ctx, list); err != nil {
+			return errors.Wrapf(err, "releasing machine objects failed")
+		}
+	}
+
+	secretList, err := a.listMachineClassSecrets(ctx, worker.Namespace)
+	if err != nil {
+		return err
+	}
+	if err := a.releaseObjects(ctx, secretList); err != nil {
+		return errors.Wrapf(err, "releasing machine class secrets failed")
+	}
+
+	return nil
+}
+
+// releaseObjects removes the finalizers of all objects in the given list and deletes them afterwards.
+func (a *genericActuator) releaseObjects(ctx context.Context, list runtime.Object) error {
+	return meta.EachListItem(list, func(obj runtime.Object) error {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+
+		if len(accessor.GetFinalizers()) > 0 {
+			withFinalizers := obj.DeepCopyObject()
+			accessor.SetFinalizers(nil)
+			if err := a.client.Patch(ctx, obj, client.MergeFrom(withFinalizers)); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+
+		return client.IgnoreNotFound(a.client.Delete(ctx, obj))
+	})
+}
@@ -17,13 +17,16 @@ package genericactuator
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	v1alpha1constantshelper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -66,6 +69,7 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 
 	// Deploy the machine-controller-manager into the cluster.
 	a.logger.Info("Deploying the machine-controller-manager", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	a.reportProgress(ctx, worker, 10, "Deploying the machine-controller-manager")
 	if err := a.deployMachineControllerManager(ctx, worker, cluster, workerDelegate, replicaFunc); err != nil {
 		return err
 	}
@@ -76,46 +80,57 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 		return errors.Wrapf(err, "failed to generate the machine deployments")
 	}
 
-	// Get list of existing machine class names and list of used machine class secrets.
-	existingMachineClassNames, err := a.listMachineClassNames(ctx, worker.Namespace, workerDelegate.MachineClassList())
-	if err != nil {
+	// Get the list of all existing machine deployments.
+	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.client.List(ctx, existingMachineDeployments, client.InNamespace(worker.Namespace)); err != nil {
 		return err
 	}
 
-	// During the time a rolling update happens we do not want the cluster autoscaler to interfer, hence it
-	// is removed for now.
+	// Determine the machine deployments whose machines are replaced by a rolling update. Rolling updates that were
+	// started by a previous reconciliation are resumed. The progress is persisted before the machine deployments
+	// are updated so that a restart of the controller does not restart the rolling updates.
 	var (
-		clusterAutoscalerUsed = extensionsv1alpha1helper.ClusterAutoscalerRequired(worker.Spec.Pools)
-		rollingUpdate         = false
+		previousRollingUpdateState = &RollingUpdateState{}
+		rollingUpdateState         = &RollingUpdateState{}
 	)
+	if !controller.IsHibernated(cluster.Shoot) {
+		previousRollingUpdateState, err = GetRollingUpdateState(worker)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read the rolling update state from worker status")
+		}
+		rollingUpdateState = computeRollingUpdateState(previousRollingUpdateState, existingMachineDeployments, wantedMachineDeployments, metav1.Now())
+	}
+	if err := a.saveRollingUpdateState(ctx, worker, rollingUpdateState); err != nil {
+		return errors.Wrapf(err, "failed to persist the rolling update state in worker status")
+	}
 
-	if clusterAutoscalerUsed {
-		// Check whether new machine classes have been computed (resulting in a rolling update of the nodes).
-		for _, machineDeployment := range wantedMachineDeployments {
-			if !existingMachineClassNames.Has(machineDeployment.ClassName) {
-				rollingUpdate = true
-				break
-			}
+	// During the time a rolling update of a machine deployment managed by the cluster autoscaler happens we do not
+	// want the cluster autoscaler to interfer, hence the scale-down of the nodes of this machine deployment is
+	// disabled until the rolling update finished.
+	var (
+		clusterAutoscalerUsed = extensionsv1alpha1helper.ClusterAutoscalerRequired(worker.Spec.Pools)
+		shootClient           client.Client
+	)
+	if clusterAutoscalerUsed && (len(previousRollingUpdateState.MachineDeployments) > 0 || len(rollingUpdateState.MachineDeployments) > 0) {
+		if _, shootClient, err = util.NewClientForShoot(ctx, a.client, worker.Namespace, client.Options{}); err != nil {
+			return errors.Wrapf(err, "could not create shoot client")
 		}
+		if err := a.disableClusterAutoscalerScaleDown(ctx, shootClient, worker, rollingUpdateState, wantedMachineDeployments); err != nil {
+			return errors.Wrapf(err, "failed to disable the scale-down of the cluster autoscaler for the rolled machine deployments")
+		}
+	}
 
-		// When the Shoot gets hibernated we want to remove the cluster auto scaler so that it does not interfer
-		// with Gardeners modifications on the machine deployment's replicas fields.
-		if controller.IsHibernated(cluster.Shoot) || rollingUpdate {
-			deployment := &appsv1.Deployment{}
-			if err := a.client.Get(ctx, kutil.Key(worker.Namespace, v1alpha1constants.DeploymentNameClusterAutoscaler), deployment); err != nil {
-				if !apierrors.IsNotFound(err) {
-					return err
-				}
-			} else {
-				if err := util.ScaleDeployment(ctx, a.client, deployment, 0); err != nil {
-					return err
-				}
-			}
+	// When the Shoot gets hibernated we want to remove the cluster auto scaler so that it does not interfer
+	// with Gardeners modifications on the machine deployment's replicas fields.
+	if clusterAutoscalerUsed && controller.IsHibernated(cluster.Shoot) {
+		if err := a.scaleClusterAutoscaler(ctx, worker, 0); err != nil {
+			return err
 		}
 	}
 
 	// Deploy generated machine classes.
 	a.logger.Info("Deploying the machine classes", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	a.reportProgress(ctx, worker, 20, "Deploying the machine classes")
 	if err := workerDelegate.DeployMachineClasses(ctx); err != nil {
 		return errors.Wrapf(err, "failed to deploy the machine classes")
 	}
@@ -129,27 +144,25 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 		return errors.Wrapf(err, "failed to update the machine images in worker status")
 	}
 
-	// Get the list of all existing machine deployments.
-	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
-	if err := a.client.List(ctx, existingMachineDeployments, client.InNamespace(worker.Namespace)); err != nil {
-		return err
-	}
-
 	// Generate machine deployment configuration based on previously computed list of deployments and deploy them.
 	a.logger.Info("Deploying the machine deployments", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	a.reportProgress(ctx, worker, 30, "Deploying the machine deployments")
 	if err := a.deployMachineDeployments(ctx, cluster, worker, existingMachineDeployments, wantedMachineDeployments, workerDelegate.MachineClassKind(), clusterAutoscalerUsed); err != nil {
 		return errors.Wrapf(err, "failed to generate the machine deployment config")
 	}
 
-	// Wait until all generated machine deployments are healthy/available.
-	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	// Wait until all generated machine deployments are healthy/available, at most until the timeouts of the rolling
+	// updates expired. Rolling updates that are not finished within this time are resumed by the next reconciliation.
+	timeoutCtx, cancel := context.WithTimeout(ctx, rollingUpdateState.waitTimeout(metav1.Now(), DefaultMachineDeploymentsReadyTimeout))
 	defer cancel()
 
-	if err := a.waitUntilMachineDeploymentsAvailable(timeoutCtx, cluster, worker, wantedMachineDeployments); err != nil {
+	observe := extensionsmetrics.ObserveMachineDeploymentsReady(worker.Spec.Type)
+	if err := observe(a.waitUntilMachineDeploymentsAvailable(timeoutCtx, cluster, worker, wantedMachineDeployments, rollingUpdateState, shootClient)); err != nil {
 		return v1alpha1constantshelper.DetermineError(fmt.Sprintf("Failed while waiting for all machine deployments to be ready: '%s'", err.Error()))
 	}
 
 	// Delete all old machine deployments (i.e. those which were not previously computed but exist in the cluster).
+	a.reportProgress(ctx, worker, 90, "Cleaning up the machine deployments and machine classes")
 	if err := a.cleanupMachineDeployments(ctx, existingMachineDeployments, wantedMachineDeployments); err != nil {
 		return errors.Wrapf(err, "failed to cleanup the machine deployments")
 	}
@@ -175,68 +188,20 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 		}
 	}
 
-	if rollingUpdate {
-		deployment := &appsv1.Deployment{}
-		if err := a.client.Get(ctx, kutil.Key(worker.Namespace, v1alpha1constants.DeploymentNameClusterAutoscaler), deployment); err != nil {
-			if !apierrors.IsNotFound(err) {
-				return err
-			}
-		} else {
-			if err := util.ScaleDeployment(ctx, a.client, deployment, 1); err != nil {
-				return err
-			}
-		}
-	}
-
 	if err := a.updateWorkerStatusMachineDeployments(ctx, worker, wantedMachineDeployments); err != nil {
 		return errors.Wrapf(err, "failed to update the machine deployments in worker status")
 	}
 
+	// Persist the machine objects so that they can be restored in case they are lost in the seed.
+	if err := a.saveMachineState(ctx, worker); err != nil {
+		return errors.Wrapf(err, "failed to persist the machine state in worker status")
+	}
+
 	return nil
 }
 
 func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments worker.MachineDeployments, classKind string, clusterAutoscalerUsed bool) error {
 	for _, deployment := range wantedMachineDeployments {
-		var (
-			labels                    = map[string]string{"name": deployment.Name}
-			existingMachineDeployment = getExistingMachineDeployment(existingMachineDeployments, deployment.Name)
-			replicas                  int
-		)
-
-		switch {
-		// If the Shoot is hibernated then the machine deployment's replicas should be zero.
-		case controller.IsHibernated(cluster.Shoot):
-			replicas = 0
-		// If the cluster autoscaler is not enabled then min=max (as per API validation), hence
-		// we can use either min or max.
-		case !clusterAutoscalerUsed:
-			replicas = deployment.Minimum
-		// If the machine deployment does not yet exist we set replicas to min so that the cluster
-		// autoscaler can scale them as required.
-		case existingMachineDeployment == nil:
-			replicas = deployment.Minimum
-		// If the Shoot was hibernated and is now woken up we set replicas to min so that the cluster
-		// autoscaler can scale them as required.
-		case shootIsAwake(controller.IsHibernated(cluster.Shoot), existingMachineDeployments):
-			replicas = deployment.Minimum
-		// If the shoot worker pool minimum was updated and if the current machine deployment replica
-		// count is less than minimum, we update the machine deployment replica count to updated minimum.
-		case int(existingMachineDeployment.Spec.Replicas) < deployment.Minimum:
-			replicas = deployment.Minimum
-		// If the shoot worker pool maximum was updated and if the current machine deployment replica
-		// count is greater than maximum, we update the machine deployment replica count to updated maximum.
-		case int(existingMachineDeployment.Spec.Replicas) > deployment.Maximum:
-			replicas = deployment.Maximum
-		// In this case the machine deployment must exist (otherwise the above case was already true),
-		// and the cluster autoscaler must be enabled. We do not want to override the machine deployment's
-		// replicas as the cluster autoscaler is responsible for setting appropriate values.
-		default:
-			replicas = getDeploymentSpecReplicas(existingMachineDeployments, deployment.Name)
-			if replicas == -1 {
-				replicas = deployment.Minimum
-			}
-		}
-
 		machineDeployment := &machinev1alpha1.MachineDeployment{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      deployment.Name,
@@ -245,41 +210,7 @@ func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster
 		}
 
 		if _, err := controllerutil.CreateOrUpdate(ctx, a.client, machineDeployment, func() error {
-			machineDeployment.Spec = machinev1alpha1.MachineDeploymentSpec{
-				Replicas:        int32(replicas),
-				MinReadySeconds: 500,
-				Strategy: machinev1alpha1.MachineDeploymentStrategy{
-					Type: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType,
-					RollingUpdate: &machinev1alpha1.RollingUpdateMachineDeployment{
-						MaxSurge:       &deployment.MaxSurge,
-						MaxUnavailable: &deployment.MaxUnavailable,
-					},
-				},
-				Selector: &metav1.LabelSelector{
-					MatchLabels: labels,
-				},
-				Template: machinev1alpha1.MachineTemplateSpec{
-					ObjectMeta: metav1.ObjectMeta{
-						Labels: labels,
-					},
-					Spec: machinev1alpha1.MachineSpec{
-						Class: machinev1alpha1.ClassSpec{
-							Kind: classKind,
-							Name: deployment.ClassName,
-						},
-						NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
-							ObjectMeta: metav1.ObjectMeta{
-								Annotations: deployment.Annotations,
-								Labels:      deployment.Labels,
-							},
-							Spec: corev1.NodeSpec{
-								Taints: deployment.Taints,
-							},
-						},
-					},
-				},
-			}
-
+			machineDeployment.Spec = generateMachineDeploymentSpec(cluster, existingMachineDeployments, deployment, classKind, clusterAutoscalerUsed)
 			return nil
 		}); err != nil {
 			return err
@@ -289,9 +220,89 @@ func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster
 	return nil
 }
 
-// waitUntilMachineDeploymentsAvailable waits for a maximum of 30 minutes until all the desired <machineDeployments>
-// were marked as healthy/available by the machine-controller-manager. It polls the status every 5 seconds.
-func (a *genericActuator) waitUntilMachineDeploymentsAvailable(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, wantedMachineDeployments worker.MachineDeployments) error {
+// generateMachineDeploymentSpec computes the specification of the given wanted machine deployment.
+func generateMachineDeploymentSpec(cluster *controller.Cluster, existingMachineDeployments *machinev1alpha1.MachineDeploymentList, deployment worker.MachineDeployment, classKind string, clusterAutoscalerUsed bool) machinev1alpha1.MachineDeploymentSpec {
+	var (
+		labels                    = map[string]string{"name": deployment.Name}
+		existingMachineDeployment = getExistingMachineDeployment(existingMachineDeployments, deployment.Name)
+		replicas                  int
+	)
+
+	switch {
+	// If the Shoot is hibernated then the machine deployment's replicas should be zero.
+	case controller.IsHibernated(cluster.Shoot):
+		replicas = 0
+	// If the cluster autoscaler is not enabled then min=max (as per API validation), hence
+	// we can use either min or max.
+	case !clusterAutoscalerUsed:
+		replicas = deployment.Minimum
+	// If the machine deployment does not yet exist we set replicas to min so that the cluster
+	// autoscaler can scale them as required.
+	case existingMachineDeployment == nil:
+		replicas = deployment.Minimum
+	// If the Shoot was hibernated and is now woken up we set replicas to min so that the cluster
+	// autoscaler can scale them as required.
+	case shootIsAwake(controller.IsHibernated(cluster.Shoot), existingMachineDeployments):
+		replicas = deployment.Minimum
+	// If the shoot worker pool minimum was updated and if the current machine deployment replica
+	// count is less than minimum, we update the machine deployment replica count to updated minimum.
+	case int(existingMachineDeployment.Spec.Replicas) < deployment.Minimum:
+		replicas = deployment.Minimum
+	// If the shoot worker pool maximum was updated and if the current machine deployment replica
+	// count is greater than maximum, we update the machine deployment replica count to updated maximum.
+	case int(existingMachineDeployment.Spec.Replicas) > deployment.Maximum:
+		replicas = deployment.Maximum
+	// In this case the machine deployment must exist (otherwise the above case was already true),
+	// and the cluster autoscaler must be enabled. We do not want to override the machine deployment's
+	// replicas as the cluster autoscaler is responsible for setting appropriate values.
+	default:
+		replicas = getDeploymentSpecReplicas(existingMachineDeployments, deployment.Name)
+		if replicas == -1 {
+			replicas = deployment.Minimum
+		}
+	}
+
+	return machinev1alpha1.MachineDeploymentSpec{
+		Replicas:        int32(replicas),
+		MinReadySeconds: 500,
+		Strategy: machinev1alpha1.MachineDeploymentStrategy{
+			Type: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType,
+			RollingUpdate: &machinev1alpha1.RollingUpdateMachineDeployment{
+				MaxSurge:       &deployment.MaxSurge,
+				MaxUnavailable: &deployment.MaxUnavailable,
+			},
+		},
+		Selector: &metav1.LabelSelector{
+			MatchLabels: labels,
+		},
+		Template: machinev1alpha1.MachineTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: labels,
+			},
+			Spec: machinev1alpha1.MachineSpec{
+				Class: machinev1alpha1.ClassSpec{
+					Kind: classKind,
+					Name: deployment.ClassName,
+				},
+				NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: deployment.Annotations,
+						Labels:      deployment.Labels,
+					},
+					Spec: corev1.NodeSpec{
+						Taints: deployment.Taints,
+					},
+				},
+			},
+		},
+	}
+}
+
+// waitUntilMachineDeploymentsAvailable waits until all the desired <machineDeployments> were marked as
+// healthy/available by the machine-controller-manager or until the given context is done. It polls the status
+// every 5 seconds and persists the progress of the given rolling updates in the worker status. If a shoot client is
+// given, the scale-down of the cluster autoscaler is disabled for the nodes of the rolled machine deployments.
+func (a *genericActuator) waitUntilMachineDeploymentsAvailable(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, wantedMachineDeployments worker.MachineDeployments, rollingUpdateState *RollingUpdateState, shootClient client.Client) error {
 	return wait.PollUntil(5*time.Second, func() (bool, error) {
 		var numHealthyDeployments, numUpdated, numDesired, numberOfAwakeMachines int32
 
@@ -301,6 +312,25 @@ func (a *genericActuator) waitUntilMachineDeploymentsAvailable(ctx context.Conte
 			return false, err
 		}
 
+		// Report the progress of the rolling updates.
+		if len(rollingUpdateState.MachineDeployments) > 0 {
+			previousRollingUpdateState := rollingUpdateState.DeepCopy()
+			rollingUpdateErr := updateRollingUpdateProgress(rollingUpdateState, existingMachineDeployments, metav1.Now())
+			if !reflect.DeepEqual(previousRollingUpdateState, rollingUpdateState) {
+				if err := a.saveRollingUpdateState(ctx, worker, rollingUpdateState); err != nil {
+					return false, err
+				}
+			}
+			if shootClient != nil {
+				if err := a.disableClusterAutoscalerScaleDown(ctx, shootClient, worker, rollingUpdateState, wantedMachineDeployments); err != nil {
+					return false, err
+				}
+			}
+			if rollingUpdateErr != nil {
+				return false, rollingUpdateErr
+			}
+		}
+
 		// Collect the numbers of ready and desired replicas.
 		for _, existingMachineDeployment := range existingMachineDeployments.Items {
 			// If the shoot get hibernated we want to wait until all machine deployments have been deleted entirely.
@@ -313,6 +343,7 @@ func (a *genericActuator) waitUntilMachineDeploymentsAvailable(ctx context.Conte
 			// replicas as desired (specified in the .spec.replicas). However, if we see any error in the status of
 			// the deployment then we return it.
 			for _, failedMachine := range existingMachineDeployment.Status.FailedMachines {
+				a.reportMachinesHealthy(ctx, worker, gardencorev1alpha1.ConditionFalse, "MachineFailed", fmt.Sprintf("Machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description))
 				return false, fmt.Errorf("Machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description)
 			}
 
@@ -333,8 +364,16 @@ func (a *genericActuator) waitUntilMachineDeploymentsAvailable(ctx context.Conte
 		case !controller.IsHibernated(cluster.Shoot):
 			a.logger.Info(fmt.Sprintf("Waiting until all desired machines are ready (%d/%d machine objects up-to-date, %d/%d machinedeployments available)...", numUpdated, numDesired, numHealthyDeployments, len(wantedMachineDeployments)), "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
 			if numUpdated >= numDesired && int(numHealthyDeployments) == len(wantedMachineDeployments) {
+				a.reportMachinesHealthy(ctx, worker, gardencorev1alpha1.ConditionTrue, "MachinesReady", fmt.Sprintf("All %d machines are up-to-date and all %d machine deployments are available.", numDesired, len(wantedMachineDeployments)))
 				return true, nil
 			}
+
+			progress := 80
+			if numDesired > 0 {
+				progress = 30 + int(50*numUpdated/numDesired)
+			}
+			a.reportProgress(ctx, worker, progress, fmt.Sprintf("Waiting until all desired machines are ready (%d/%d machine objects up-to-date)", numUpdated, numDesired))
+			a.reportMachinesHealthy(ctx, worker, gardencorev1alpha1.ConditionFalse, "MachinesNotReady", fmt.Sprintf("%d/%d machine objects are up-to-date, %d/%d machine deployments are available.", numUpdated, numDesired, numHealthyDeployments, len(wantedMachineDeployments)))
 		default:
 			if numberOfAwakeMachines == 0 {
 				return true, nil
@@ -374,8 +413,35 @@ func (a *genericActuator) updateWorkerStatusMachineImages(ctx context.Context, w
 	})
 }
 
+func (a *genericActuator) scaleClusterAutoscaler(ctx context.Context, worker *extensionsv1alpha1.Worker, replicas int32) error {
+	deployment := &appsv1.Deployment{}
+	if err := a.client.Get(ctx, kutil.Key(worker.Namespace, v1alpha1constants.DeploymentNameClusterAutoscaler), deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return util.ScaleDeployment(ctx, a.client, deployment, replicas)
+}
+
 // Helper functions
 
+// reportProgress reports the given progress of the worker. Failures are only logged as the progress reports are
+// not essential for the reconciliation.
+func (a *genericActuator) reportProgress(ctx context.Context, worker *extensionsv1alpha1.Worker, progress int, description string) {
+	if err := controller.ReportProgress(ctx, progress, description); err != nil {
+		a.logger.Error(err, "Could not report the progress", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	}
+}
+
+// reportMachinesHealthy reports the MachinesHealthy condition of the worker. Failures are only logged as the
+// condition reports are not essential for the reconciliation.
+func (a *genericActuator) reportMachinesHealthy(ctx context.Context, w *extensionsv1alpha1.Worker, status gardencorev1alpha1.ConditionStatus, reason, message string) {
+	if err := controller.ReportCondition(ctx, worker.ConditionTypeMachinesHealthy, status, reason, message); err != nil {
+		a.logger.Error(err, "Could not report the machines condition", "worker", fmt.Sprintf("%s/%s", w.Namespace, w.Name))
+	}
+}
+
 func shootIsAwake(isHibernated bool, existingMachineDeployments *machinev1alpha1.MachineDeploymentList) bool {
 	if isHibernated {
 		return false
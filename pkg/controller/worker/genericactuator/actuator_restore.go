@@ -0,0 +1,36 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/pkg/errors"
+)
+
+// Restore rebuilds the seed-local resources of the given worker after a migration. The machine objects persisted
+// in the worker status are recreated first so that the usual reconciliation flow adopts the existing machines.
+func (a *genericActuator) Restore(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *controller.Cluster) error {
+	a.logger.Info("Restoring the machine objects", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	if err := a.restoreMachineState(ctx, worker); err != nil {
+		return errors.Wrapf(err, "failed to restore the machine state from worker status")
+	}
+
+	return a.Reconcile(ctx, worker, cluster)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// machineState is the snapshot of the machine objects of a worker that is persisted in its status. It only
+// contains the fields required to recreate the objects, i.e. neither status information that changes over time
+// nor references to other objects that are specific to the seed.
+type machineState struct {
+	MachineDeployments []machinev1alpha1.MachineDeployment `json:"machineDeployments,omitempty"`
+	MachineSets        []machinev1alpha1.MachineSet        `json:"machineSets,omitempty"`
+	Machines           []machinev1alpha1.Machine           `json:"machines,omitempty"`
+}
+
+// saveMachineState persists a snapshot of all machine objects of the given worker in its status.
+func (a *genericActuator) saveMachineState(ctx context.Context, worker *extensionsv1alpha1.Worker) error {
+	var (
+		state                 = &machineState{}
+		machineDeploymentList = &machinev1alpha1.MachineDeploymentList{}
+		machineSetList        = &machinev1alpha1.MachineSetList{}
+		machineList           = &machinev1alpha1.MachineList{}
+	)
+
+	if err := a.client.List(ctx, machineDeploymentList, client.InNamespace(worker.Namespace)); err != nil {
+		return err
+	}
+	for _, machineDeployment := range machineDeploymentList.Items {
+		state.MachineDeployments = append(state.MachineDeployments, machinev1alpha1.MachineDeployment{
+			ObjectMeta: snapshotObjectMeta(machineDeployment.ObjectMeta),
+			Spec:       machineDeployment.Spec,
+		})
+	}
+
+	if err := a.client.List(ctx, machineSetList, client.InNamespace(worker.Namespace)); err != nil {
+		return err
+	}
+	for _, machineSet := range machineSetList.Items {
+		state.MachineSets = append(state.MachineSets, machinev1alpha1.MachineSet{
+			ObjectMeta: snapshotObjectMeta(machineSet.ObjectMeta),
+			Spec:       machineSet.Spec,
+		})
+	}
+
+	if err := a.client.List(ctx, machineList, client.InNamespace(worker.Namespace)); err != nil {
+		return err
+	}
+	for _, machine := range machineList.Items {
+		state.Machines = append(state.Machines, machinev1alpha1.Machine{
+			ObjectMeta: snapshotObjectMeta(machine.ObjectMeta),
+			Spec:       machine.Spec,
+			// The node name is required by the machine-controller-manager to recognize that the machine was
+			// already created.
+			Status: machinev1alpha1.MachineStatus{Node: machine.Status.Node},
+		})
+	}
+
+	if len(state.MachineDeployments) == 0 && len(state.MachineSets) == 0 && len(state.Machines) == 0 {
+		return extensionscontroller.SaveState(ctx, a.client, worker, extensionscontroller.StateKeyMachines, nil)
+	}
+	return extensionscontroller.SaveStateObject(ctx, a.client, worker, extensionscontroller.StateKeyMachines, state)
+}
+
+// restoreMachineState recreates the machine objects persisted in the status of the given worker. Nothing is
+// restored if there is no persisted state or if machine deployments already exist in the seed.
+func (a *genericActuator) restoreMachineState(ctx context.Context, worker *extensionsv1alpha1.Worker) error {
+	state := &machineState{}
+	found, err := extensionscontroller.GetStateObject(worker, extensionscontroller.StateKeyMachines, state)
+	if err != nil || !found {
+		return err
+	}
+
+	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.client.List(ctx, existingMachineDeployments, client.InNamespace(worker.Namespace)); err != nil {
+		return err
+	}
+	if len(existingMachineDeployments.Items) > 0 {
+		return nil
+	}
+
+	var objects []runtime.Object
+	for i := range state.MachineDeployments {
+		objects = append(objects, &state.MachineDeployments[i])
+	}
+	for i := range state.MachineSets {
+		objects = append(objects, &state.MachineSets[i])
+	}
+	for i := range state.Machines {
+		objects = append(objects, &state.Machines[i])
+	}
+
+	for _, obj := range objects {
+		if err := a.client.Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func snapshotObjectMeta(meta metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        meta.Name,
+		Namespace:   meta.Namespace,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+}
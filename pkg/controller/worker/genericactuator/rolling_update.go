@@ -0,0 +1,312 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultRollingUpdateTimeout is the maximum duration of a rolling update of a machine deployment if the
+// machine deployment does not specify a timeout itself.
+const DefaultRollingUpdateTimeout = 30 * time.Minute
+
+// DefaultMachineDeploymentsReadyTimeout is the minimum duration the actuator waits for the machine deployments to
+// become available. Rolling updates that are not finished within this time are resumed by the next reconciliation.
+const DefaultMachineDeploymentsReadyTimeout = 5 * time.Minute
+
+const (
+	// AnnotationClusterAutoscalerScaleDownDisabled is the annotation that prevents the cluster-autoscaler from
+	// removing a node.
+	AnnotationClusterAutoscalerScaleDownDisabled = "cluster-autoscaler.kubernetes.io/scale-down-disabled"
+	// AnnotationScaleDownDisabledByRollingUpdate marks the nodes on which the worker actuator disabled the
+	// scale-down of the cluster-autoscaler because their machine deployment is being rolled.
+	AnnotationScaleDownDisabledByRollingUpdate = "worker.gardener.cloud/scale-down-disabled-by-rolling-update"
+)
+
+// RollingUpdatePhase is the phase of a rolling update of a machine deployment.
+type RollingUpdatePhase string
+
+const (
+	// RollingUpdatePhaseProgressing means that the machines of the machine deployment are being replaced.
+	RollingUpdatePhaseProgressing RollingUpdatePhase = "Progressing"
+	// RollingUpdatePhaseSucceeded means that all machines of the machine deployment were replaced and are ready.
+	RollingUpdatePhaseSucceeded RollingUpdatePhase = "Succeeded"
+	// RollingUpdatePhaseFailed means that the rolling update did not finish within its timeout or that a machine
+	// of the machine deployment failed.
+	RollingUpdatePhaseFailed RollingUpdatePhase = "Failed"
+)
+
+// MachineDeploymentRollingUpdate is the progress of a rolling update of a single machine deployment, i.e. of a
+// worker pool in a zone.
+type MachineDeploymentRollingUpdate struct {
+	// Name is the name of the machine deployment.
+	Name string `json:"name"`
+	// ClassName is the name of the machine class the machine deployment is updated to.
+	ClassName string `json:"className"`
+	// Phase is the phase of the rolling update.
+	Phase RollingUpdatePhase `json:"phase"`
+	// StartTime is the time the rolling update was started.
+	StartTime metav1.Time `json:"startTime"`
+	// Timeout is the maximum duration of the rolling update.
+	Timeout metav1.Duration `json:"timeout"`
+	// Replicas is the number of desired machines.
+	Replicas int32 `json:"replicas"`
+	// UpdatedReplicas is the number of machines that already use the new machine class.
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// ReadyReplicas is the number of ready machines.
+	ReadyReplicas int32 `json:"readyReplicas"`
+	// TotalReplicas is the number of all machines including the surge machines.
+	TotalReplicas int32 `json:"totalReplicas"`
+	// Message is a human readable description of the progress.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// RollingUpdateState is the progress of the rolling updates of the machine deployments of a worker. It is
+// persisted in the status of the worker under the extensionscontroller.StateKeyRollingUpdate key so that a
+// rolling update is resumed instead of restarted after the controller was restarted.
+type RollingUpdateState struct {
+	// MachineDeployments is the progress of the machine deployments that are or were lately rolled.
+	MachineDeployments []MachineDeploymentRollingUpdate `json:"machineDeployments,omitempty"`
+}
+
+// GetRollingUpdateState returns the rolling update progress persisted in the status of the given worker.
+func GetRollingUpdateState(worker *extensionsv1alpha1.Worker) (*RollingUpdateState, error) {
+	state := &RollingUpdateState{}
+	if _, err := extensionscontroller.GetStateObject(worker, extensionscontroller.StateKeyRollingUpdate, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// DeepCopy returns a deep copy of the RollingUpdateState.
+func (s *RollingUpdateState) DeepCopy() *RollingUpdateState {
+	out := &RollingUpdateState{}
+	if s.MachineDeployments != nil {
+		out.MachineDeployments = make([]MachineDeploymentRollingUpdate, len(s.MachineDeployments))
+		copy(out.MachineDeployments, s.MachineDeployments)
+	}
+	return out
+}
+
+// Get returns the progress of the machine deployment with the given name, or nil if it is not rolled.
+func (s *RollingUpdateState) Get(name string) *MachineDeploymentRollingUpdate {
+	for i := range s.MachineDeployments {
+		if s.MachineDeployments[i].Name == name {
+			return &s.MachineDeployments[i]
+		}
+	}
+	return nil
+}
+
+// IsProgressing returns true if the machine deployment with the given name is being rolled.
+func (s *RollingUpdateState) IsProgressing(name string) bool {
+	rollingUpdate := s.Get(name)
+	return rollingUpdate != nil && rollingUpdate.Phase == RollingUpdatePhaseProgressing
+}
+
+// computeRollingUpdateState determines which of the wanted machine deployments are rolled. A machine deployment
+// is rolled if its machine class changes, or if a previous rolling update to the same machine class is not yet
+// finished. The latter resumes the rolling update with its original start time. Failed rolling updates are
+// retried with a new start time, finished rolling updates are forgotten.
+func computeRollingUpdateState(previous *RollingUpdateState, existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments worker.MachineDeployments, now metav1.Time) *RollingUpdateState {
+	state := &RollingUpdateState{}
+
+	for _, deployment := range wantedMachineDeployments {
+		var (
+			previousRollingUpdate     = previous.Get(deployment.Name)
+			existingMachineDeployment = getExistingMachineDeployment(existingMachineDeployments, deployment.Name)
+		)
+
+		if previousRollingUpdate != nil && previousRollingUpdate.ClassName == deployment.ClassName && previousRollingUpdate.Phase == RollingUpdatePhaseProgressing {
+			state.MachineDeployments = append(state.MachineDeployments, *previousRollingUpdate)
+			continue
+		}
+
+		var (
+			classChanged = existingMachineDeployment != nil && existingMachineDeployment.Spec.Template.Spec.Class.Name != deployment.ClassName
+			retry        = previousRollingUpdate != nil && previousRollingUpdate.ClassName == deployment.ClassName && previousRollingUpdate.Phase == RollingUpdatePhaseFailed
+		)
+		if !classChanged && !retry {
+			continue
+		}
+
+		timeout := deployment.RollingUpdateTimeout
+		if timeout == 0 {
+			timeout = DefaultRollingUpdateTimeout
+		}
+
+		state.MachineDeployments = append(state.MachineDeployments, MachineDeploymentRollingUpdate{
+			Name:      deployment.Name,
+			ClassName: deployment.ClassName,
+			Phase:     RollingUpdatePhaseProgressing,
+			StartTime: now,
+			Timeout:   metav1.Duration{Duration: timeout},
+		})
+	}
+
+	return state
+}
+
+// updateRollingUpdateProgress updates the progress of the rolling updates in the given state with the status of
+// the given machine deployments. It returns an error if a rolling update failed.
+func updateRollingUpdateProgress(state *RollingUpdateState, existingMachineDeployments *machinev1alpha1.MachineDeploymentList, now metav1.Time) error {
+	for i := range state.MachineDeployments {
+		rollingUpdate := &state.MachineDeployments[i]
+		if rollingUpdate.Phase != RollingUpdatePhaseProgressing {
+			continue
+		}
+
+		existingMachineDeployment := getExistingMachineDeployment(existingMachineDeployments, rollingUpdate.Name)
+		if existingMachineDeployment == nil {
+			rollingUpdate.Message = "Waiting for the machine deployment to be created"
+		} else {
+			rollingUpdate.Replicas = existingMachineDeployment.Spec.Replicas
+			rollingUpdate.UpdatedReplicas = existingMachineDeployment.Status.UpdatedReplicas
+			rollingUpdate.ReadyReplicas = existingMachineDeployment.Status.ReadyReplicas
+			rollingUpdate.TotalReplicas = existingMachineDeployment.Status.Replicas
+			rollingUpdate.Message = fmt.Sprintf("%d/%d machines updated, %d/%d machines ready", rollingUpdate.UpdatedReplicas, rollingUpdate.Replicas, rollingUpdate.ReadyReplicas, rollingUpdate.TotalReplicas)
+
+			if len(existingMachineDeployment.Status.FailedMachines) > 0 {
+				failedMachine := existingMachineDeployment.Status.FailedMachines[0]
+				rollingUpdate.Phase = RollingUpdatePhaseFailed
+				rollingUpdate.Message = fmt.Sprintf("Machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description)
+				continue
+			}
+
+			if existingMachineDeployment.Spec.Template.Spec.Class.Name == rollingUpdate.ClassName &&
+				rollingUpdate.UpdatedReplicas >= rollingUpdate.Replicas &&
+				health.CheckMachineDeployment(existingMachineDeployment) == nil {
+				rollingUpdate.Phase = RollingUpdatePhaseSucceeded
+				continue
+			}
+		}
+
+		if now.Time.After(rollingUpdate.StartTime.Add(rollingUpdate.Timeout.Duration)) {
+			rollingUpdate.Phase = RollingUpdatePhaseFailed
+			rollingUpdate.Message = fmt.Sprintf("Rolling update did not finish within %s: %s", rollingUpdate.Timeout.Duration, rollingUpdate.Message)
+		}
+	}
+
+	for _, rollingUpdate := range state.MachineDeployments {
+		if rollingUpdate.Phase == RollingUpdatePhaseFailed {
+			return fmt.Errorf("rolling update of machine deployment %s failed: %s", rollingUpdate.Name, rollingUpdate.Message)
+		}
+	}
+	return nil
+}
+
+// waitTimeout returns how long the actuator waits for the machine deployments to become available. It waits at
+// least for the given minimum, or until the latest timeout of the progressing rolling updates expired.
+func (s *RollingUpdateState) waitTimeout(now metav1.Time, minimum time.Duration) time.Duration {
+	timeout := minimum
+	for _, rollingUpdate := range s.MachineDeployments {
+		if rollingUpdate.Phase != RollingUpdatePhaseProgressing {
+			continue
+		}
+		if remaining := rollingUpdate.StartTime.Add(rollingUpdate.Timeout.Duration).Sub(now.Time); remaining > timeout {
+			timeout = remaining
+		}
+	}
+	return timeout
+}
+
+// clusterAutoscalerManagedRollingUpdates returns the names of the machine deployments that are managed by the
+// cluster-autoscaler (i.e., whose minimum differs from its maximum) and that are being rolled.
+func clusterAutoscalerManagedRollingUpdates(state *RollingUpdateState, wantedMachineDeployments worker.MachineDeployments) sets.String {
+	names := sets.NewString()
+	for _, deployment := range wantedMachineDeployments {
+		if deployment.Minimum != deployment.Maximum && state.IsProgressing(deployment.Name) {
+			names.Insert(deployment.Name)
+		}
+	}
+	return names
+}
+
+// nodesOfMachineDeployments returns the names of the nodes that belong to the machines of the machine deployments
+// with the given names.
+func nodesOfMachineDeployments(machines *machinev1alpha1.MachineList, machineDeploymentNames sets.String) sets.String {
+	nodeNames := sets.NewString()
+	for _, machine := range machines.Items {
+		if machineDeploymentNames.Has(machine.Labels["name"]) && machine.Status.Node != "" {
+			nodeNames.Insert(machine.Status.Node)
+		}
+	}
+	return nodeNames
+}
+
+// disableClusterAutoscalerScaleDown prevents the cluster-autoscaler from removing the nodes of the machine
+// deployments managed by it while they are rolled, i.e. the cluster-autoscaler keeps running for all other worker
+// pools. The scale-down of the nodes is enabled again once their rolling update finished. Nodes on which the
+// scale-down was disabled by somebody else are left untouched.
+func (a *genericActuator) disableClusterAutoscalerScaleDown(ctx context.Context, shootClient client.Client, worker *extensionsv1alpha1.Worker, state *RollingUpdateState, wantedMachineDeployments worker.MachineDeployments) error {
+	machines := &machinev1alpha1.MachineList{}
+	if err := a.client.List(ctx, machines, client.InNamespace(worker.Namespace)); err != nil {
+		return err
+	}
+	nodeNames := nodesOfMachineDeployments(machines, clusterAutoscalerManagedRollingUpdates(state, wantedMachineDeployments))
+
+	nodes := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodes); err != nil {
+		return err
+	}
+
+	for i := range nodes.Items {
+		var (
+			node     = &nodes.Items[i]
+			disabled = node.Annotations[AnnotationScaleDownDisabledByRollingUpdate] == "true"
+		)
+
+		switch {
+		case nodeNames.Has(node.Name) && !disabled && node.Annotations[AnnotationClusterAutoscalerScaleDownDisabled] != "true":
+			patch := client.MergeFrom(node.DeepCopy())
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationClusterAutoscalerScaleDownDisabled, "true")
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationScaleDownDisabledByRollingUpdate, "true")
+			if err := shootClient.Patch(ctx, node, patch); err != nil {
+				return err
+			}
+		case !nodeNames.Has(node.Name) && disabled:
+			patch := client.MergeFrom(node.DeepCopy())
+			delete(node.Annotations, AnnotationClusterAutoscalerScaleDownDisabled)
+			delete(node.Annotations, AnnotationScaleDownDisabledByRollingUpdate)
+			if err := shootClient.Patch(ctx, node, patch); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// saveRollingUpdateState persists the given rolling update progress in the status of the given worker.
+func (a *genericActuator) saveRollingUpdateState(ctx context.Context, worker *extensionsv1alpha1.Worker, state *RollingUpdateState) error {
+	if len(state.MachineDeployments) == 0 {
+		return extensionscontroller.SaveState(ctx, a.client, worker, extensionscontroller.StateKeyRollingUpdate, nil)
+	}
+	return extensionscontroller.SaveStateObject(ctx, a.client, worker, extensionscontroller.StateKeyRollingUpdate, state)
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("RollingUpdate", func() {
+	var (
+		start = metav1.NewTime(time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC))
+
+		machineDeployment = func(name, className string, replicas, updated, ready int32) machinev1alpha1.MachineDeployment {
+			return machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: machinev1alpha1.MachineDeploymentSpec{
+					Replicas: replicas,
+					Template: machinev1alpha1.MachineTemplateSpec{
+						Spec: machinev1alpha1.MachineSpec{
+							Class: machinev1alpha1.ClassSpec{Name: className},
+						},
+					},
+				},
+				Status: machinev1alpha1.MachineDeploymentStatus{
+					Replicas:          updated + (replicas - ready),
+					UpdatedReplicas:   updated,
+					ReadyReplicas:     ready,
+					AvailableReplicas: ready,
+					Conditions: []machinev1alpha1.MachineDeploymentCondition{
+						{Type: machinev1alpha1.MachineDeploymentAvailable, Status: machinev1alpha1.ConditionTrue},
+						{Type: machinev1alpha1.MachineDeploymentProgressing, Status: machinev1alpha1.ConditionTrue},
+					},
+				},
+			}
+		}
+	)
+
+	Describe("#computeRollingUpdateState", func() {
+		var (
+			existing = &machinev1alpha1.MachineDeploymentList{
+				Items: []machinev1alpha1.MachineDeployment{
+					machineDeployment("pool-a", "class-a-old", 2, 2, 2),
+					machineDeployment("pool-b", "class-b", 2, 2, 2),
+				},
+			}
+			wanted = worker.MachineDeployments{
+				{Name: "pool-a", ClassName: "class-a-new", RollingUpdateTimeout: 10 * time.Minute},
+				{Name: "pool-b", ClassName: "class-b"},
+				{Name: "pool-c", ClassName: "class-c"},
+			}
+		)
+
+		It("should start rolling updates only for machine deployments with a new class", func() {
+			state := computeRollingUpdateState(&RollingUpdateState{}, existing, wanted, start)
+
+			Expect(state.MachineDeployments).To(Equal([]MachineDeploymentRollingUpdate{
+				{
+					Name:      "pool-a",
+					ClassName: "class-a-new",
+					Phase:     RollingUpdatePhaseProgressing,
+					StartTime: start,
+					Timeout:   metav1.Duration{Duration: 10 * time.Minute},
+				},
+			}))
+		})
+
+		It("should resume a progressing rolling update with its original start time", func() {
+			previous := &RollingUpdateState{MachineDeployments: []MachineDeploymentRollingUpdate{
+				{Name: "pool-b", ClassName: "class-b", Phase: RollingUpdatePhaseProgressing, StartTime: start, Timeout: metav1.Duration{Duration: time.Minute}},
+			}}
+
+			state := computeRollingUpdateState(previous, existing, wanted, metav1.NewTime(start.Add(time.Hour)))
+
+			Expect(state.IsProgressing("pool-a")).To(BeTrue())
+			Expect(state.Get("pool-b")).To(Equal(&previous.MachineDeployments[0]))
+		})
+
+		It("should retry a failed rolling update with a new start time and forget succeeded ones", func() {
+			var (
+				now      = metav1.NewTime(start.Add(time.Hour))
+				previous = &RollingUpdateState{MachineDeployments: []MachineDeploymentRollingUpdate{
+					{Name: "pool-a", ClassName: "class-a-old", Phase: RollingUpdatePhaseSucceeded, StartTime: start},
+					{Name: "pool-b", ClassName: "class-b", Phase: RollingUpdatePhaseFailed, StartTime: start},
+				}}
+			)
+
+			state := computeRollingUpdateState(previous, existing, wanted, now)
+
+			Expect(state.Get("pool-a").StartTime).To(Equal(now))
+			Expect(state.Get("pool-b")).To(Equal(&MachineDeploymentRollingUpdate{
+				Name:      "pool-b",
+				ClassName: "class-b",
+				Phase:     RollingUpdatePhaseProgressing,
+				StartTime: now,
+				Timeout:   metav1.Duration{Duration: DefaultRollingUpdateTimeout},
+			}))
+		})
+	})
+
+	Describe("#updateRollingUpdateProgress", func() {
+		var state *RollingUpdateState
+
+		BeforeEach(func() {
+			state = &RollingUpdateState{MachineDeployments: []MachineDeploymentRollingUpdate{
+				{Name: "pool-a", ClassName: "class-a", Phase: RollingUpdatePhaseProgressing, StartTime: start, Timeout: metav1.Duration{Duration: 10 * time.Minute}},
+			}}
+		})
+
+		It("should report the progress of a rolling update", func() {
+			existing := &machinev1alpha1.MachineDeploymentList{Items: []machinev1alpha1.MachineDeployment{
+				machineDeployment("pool-a", "class-a", 3, 1, 2),
+			}}
+
+			Expect(updateRollingUpdateProgress(state, existing, metav1.NewTime(start.Add(time.Minute)))).To(Succeed())
+
+			rollingUpdate := state.Get("pool-a")
+			Expect(rollingUpdate.Phase).To(Equal(RollingUpdatePhaseProgressing))
+			Expect(rollingUpdate.Replicas).To(Equal(int32(3)))
+			Expect(rollingUpdate.UpdatedReplicas).To(Equal(int32(1)))
+			Expect(rollingUpdate.ReadyReplicas).To(Equal(int32(2)))
+			Expect(rollingUpdate.TotalReplicas).To(Equal(int32(2)))
+		})
+
+		It("should mark a finished rolling update as succeeded", func() {
+			existing := &machinev1alpha1.MachineDeploymentList{Items: []machinev1alpha1.MachineDeployment{
+				machineDeployment("pool-a", "class-a", 3, 3, 3),
+			}}
+
+			Expect(updateRollingUpdateProgress(state, existing, metav1.NewTime(start.Add(time.Minute)))).To(Succeed())
+			Expect(state.Get("pool-a").Phase).To(Equal(RollingUpdatePhaseSucceeded))
+		})
+
+		It("should fail a rolling update that exceeded its timeout", func() {
+			existing := &machinev1alpha1.MachineDeploymentList{Items: []machinev1alpha1.MachineDeployment{
+				machineDeployment("pool-a", "class-a", 3, 1, 2),
+			}}
+
+			Expect(updateRollingUpdateProgress(state, existing, metav1.NewTime(start.Add(time.Hour)))).NotTo(Succeed())
+			Expect(state.Get("pool-a").Phase).To(Equal(RollingUpdatePhaseFailed))
+		})
+	})
+
+	Describe("#waitTimeout", func() {
+		It("should wait at least for the minimum", func() {
+			state := &RollingUpdateState{MachineDeployments: []MachineDeploymentRollingUpdate{
+				{Name: "pool-a", Phase: RollingUpdatePhaseProgressing, StartTime: start, Timeout: metav1.Duration{Duration: 10 * time.Minute}},
+			}}
+
+			Expect(state.waitTimeout(metav1.NewTime(start.Add(8*time.Minute)), 5*time.Minute)).To(Equal(5 * time.Minute))
+		})
+
+		It("should wait until the latest timeout of the progressing rolling updates expired", func() {
+			state := &RollingUpdateState{MachineDeployments: []MachineDeploymentRollingUpdate{
+				{Name: "pool-a", Phase: RollingUpdatePhaseProgressing, StartTime: start, Timeout: metav1.Duration{Duration: 10 * time.Minute}},
+				{Name: "pool-b", Phase: RollingUpdatePhaseProgressing, StartTime: start, Timeout: metav1.Duration{Duration: 20 * time.Minute}},
+				{Name: "pool-c", Phase: RollingUpdatePhaseSucceeded, StartTime: start, Timeout: metav1.Duration{Duration: time.Hour}},
+			}}
+
+			Expect(state.waitTimeout(metav1.NewTime(start.Add(time.Minute)), 5*time.Minute)).To(Equal(19 * time.Minute))
+		})
+	})
+
+	Describe("#clusterAutoscalerManagedRollingUpdates", func() {
+		state := &RollingUpdateState{MachineDeployments: []MachineDeploymentRollingUpdate{
+			{Name: "pool-a", Phase: RollingUpdatePhaseProgressing},
+			{Name: "pool-b", Phase: RollingUpdatePhaseSucceeded},
+		}}
+
+		It("should return nothing if only machine deployments not managed by the cluster-autoscaler are rolled", func() {
+			Expect(clusterAutoscalerManagedRollingUpdates(state, worker.MachineDeployments{
+				{Name: "pool-a", Minimum: 2, Maximum: 2},
+				{Name: "pool-b", Minimum: 1, Maximum: 3},
+			})).To(BeEmpty())
+		})
+
+		It("should return the rolled machine deployments managed by the cluster-autoscaler", func() {
+			Expect(clusterAutoscalerManagedRollingUpdates(state, worker.MachineDeployments{
+				{Name: "pool-a", Minimum: 1, Maximum: 3},
+				{Name: "pool-b", Minimum: 1, Maximum: 3},
+			})).To(Equal(sets.NewString("pool-a")))
+		})
+	})
+
+	Describe("#disableClusterAutoscalerScaleDown", func() {
+		var (
+			ctx = context.TODO()
+
+			ctrl        *gomock.Controller
+			shootClient *mockclient.MockClient
+			actuator    *genericActuator
+			patched     map[string]map[string]string
+
+			workerObj = &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "shoot--foo--bar"}}
+			wanted    = worker.MachineDeployments{
+				{Name: "pool-a", Minimum: 1, Maximum: 3},
+				{Name: "pool-b", Minimum: 1, Maximum: 3},
+			}
+
+			machine = func(name, machineDeploymentName, nodeName string) *machinev1alpha1.Machine {
+				return &machinev1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: workerObj.Namespace, Labels: map[string]string{"name": machineDeploymentName}},
+					Status:     machinev1alpha1.MachineStatus{Node: nodeName},
+				}
+			}
+			node = func(name string, annotations map[string]string) corev1.Node {
+				return corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations}}
+			}
+			disabledByRollingUpdate = map[string]string{
+				AnnotationClusterAutoscalerScaleDownDisabled: "true",
+				AnnotationScaleDownDisabledByRollingUpdate:   "true",
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			shootClient = mockclient.NewMockClient(ctrl)
+			patched = map[string]map[string]string{}
+
+			scheme := runtime.NewScheme()
+			Expect(machinev1alpha1.AddToScheme(scheme)).To(Succeed())
+			actuator = &genericActuator{client: fake.NewFakeClientWithScheme(scheme,
+				machine("machine-a", "pool-a", "node-a"),
+				machine("machine-b", "pool-b", "node-b"),
+				machine("machine-c", "pool-a", "node-c"),
+			)}
+
+			shootClient.EXPECT().List(ctx, &corev1.NodeList{}).DoAndReturn(func(_ context.Context, list *corev1.NodeList, _ ...client.ListOptionFunc) error {
+				list.Items = []corev1.Node{
+					node("node-a", nil),
+					node("node-b", map[string]string{
+						AnnotationClusterAutoscalerScaleDownDisabled: "true",
+						AnnotationScaleDownDisabledByRollingUpdate:   "true",
+					}),
+					node("node-c", map[string]string{AnnotationClusterAutoscalerScaleDownDisabled: "true"}),
+				}
+				return nil
+			})
+			shootClient.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&corev1.Node{}), gomock.Any()).DoAndReturn(func(_ context.Context, node *corev1.Node, _ client.Patch, _ ...client.PatchOptionFunc) error {
+				patched[node.Name] = node.Annotations
+				return nil
+			}).AnyTimes()
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should disable the scale-down only for the nodes of the rolled machine deployments", func() {
+			state := &RollingUpdateState{MachineDeployments: []MachineDeploymentRollingUpdate{
+				{Name: "pool-a", Phase: RollingUpdatePhaseProgressing},
+				{Name: "pool-b", Phase: RollingUpdatePhaseSucceeded},
+			}}
+
+			Expect(actuator.disableClusterAutoscalerScaleDown(ctx, shootClient, workerObj, state, wanted)).To(Succeed())
+			Expect(patched).To(Equal(map[string]map[string]string{
+				"node-a": disabledByRollingUpdate,
+				"node-b": {},
+			}))
+		})
+
+		It("should not enable the scale-down of nodes on which it was disabled by somebody else", func() {
+			Expect(actuator.disableClusterAutoscalerScaleDown(ctx, shootClient, workerObj, &RollingUpdateState{}, wanted)).To(Succeed())
+			Expect(patched).To(Equal(map[string]map[string]string{
+				"node-b": {},
+			}))
+		})
+	})
+})
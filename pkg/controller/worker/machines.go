@@ -15,13 +15,20 @@
 package worker
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"math"
+	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 )
@@ -47,6 +54,9 @@ type MachineDeployment struct {
 	Labels         map[string]string
 	Annotations    map[string]string
 	Taints         []corev1.Taint
+	// RollingUpdateTimeout is the maximum duration of a rolling update of the machine deployment. The default
+	// timeout of the actuator is used if it is zero.
+	RollingUpdateTimeout time.Duration
 }
 
 // MachineDeployments is a list of machine deployments.
@@ -91,6 +101,71 @@ func MachineClassHash(machineClassSpec map[string]interface{}, version string) s
 	return utils.ComputeSHA256Hex([]byte(fmt.Sprintf("%s-%s", utils.HashForMap(machineClassSpec), version)))[:5]
 }
 
+// MachineClassHashWithProviderConfig returns the hash of the <machineClassSpec> and the <version> like MachineClassHash,
+// but additionally takes the decoded <providerConfig> of the worker pool into account. utils.HashForMap ignores values
+// of some types, e.g. int64 or map[string]string, hence changes of such settings would otherwise not roll the machines.
+// If <providerConfig> is nil or marshals like an empty one, the hash equals the one of MachineClassHash, i.e. machine
+// classes of worker pools without provider config keep their names, also if settings that are excluded from the
+// marshalled config and don't affect the machines, like the rolling update timeout, are added later.
+func MachineClassHashWithProviderConfig(machineClassSpec map[string]interface{}, version string, providerConfig runtime.Object) (string, error) {
+	if providerConfig == nil || reflect.ValueOf(providerConfig).IsNil() {
+		return MachineClassHash(machineClassSpec, version), nil
+	}
+
+	// The keys of maps are sorted when they are marshalled, hence the result is deterministic.
+	data, err := json.Marshal(providerConfig)
+	if err != nil {
+		return "", err
+	}
+
+	emptyProviderConfig := reflect.New(reflect.TypeOf(providerConfig).Elem()).Interface().(runtime.Object)
+	emptyProviderConfig.GetObjectKind().SetGroupVersionKind(providerConfig.GetObjectKind().GroupVersionKind())
+	emptyData, err := json.Marshal(emptyProviderConfig)
+	if err != nil {
+		return "", err
+	}
+	if bytes.Equal(data, emptyData) {
+		return MachineClassHash(machineClassSpec, version), nil
+	}
+
+	return utils.ComputeSHA256Hex([]byte(fmt.Sprintf("%s-%s-%s", utils.HashForMap(machineClassSpec), version, utils.ComputeSHA256Hex(data))))[:5], nil
+}
+
+// DecodeProviderConfig decodes the provider config of the given worker pool into <into>. It returns <into> if the pool
+// has a provider config and nil otherwise. In the latter case, <into> is left unchanged.
+func DecodeProviderConfig(decoder runtime.Decoder, pool extensionsv1alpha1.WorkerPool, into runtime.Object) (runtime.Object, error) {
+	if pool.ProviderConfig == nil || pool.ProviderConfig.Raw == nil {
+		return nil, nil
+	}
+
+	if _, _, err := decoder.Decode(pool.ProviderConfig.Raw, nil, into); err != nil {
+		return nil, fmt.Errorf("could not decode provider config of worker pool %q: %v", pool.Name, err)
+	}
+	return into, nil
+}
+
+// RollingUpdateTimeout returns the duration of the given rolling update timeout of a worker pool, or zero if it is not
+// set, i.e. if the default timeout of the actuator is used.
+func RollingUpdateTimeout(timeout *metav1.Duration) time.Duration {
+	if timeout == nil {
+		return 0
+	}
+	return timeout.Duration
+}
+
+// MergeTags returns the union of the <additionalTags>, e.g. the tags of the provider config of a worker pool, and the
+// <requiredTags>. The required tags take precedence, i.e. they cannot be overwritten by the additional tags.
+func MergeTags(additionalTags, requiredTags map[string]string) map[string]string {
+	tags := make(map[string]string, len(additionalTags)+len(requiredTags))
+	for key, value := range additionalTags {
+		tags[key] = value
+	}
+	for key, value := range requiredTags {
+		tags[key] = value
+	}
+	return tags
+}
+
 // DistributeOverZones is a function which is used to determine how many nodes should be used
 // for each availability zone. It takes the number of availability zones (<zoneSize>), the
 // index of the current zone (<zoneIndex>) and the number of nodes which must be distributed
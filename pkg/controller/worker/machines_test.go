@@ -17,6 +17,11 @@ package worker_test
 import (
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	. "github.com/onsi/ginkgo"
@@ -70,6 +75,100 @@ var _ = Describe("Machines", func() {
 		Entry("non-empty spec", map[string]interface{}{"foo": "bar"}, "1.5", "5a88b"),
 	)
 
+	Describe("#MachineClassHashWithProviderConfig", func() {
+		var spec = map[string]interface{}{"foo": "bar"}
+
+		hash := func(providerConfig runtime.Object) string {
+			h, err := worker.MachineClassHashWithProviderConfig(spec, "1.5", providerConfig)
+			Expect(err).NotTo(HaveOccurred())
+			return h
+		}
+
+		It("should return the hash of MachineClassHash if there is no provider config", func() {
+			var nilConfigMap *corev1.ConfigMap
+
+			Expect(hash(nil)).To(Equal(worker.MachineClassHash(spec, "1.5")))
+			Expect(hash(nilConfigMap)).To(Equal(worker.MachineClassHash(spec, "1.5")))
+		})
+
+		It("should return the hash of MachineClassHash if the provider config is empty", func() {
+			configMap := &corev1.ConfigMap{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}}
+
+			Expect(hash(configMap)).To(Equal(worker.MachineClassHash(spec, "1.5")))
+			Expect(hash(&corev1.ConfigMap{Data: map[string]string{"foo": "bar"}})).NotTo(Equal(worker.MachineClassHash(spec, "1.5")))
+		})
+
+		It("should consider values that are ignored by the hash of the spec", func() {
+			var (
+				deadline1 = int64(1)
+				deadline2 = int64(2)
+			)
+
+			Expect(hash(&corev1.ConfigMap{Data: map[string]string{"foo": "bar"}})).
+				NotTo(Equal(hash(&corev1.ConfigMap{Data: map[string]string{"foo": "baz"}})))
+			Expect(hash(&corev1.Pod{Spec: corev1.PodSpec{ActiveDeadlineSeconds: &deadline1}})).
+				NotTo(Equal(hash(&corev1.Pod{Spec: corev1.PodSpec{ActiveDeadlineSeconds: &deadline2}})))
+		})
+
+		It("should be deterministic", func() {
+			configMap := &corev1.ConfigMap{Data: map[string]string{"a": "1", "b": "2", "c": "3"}}
+
+			Expect(hash(configMap)).To(Equal(hash(configMap.DeepCopy())))
+		})
+	})
+
+	Describe("#DecodeProviderConfig", func() {
+		var decoder runtime.Decoder
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+		})
+
+		It("should return nil if the pool has no provider config", func() {
+			configMap := &corev1.ConfigMap{}
+
+			providerConfig, err := worker.DecodeProviderConfig(decoder, extensionsv1alpha1.WorkerPool{}, configMap)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(providerConfig).To(BeNil())
+			Expect(configMap).To(Equal(&corev1.ConfigMap{}))
+		})
+
+		It("should decode the provider config of the pool", func() {
+			configMap := &corev1.ConfigMap{}
+			pool := extensionsv1alpha1.WorkerPool{
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","data":{"foo":"bar"}}`)},
+			}
+
+			providerConfig, err := worker.DecodeProviderConfig(decoder, pool, configMap)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(providerConfig).To(BeIdenticalTo(configMap))
+			Expect(configMap.Data).To(Equal(map[string]string{"foo": "bar"}))
+		})
+
+		It("should fail if the provider config cannot be decoded", func() {
+			pool := extensionsv1alpha1.WorkerPool{
+				Name:           "pool",
+				ProviderConfig: &runtime.RawExtension{Raw: []byte("invalid")},
+			}
+
+			_, err := worker.DecodeProviderConfig(decoder, pool, &corev1.ConfigMap{})
+			Expect(err).To(MatchError(ContainSubstring(`could not decode provider config of worker pool "pool"`)))
+		})
+	})
+
+	DescribeTable("#MergeTags",
+		func(additionalTags, requiredTags, expected map[string]string) {
+			Expect(worker.MergeTags(additionalTags, requiredTags)).To(Equal(expected))
+		},
+
+		Entry("no tags", nil, nil, map[string]string{}),
+		Entry("only required tags", nil, map[string]string{"a": "1"}, map[string]string{"a": "1"}),
+		Entry("additional tags", map[string]string{"b": "2"}, map[string]string{"a": "1"}, map[string]string{"a": "1", "b": "2"}),
+		Entry("required tags cannot be overwritten", map[string]string{"a": "2"}, map[string]string{"a": "1"}, map[string]string{"a": "1"}),
+	)
+
 	DescribeTable("#DistributeOverZones",
 		func(zoneIndex, size, zoneSize, expectation int) {
 			Expect(worker.DistributeOverZones(zoneIndex, size, zoneSize)).To(Equal(expectation))
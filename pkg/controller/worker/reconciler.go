@@ -20,6 +20,7 @@ import (
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -28,8 +29,11 @@ import (
 
 	"github.com/go-logr/logr"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,22 +43,32 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
+const (
+	// EventWorkerDryRun an event reason to describe a worker dry-run.
+	EventWorkerDryRun string = "WorkerDryRun"
+)
+
 type reconciler struct {
 	logger   logr.Logger
 	actuator Actuator
+	dryRun   bool
 
-	ctx    context.Context
-	client client.Client
+	ctx      context.Context
+	client   client.Client
+	recorder record.EventRecorder
 }
 
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // Worker resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+// If dryRun is true, all Worker resources are only dry-run instead of being reconciled.
+func NewReconciler(mgr manager.Manager, actuator Actuator, dryRun bool) reconcile.Reconciler {
 	return extensionscontroller.OperationAnnotationWrapper(
 		&extensionsv1alpha1.Worker{},
 		&reconciler{
 			logger:   log.Log.WithName(ControllerName),
 			actuator: actuator,
+			dryRun:   dryRun,
+			recorder: mgr.GetEventRecorderFor(ControllerName),
 		},
 	)
 }
@@ -87,6 +101,19 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
+	operationType := extensionscontroller.ComputeOperationType(worker.ObjectMeta, worker.Status.LastOperation)
+
+	switch {
+	case r.dryRun || extensionscontroller.IsDryRun(worker.ObjectMeta):
+		return r.dryRunWorker(r.ctx, worker, cluster)
+	case operationType == extensionscontroller.LastOperationTypeMigrate:
+		return r.migrate(r.ctx, worker, cluster)
+	case operationType == extensionscontroller.LastOperationTypeRestore:
+		return r.restore(r.ctx, worker, cluster)
+	case extensionscontroller.IsMigrated(worker):
+		return reconcile.Result{}, nil
+	}
+
 	// Deletion flow
 	if worker.DeletionTimestamp != nil {
 		hasFinalizer, err := extensionscontroller.HasFinalizer(worker, FinalizerName)
@@ -99,13 +126,16 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 			return reconcile.Result{}, nil
 		}
 
-		operationType := v1alpha1constantshelper.ComputeOperationType(worker.ObjectMeta, worker.Status.LastOperation)
 		if err := r.updateStatusProcessing(r.ctx, worker, operationType, "Deleting the worker"); err != nil {
 			return reconcile.Result{}, err
 		}
 
 		r.logger.Info("Starting the deletion of worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-		if err := r.actuator.Delete(r.ctx, worker, cluster); err != nil {
+		progressCtx, flushProgress := extensionscontroller.NewProgressContext(r.ctx, r.client, worker)
+		observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.WorkerResource, worker.Spec.Type, operationType)
+		err = observe(r.actuator.Delete(progressCtx, worker, cluster))
+		flushProgress()
+		if err != nil {
 			msg := "Error deleting worker"
 			utilruntime.HandleError(r.updateStatusError(r.ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
 			r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
@@ -132,12 +162,15 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
-	operationType := v1alpha1constantshelper.ComputeOperationType(worker.ObjectMeta, worker.Status.LastOperation)
 	if err := r.updateStatusProcessing(r.ctx, worker, operationType, "Reconciling the worker"); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	if err := r.actuator.Reconcile(r.ctx, worker, cluster); err != nil {
+	progressCtx, flushProgress := extensionscontroller.NewProgressContext(r.ctx, r.client, worker)
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.WorkerResource, worker.Spec.Type, operationType)
+	err = observe(r.actuator.Reconcile(progressCtx, worker, cluster))
+	flushProgress()
+	if err != nil {
 		msg := "Error reconciling worker"
 		utilruntime.HandleError(r.updateStatusError(r.ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
 		r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
@@ -153,6 +186,118 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 	return reconcile.Result{}, nil
 }
 
+func (r *reconciler) migrate(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	operationType := extensionscontroller.LastOperationTypeMigrate
+	if err := r.updateStatusProcessing(ctx, worker, operationType, "Migrating the worker"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the migration of worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.WorkerResource, worker.Spec.Type, operationType)
+	if err := observe(r.actuator.Migrate(ctx, worker, cluster)); err != nil {
+		msg := "Error migrating worker"
+		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
+		r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully migrated worker"
+	r.logger.Info(msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	if err := r.updateStatusSuccess(ctx, worker, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Removing finalizer.", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, worker); err != nil {
+		r.logger.Error(err, "Error removing finalizer from Worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, worker); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) restore(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, FinalizerName, worker); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	operationType := extensionscontroller.LastOperationTypeRestore
+	if err := r.updateStatusProcessing(ctx, worker, operationType, "Restoring the worker"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the restoration of worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	progressCtx, flushProgress := extensionscontroller.NewProgressContext(ctx, r.client, worker)
+	observe := extensionsmetrics.ObserveOperation(extensionsv1alpha1.WorkerResource, worker.Spec.Type, operationType)
+	err := observe(r.actuator.Restore(progressCtx, worker, cluster))
+	flushProgress()
+	if err != nil {
+		msg := "Error restoring worker"
+		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
+		r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully restored worker"
+	r.logger.Info(msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	if err := r.updateStatusSuccess(ctx, worker, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, worker); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) dryRunWorker(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	result := &extensionscontroller.DryRunResult{Time: metav1.Now()}
+
+	if worker.DeletionTimestamp != nil {
+		result.Add(extensionscontroller.DryRunChange{
+			Kind:      extensionsv1alpha1.WorkerResource,
+			Namespace: worker.Namespace,
+			Name:      worker.Name,
+			Action:    extensionscontroller.DryRunActionDelete,
+		})
+	} else {
+		dryRunner, ok := r.actuator.(DryRunner)
+		if !ok {
+			msg := "Dry-run is not supported for this worker"
+			r.logger.Info(msg, "worker", worker.Name)
+			r.recorder.Event(worker, corev1.EventTypeWarning, EventWorkerDryRun, msg)
+			return reconcile.Result{}, extensionscontroller.RemoveDryRunOperationAnnotation(ctx, r.client, worker)
+		}
+
+		r.logger.Info("Starting the dry-run of worker", "worker", worker.Name)
+		changes, err := dryRunner.DryRun(ctx, worker, cluster)
+		if err != nil {
+			msg := "Error during dry-run of worker"
+			r.recorder.Eventf(worker, corev1.EventTypeWarning, EventWorkerDryRun, "%s: %+v", msg, err)
+			r.logger.Error(err, msg, "worker", worker.Name)
+			return extensionscontroller.ReconcileErr(err)
+		}
+		if changes != nil {
+			result.Add(changes.Changes...)
+		}
+	}
+
+	if err := extensionscontroller.SaveDryRunResult(ctx, r.client, worker, result); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	msg := fmt.Sprintf("Finished dry-run of worker: %s", result.Summary())
+	r.logger.Info(msg, "worker", worker.Name)
+	r.recorder.Event(worker, corev1.EventTypeNormal, EventWorkerDryRun, msg)
+
+	return reconcile.Result{}, extensionscontroller.RemoveDryRunOperationAnnotation(ctx, r.client, worker)
+}
+
 func (r *reconciler) updateStatusProcessing(ctx context.Context, worker *extensionsv1alpha1.Worker, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, worker, func() error {
 		worker.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
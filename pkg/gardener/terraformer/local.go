@@ -0,0 +1,438 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/controller/metrics"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultTerraformBinary is the default Terraform binary that is looked up in the PATH.
+	DefaultTerraformBinary = "terraform"
+
+	numberOfConfigResources = 3
+)
+
+// LocalOptions are options for Terraformers that run Terraform as a local subprocess.
+type LocalOptions struct {
+	// Binary is the Terraform binary, e.g. a fake binary for tests. It defaults to DefaultTerraformBinary.
+	Binary string
+	// PluginDir is the directory containing the Terraform provider plugins. If empty, Terraform downloads them.
+	PluginDir string
+	// WorkDir is the directory in which the temporary working directories are created. It defaults to the
+	// directory for temporary files.
+	WorkDir string
+}
+
+type localFactory struct {
+	options LocalOptions
+}
+
+// NewLocalFactory returns a Factory whose Terraformers run Terraform as a local subprocess of the controller
+// instead of launching terraformer pods in the seed. They use the same configuration, variables and state
+// ConfigMaps and Secrets as the Terraformers of the DefaultFactory, hence both are interchangeable.
+func NewLocalFactory(options LocalOptions) Factory {
+	if options.Binary == "" {
+		options.Binary = DefaultTerraformBinary
+	}
+	return localFactory{options}
+}
+
+// NewForConfig implements Factory.
+func (f localFactory) NewForConfig(logger logrus.FieldLogger, config *rest.Config, purpose, namespace, name, image string) (Interface, error) {
+	c, err := client.New(config, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	return f.New(logger, c, nil, purpose, namespace, name, image), nil
+}
+
+// New implements Factory. The core v1 client and the image are not used as no pods are created.
+func (f localFactory) New(logger logrus.FieldLogger, client client.Client, _ corev1client.CoreV1Interface, purpose, namespace, name, _ string) Interface {
+	prefix := fmt.Sprintf("%s.%s", name, purpose)
+
+	return &localTerraformer{
+		options: f.options,
+		logger:  logger,
+		client:  client,
+
+		purpose:       purpose,
+		namespace:     namespace,
+		prefix:        prefix,
+		configName:    prefix + common.TerraformerConfigSuffix,
+		variablesName: prefix + common.TerraformerVariablesSuffix,
+		stateName:     prefix + common.TerraformerStateSuffix,
+
+		activeDeadlineSeconds: 3600,
+	}
+}
+
+// DefaultInitializer implements Factory.
+func (localFactory) DefaultInitializer(c client.Client, main, variables string, tfVars []byte) Initializer {
+	return initializerFunc(gardenerterraformer.DefaultInitializer(c, main, variables, tfVars))
+}
+
+// localTerraformer is a Terraformer that runs Terraform as a local subprocess. The Terraform process is killed
+// after the active deadline seconds. The job backoff limit and the deadlines for cleaning, pods and jobs have no
+// effect as no pods or jobs are created.
+type localTerraformer struct {
+	options LocalOptions
+	logger  logrus.FieldLogger
+	client  client.Client
+
+	purpose       string
+	namespace     string
+	prefix        string
+	configName    string
+	variablesName string
+	stateName     string
+
+	variablesEnvironment  map[string]string
+	configurationDefined  bool
+	activeDeadlineSeconds int64
+}
+
+// SetVariablesEnvironment implements Terraformer.
+func (t *localTerraformer) SetVariablesEnvironment(tfVarsEnvironment map[string]string) Interface {
+	out := *t
+	out.variablesEnvironment = tfVarsEnvironment
+	return &out
+}
+
+// SetJobBackoffLimit implements Terraformer.
+func (t *localTerraformer) SetJobBackoffLimit(int32) Interface {
+	return t
+}
+
+// SetActiveDeadlineSeconds implements Terraformer.
+func (t *localTerraformer) SetActiveDeadlineSeconds(val int64) Interface {
+	out := *t
+	out.activeDeadlineSeconds = val
+	return &out
+}
+
+// SetDeadlineCleaning implements Terraformer.
+func (t *localTerraformer) SetDeadlineCleaning(time.Duration) Interface {
+	return t
+}
+
+// SetDeadlinePod implements Terraformer.
+func (t *localTerraformer) SetDeadlinePod(time.Duration) Interface {
+	return t
+}
+
+// SetDeadlineJob implements Terraformer.
+func (t *localTerraformer) SetDeadlineJob(time.Duration) Interface {
+	return t
+}
+
+// InitializeWith implements Terraformer.
+func (t *localTerraformer) InitializeWith(initializer Initializer) Interface {
+	out := *t
+	if err := initializer.Initialize(&gardenerterraformer.InitializerConfig{
+		Namespace:         t.namespace,
+		ConfigurationName: t.configName,
+		VariablesName:     t.variablesName,
+		StateName:         t.stateName,
+		InitializeState:   t.isStateEmpty(),
+	}); err != nil {
+		t.logger.Errorf("Could not create the Terraform ConfigMaps/Secrets: %s", err.Error())
+		return &out
+	}
+	out.configurationDefined = true
+	return &out
+}
+
+// Apply implements Terraformer.
+func (t *localTerraformer) Apply() error {
+	if !t.configurationDefined {
+		return errors.New("Terraformer configuration has not been defined, cannot execute the Terraform scripts")
+	}
+	observe := extensionsmetrics.ObserveTerraformApply(t.purpose)
+	_, err := t.execute(context.TODO(), "apply", "-auto-approve")
+	return observe(err)
+}
+
+// Destroy implements Terraformer.
+func (t *localTerraformer) Destroy() error {
+	if _, err := t.execute(context.TODO(), "destroy", "-auto-approve"); err != nil {
+		return err
+	}
+	return t.CleanupConfiguration(context.TODO())
+}
+
+// Plan implements Terraformer.
+func (t *localTerraformer) Plan() (*Plan, error) {
+	if !t.configurationDefined {
+		return nil, errors.New("Terraformer configuration has not been defined, cannot plan the Terraform scripts")
+	}
+
+	output, err := t.execute(context.TODO(), "plan", "-detailed-exitcode")
+	if err != nil {
+		if exitErr, ok := err.(*exitError); ok && exitErr.code == planExitCodeChanges {
+			return ParsePlanOutput(exitErr.output), nil
+		}
+		return nil, err
+	}
+	return ParsePlanOutput(output), nil
+}
+
+// GetStateOutputVariables implements Terraformer.
+func (t *localTerraformer) GetStateOutputVariables(variables ...string) (map[string]string, error) {
+	state, err := ReadState(t)
+	if err != nil {
+		return nil, err
+	}
+	return state.GetStateOutputVariables(variables...)
+}
+
+// GetState implements Terraformer.
+func (t *localTerraformer) GetState() ([]byte, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := t.client.Get(context.TODO(), kutil.Key(t.namespace, t.stateName), configMap); err != nil {
+		return nil, err
+	}
+	return []byte(configMap.Data[gardenerterraformer.StateKey]), nil
+}
+
+// ConfigExists implements Terraformer.
+func (t *localTerraformer) ConfigExists() (bool, error) {
+	numberOfExistingResources, err := t.verifyConfigExists(context.TODO())
+	return numberOfExistingResources == numberOfConfigResources, err
+}
+
+// CleanupConfiguration implements Terraformer.
+func (t *localTerraformer) CleanupConfiguration(ctx context.Context) error {
+	for _, obj := range []runtime.Object{
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.variablesName}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.configName}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.stateName}},
+	} {
+		if err := t.client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *localTerraformer) isStateEmpty() bool {
+	state, err := t.GetState()
+	if err != nil {
+		return apierrors.IsNotFound(err)
+	}
+	return len(state) == 0
+}
+
+func (t *localTerraformer) verifyConfigExists(ctx context.Context) (int, error) {
+	numberOfExistingResources := 0
+	for _, obj := range []struct {
+		name string
+		obj  runtime.Object
+	}{
+		{t.stateName, &corev1.ConfigMap{}},
+		{t.variablesName, &corev1.Secret{}},
+		{t.configName, &corev1.ConfigMap{}},
+	} {
+		if err := t.client.Get(ctx, kutil.Key(t.namespace, obj.name), obj.obj); err == nil {
+			numberOfExistingResources++
+		} else if !apierrors.IsNotFound(err) {
+			return -1, err
+		}
+	}
+	return numberOfExistingResources, nil
+}
+
+// execute runs the given Terraform command in a temporary working directory that is populated from the
+// configuration, variables and state resources. Unless a plan is run, the resulting state is written back to the
+// state ConfigMap, even if the command failed as the state might already reference created resources.
+func (t *localTerraformer) execute(ctx context.Context, command string, args ...string) (string, error) {
+	numberOfExistingResources, err := t.verifyConfigExists(ctx)
+	if err != nil {
+		return "", err
+	}
+	switch numberOfExistingResources {
+	case 0:
+		t.logger.Debug("All ConfigMaps/Secrets do not exist, no need to run Terraform")
+		return "", nil
+	case numberOfConfigResources:
+	default:
+		return "", fmt.Errorf("could not find all %d ConfigMaps/Secrets for Terraform %s", numberOfConfigResources, command)
+	}
+
+	if t.variablesEnvironment == nil {
+		return "", errors.New("no Terraform variables environment provided")
+	}
+
+	dir, err := ioutil.TempDir(t.options.WorkDir, t.prefix+"-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := t.writeWorkingDirectory(ctx, dir); err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(t.activeDeadlineSeconds)*time.Second)
+	defer cancel()
+
+	initArgs := []string{"init", "-input=false", "-no-color"}
+	if t.options.PluginDir != "" {
+		initArgs = append(initArgs, "-plugin-dir="+t.options.PluginDir)
+	}
+	if _, err := t.run(ctx, dir, initArgs...); err != nil {
+		return "", err
+	}
+
+	t.logger.Infof("Running Terraform %s for '%s' in %s", command, t.prefix, dir)
+	output, runErr := t.run(ctx, dir, append([]string{command, "-input=false", "-no-color"}, args...)...)
+
+	if command != "plan" {
+		if err := t.saveState(ctx, dir); err != nil {
+			return output, err
+		}
+	}
+
+	return output, runErr
+}
+
+func (t *localTerraformer) writeWorkingDirectory(ctx context.Context, dir string) error {
+	config := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, kutil.Key(t.namespace, t.configName), config); err != nil {
+		return err
+	}
+
+	variables := &corev1.Secret{}
+	if err := t.client.Get(ctx, kutil.Key(t.namespace, t.variablesName), variables); err != nil {
+		return err
+	}
+
+	state := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, kutil.Key(t.namespace, t.stateName), state); err != nil {
+		return err
+	}
+
+	files := map[string][]byte{
+		gardenerterraformer.MainKey:      []byte(config.Data[gardenerterraformer.MainKey]),
+		gardenerterraformer.VariablesKey: []byte(config.Data[gardenerterraformer.VariablesKey]),
+		gardenerterraformer.TFVarsKey:    variables.Data[gardenerterraformer.TFVarsKey],
+	}
+	if data := state.Data[gardenerterraformer.StateKey]; len(data) > 0 {
+		files[gardenerterraformer.StateKey] = []byte(data)
+	}
+
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *localTerraformer) saveState(ctx context.Context, dir string) error {
+	data, err := ioutil.ReadFile(filepath.Join(dir, gardenerterraformer.StateKey))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	_, err = gardenerterraformer.CreateOrUpdateStateConfigMap(ctx, t.client, t.namespace, t.stateName, string(data))
+	return err
+}
+
+func (t *localTerraformer) run(ctx context.Context, dir string, args ...string) (string, error) {
+	var output bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, t.options.Binary, args...)
+	cmd.Dir = dir
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	cmd.Env = append(os.Environ(), "TF_IN_AUTOMATION=true")
+	for key, value := range t.variablesEnvironment {
+		cmd.Env = append(cmd.Env, key+"="+value)
+	}
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return output.String(), fmt.Errorf("terraform %s did not finish within %d seconds", args[0], t.activeDeadlineSeconds)
+		}
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return output.String(), &exitError{command: args[0], code: exitErr.ExitCode(), output: output.String()}
+		}
+		return output.String(), err
+	}
+	return output.String(), nil
+}
+
+// exitError is returned if a Terraform command exits with a non-zero exit code.
+type exitError struct {
+	command string
+	code    int
+	output  string
+}
+
+// Error implements error.
+func (e *exitError) Error() string {
+	output := e.output
+	if index := strings.Index(output, "Error"); index != -1 {
+		output = output[index:]
+	}
+	return fmt.Sprintf("terraform %s failed with exit code %d:\n%s", e.command, e.code, strings.TrimSpace(output))
+}
+
+type variablesNotFoundError struct {
+	variables []string
+}
+
+// Error implements error.
+func (e *variablesNotFoundError) Error() string {
+	return fmt.Sprintf("could not find all requested variables: %+v", e.variables)
+}
+
+// IsVariablesNotFoundError returns true if the error indicates that not all output variables have been found
+// in the Terraform state. It supports the errors of the Terraformers of all factories.
+func IsVariablesNotFoundError(err error) bool {
+	if _, ok := err.(*variablesNotFoundError); ok {
+		return true
+	}
+	return gardenerterraformer.IsVariablesNotFoundError(err)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	"github.com/gardener/gardener/pkg/logger"
+	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeTerraform is a fake Terraform binary that verifies its inputs and records a state with the given variable
+// as output.
+const fakeTerraform = `#!/bin/sh
+case "$1" in
+init)
+  test -f main.tf -a -f variables.tf -a -f terraform.tfvars || { echo "Error: configuration missing"; exit 1; }
+  ;;
+apply)
+  test "$TF_VAR_FOO" = "bar" || { echo "Error: TF_VAR_FOO is not set"; exit 1; }
+  cat > terraform.tfstate <<STATE
+{"version": 4, "outputs": {"foo": {"value": "$TF_VAR_FOO", "type": "string"}}, "resources": []}
+STATE
+  echo "Apply complete! Resources: 1 added, 0 changed, 0 destroyed."
+  ;;
+plan)
+  grep -q '"foo"' terraform.tfstate 2>/dev/null && exit 0
+  echo "Terraform will perform the following actions:"
+  echo "  # null_resource.foo will be created"
+  echo "Plan: 1 to add, 0 to change, 0 to destroy."
+  exit 2
+  ;;
+destroy)
+  echo '{"version": 4, "outputs": {}, "resources": []}' > terraform.tfstate
+  ;;
+esac
+`
+
+var _ = Describe("Local", func() {
+	var (
+		ctx = context.TODO()
+
+		dir     string
+		c       client.Client
+		factory Factory
+		tf      Interface
+
+		namespace = "shoot--foo--bar"
+		stateKey  = kutil.Key(namespace, "bar.infra.tf-state")
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "terraform")
+		Expect(err).NotTo(HaveOccurred())
+
+		binary := filepath.Join(dir, "terraform")
+		Expect(ioutil.WriteFile(binary, []byte(fakeTerraform), 0700)).To(Succeed())
+
+		c = fake.NewFakeClient()
+		factory = NewLocalFactory(LocalOptions{Binary: binary, WorkDir: dir})
+		tf = factory.
+			New(logger.NewLogger("info"), c, nil, "infra", namespace, "bar", "").
+			SetVariablesEnvironment(map[string]string{"TF_VAR_FOO": "bar"})
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	initializer := func() Initializer {
+		return factory.DefaultInitializer(c, "main", "variables", []byte("tfvars"))
+	}
+
+	It("should fail to apply without configuration", func() {
+		Expect(tf.Apply()).NotTo(Succeed())
+	})
+
+	It("should apply the configuration and store the state", func() {
+		Expect(tf.InitializeWith(initializer()).Apply()).To(Succeed())
+
+		Expect(tf.ConfigExists()).To(BeTrue())
+		Expect(tf.GetStateOutputVariables("foo")).To(Equal(map[string]string{"foo": "bar"}))
+		_, err := tf.GetStateOutputVariables("foo", "baz")
+		Expect(IsVariablesNotFoundError(err)).To(BeTrue())
+
+		state := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, stateKey, state)).To(Succeed())
+		Expect(state.Data[gardenerterraformer.StateKey]).To(ContainSubstring(`"foo"`))
+	})
+
+	It("should return the errors of Terraform", func() {
+		err := tf.
+			SetVariablesEnvironment(map[string]string{}).
+			InitializeWith(initializer()).
+			Apply()
+
+		Expect(err).To(MatchError(ContainSubstring("Error: TF_VAR_FOO is not set")))
+	})
+
+	It("should plan the configuration", func() {
+		plan, err := tf.InitializeWith(initializer()).Plan()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Changes).To(Equal([]ResourceChange{
+			{Address: "null_resource.foo", Type: "null_resource", Action: ResourceChangeActionCreate},
+		}))
+
+		Expect(tf.InitializeWith(initializer()).Apply()).To(Succeed())
+
+		plan, err = tf.InitializeWith(initializer()).Plan()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.HasChanges()).To(BeFalse())
+	})
+
+	It("should destroy the infrastructure and clean up the configuration", func() {
+		Expect(tf.InitializeWith(initializer()).Apply()).To(Succeed())
+
+		Expect(tf.Destroy()).To(Succeed())
+
+		Expect(tf.ConfigExists()).To(BeFalse())
+		Expect(apierrors.IsNotFound(c.Get(ctx, stateKey, &corev1.ConfigMap{}))).To(BeTrue())
+	})
+
+	It("should do nothing when destroying without configuration", func() {
+		Expect(tf.Destroy()).To(Succeed())
+	})
+})
@@ -0,0 +1,374 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceChangeAction is the action Terraform would take for a resource.
+type ResourceChangeAction string
+
+const (
+	// ResourceChangeActionCreate means that the resource would be created.
+	ResourceChangeActionCreate ResourceChangeAction = "create"
+	// ResourceChangeActionUpdate means that the resource would be updated in-place.
+	ResourceChangeActionUpdate ResourceChangeAction = "update"
+	// ResourceChangeActionDelete means that the resource would be destroyed.
+	ResourceChangeActionDelete ResourceChangeAction = "delete"
+	// ResourceChangeActionReplace means that the resource would be destroyed and created again.
+	ResourceChangeActionReplace ResourceChangeAction = "replace"
+)
+
+// ResourceChange is a single change of a Terraform plan.
+type ResourceChange struct {
+	// Address is the address of the resource, e.g. 'aws_subnet.nodes_z0' or 'module.vpc.aws_vpc.vpc[0]'.
+	Address string `json:"address"`
+	// Type is the type of the resource, e.g. 'aws_subnet'.
+	Type string `json:"type"`
+	// Action is the action Terraform would take for the resource.
+	Action ResourceChangeAction `json:"action"`
+}
+
+// Plan is the machine-readable result of a Terraform plan.
+type Plan struct {
+	// Changes are the resource changes Terraform would apply.
+	Changes []ResourceChange `json:"changes,omitempty"`
+}
+
+// HasChanges returns true if the plan contains at least one resource change.
+func (p *Plan) HasChanges() bool {
+	return p != nil && len(p.Changes) > 0
+}
+
+const (
+	terraformerName = "terraformer"
+	rbacName        = "gardener.cloud:system:terraformer"
+
+	planPodSuffix = ".tf-plan"
+
+	// planExitCodeNoChanges is the exit code of 'terraform plan -detailed-exitcode' if there are no changes.
+	planExitCodeNoChanges = 0
+	// planExitCodeChanges is the exit code of 'terraform plan -detailed-exitcode' if there are changes.
+	planExitCodeChanges = 2
+)
+
+var (
+	ansiEscapeRegexp = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+	// planChangeRegexp matches resource changes printed by Terraform >= 0.12, e.g.
+	// '  # aws_vpc.vpc will be created'.
+	planChangeRegexp = regexp.MustCompile(`^\s*# (\S+\.\S+) (?:is tainted, so )?(will be created|will be updated in-place|will be destroyed|must be replaced)`)
+	// legacyPlanChangeRegexp matches resource changes printed by Terraform < 0.12, e.g.
+	// '  + aws_vpc.vpc' or '-/+ aws_subnet.nodes (new resource required)'.
+	legacyPlanChangeRegexp = regexp.MustCompile(`^\s*(-/\+|\+/-|\+|~|-) ([\w-]+(?:\[\d+\])?(?:\.[\w-]+(?:\[\d+\])?)+)(?: \(new resource required\))?\s*$`)
+
+	planActions = map[string]ResourceChangeAction{
+		"will be created":          ResourceChangeActionCreate,
+		"will be updated in-place": ResourceChangeActionUpdate,
+		"will be destroyed":        ResourceChangeActionDelete,
+		"must be replaced":         ResourceChangeActionReplace,
+		"+":                        ResourceChangeActionCreate,
+		"~":                        ResourceChangeActionUpdate,
+		"-":                        ResourceChangeActionDelete,
+		"-/+":                      ResourceChangeActionReplace,
+		"+/-":                      ResourceChangeActionReplace,
+	}
+)
+
+// ParsePlanOutput parses the human readable output of 'terraform plan' and returns the contained resource changes.
+// Only the section following 'Terraform will perform the following actions:' is considered so that the legend
+// of the action symbols is not mistaken for changes. Data sources that would be read are ignored.
+func ParsePlanOutput(output string) *Plan {
+	var (
+		plan      = &Plan{}
+		inActions bool
+		scanner   = bufio.NewScanner(strings.NewReader(ansiEscapeRegexp.ReplaceAllString(output, "")))
+	)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(trimmed, "Terraform will perform the following actions:"):
+			inActions = true
+			continue
+		case strings.HasPrefix(trimmed, "Plan:"):
+			inActions = false
+			continue
+		case !inActions:
+			continue
+		}
+
+		var address, action string
+		if match := planChangeRegexp.FindStringSubmatch(line); match != nil {
+			address, action = match[1], match[2]
+		} else if match := legacyPlanChangeRegexp.FindStringSubmatch(line); match != nil {
+			action, address = match[1], match[2]
+		} else {
+			continue
+		}
+
+		if strings.HasPrefix(address, "data.") || strings.Contains(address, ".data.") {
+			continue
+		}
+
+		plan.Changes = append(plan.Changes, ResourceChange{
+			Address: address,
+			Type:    resourceType(address),
+			Action:  planActions[action],
+		})
+	}
+
+	return plan
+}
+
+// resourceType returns the resource type of the given resource address.
+func resourceType(address string) string {
+	if idx := strings.Index(address, "["); idx >= 0 && strings.HasSuffix(address, "]") {
+		address = address[:idx]
+	}
+
+	parts := strings.Split(address, ".")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[len(parts)-2]
+}
+
+// Plan implements Terraformer.
+func (t *terraformer) Plan() (*Plan, error) {
+	ctx := context.TODO()
+
+	if !t.configurationDefined {
+		return nil, errors.New("Terraformer configuration has not been defined, cannot plan the Terraform scripts")
+	}
+	if t.variablesEnvironment == nil {
+		return nil, errors.New("no Terraform variables environment provided")
+	}
+
+	if err := t.createOrUpdateAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	pod := t.planPod()
+	if err := t.client.Create(ctx, pod); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := t.client.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+			t.logger.Errorf("Could not delete Terraform plan Pod '%s': %s", pod.Name, err.Error())
+		}
+	}()
+
+	exitCode, err := t.waitForPlanPod(ctx, pod.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := kubernetes.GetPodLogs(t.coreV1Client.Pods(t.namespace), pod.Name, &corev1.PodLogOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the logs of Terraform plan Pod '%s': %+v", pod.Name, err)
+	}
+
+	switch exitCode {
+	case planExitCodeNoChanges:
+		return &Plan{}, nil
+	case planExitCodeChanges:
+		return ParsePlanOutput(string(logs)), nil
+	default:
+		return nil, fmt.Errorf("Terraform plan Pod '%s' failed with exit code %d:\n%s", pod.Name, exitCode, string(logs))
+	}
+}
+
+// waitForPlanPod waits until the Terraform plan Pod with the given name has terminated and returns the exit code
+// of its container.
+func (t *terraformer) waitForPlanPod(ctx context.Context, name string) (int32, error) {
+	var exitCode int32
+
+	ctx, cancel := context.WithTimeout(ctx, t.deadlinePod)
+	defer cancel()
+
+	if err := retry.Until(ctx, 5*time.Second, func(ctx context.Context) (done bool, err error) {
+		t.logger.Infof("Waiting for Terraform plan Pod '%s' to be completed...", name)
+		pod := &corev1.Pod{}
+		if err := t.client.Get(ctx, kutil.Key(t.namespace, name), pod); err != nil {
+			return retry.SevereError(err)
+		}
+
+		if (pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed) && len(pod.Status.ContainerStatuses) > 0 {
+			if terminated := pod.Status.ContainerStatuses[0].State.Terminated; terminated != nil {
+				exitCode = terminated.ExitCode
+				return retry.Ok()
+			}
+		}
+
+		return retry.MinorError(fmt.Errorf("plan was not completed (phase=%s)", pod.Status.Phase))
+	}); err != nil {
+		return 0, fmt.Errorf("Terraform plan Pod '%s' did not complete: %+v", name, err)
+	}
+
+	return exitCode, nil
+}
+
+func (t *terraformer) createOrUpdateAuth(ctx context.Context) error {
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: terraformerName}}
+	if err := kutil.CreateOrUpdate(ctx, t.client, serviceAccount, func() error {
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	role := &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: rbacName}}
+	if err := kutil.CreateOrUpdate(ctx, t.client, role, func() error {
+		role.Rules = []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"configmaps"},
+				Verbs:     []string{"*"},
+			},
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	roleBinding := &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: rbacName}}
+	return kutil.CreateOrUpdate(ctx, t.client, roleBinding, func() error {
+		roleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     rbacName,
+		}
+		roleBinding.Subjects = []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      terraformerName,
+				Namespace: t.namespace,
+			},
+		}
+		return nil
+	})
+}
+
+// planPod returns a Pod that runs 'terraform plan' for the configuration, variables and state of the Terraformer.
+// The Terraform state is only read, the Pod never writes it back.
+func (t *terraformer) planPod() *corev1.Pod {
+	const (
+		tfVolume      = "tf"
+		tfVarsVolume  = "tfvars"
+		tfStateVolume = "tfstate"
+	)
+
+	var (
+		prefix                = fmt.Sprintf("%s.%s", t.name, t.purpose)
+		activeDeadlineSeconds = t.activeDeadlineSeconds
+		env                   = []corev1.EnvVar{
+			{Name: "MAX_BACKOFF_SEC", Value: "60"},
+			{Name: "MAX_TIME_SEC", Value: "1800"},
+			{Name: "TF_STATE_CONFIG_MAP_NAME", Value: prefix + common.TerraformerStateSuffix},
+		}
+	)
+
+	for k, v := range t.variablesEnvironment {
+		env = append(env, corev1.EnvVar{Name: k, Value: v})
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: t.namespace,
+			Name:      fmt.Sprintf("%s-%s", prefix+planPodSuffix, utils.ComputeSHA256Hex([]byte(time.Now().String()))[:5]),
+			Labels: map[string]string{
+				v1alpha1constants.LabelNetworkPolicyToDNS:             v1alpha1constants.LabelNetworkPolicyAllowed,
+				v1alpha1constants.LabelNetworkPolicyToPrivateNetworks: v1alpha1constants.LabelNetworkPolicyAllowed,
+				v1alpha1constants.LabelNetworkPolicyToPublicNetworks:  v1alpha1constants.LabelNetworkPolicyAllowed,
+				v1alpha1constants.LabelNetworkPolicyToSeedAPIServer:   v1alpha1constants.LabelNetworkPolicyAllowed,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: &activeDeadlineSeconds,
+			Containers: []corev1.Container{
+				{
+					Name:            "terraform",
+					Image:           t.image,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Command:         []string{"sh", "-c", "sh /terraform.sh validate"},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("50m"),
+							corev1.ResourceMemory: resource.MustParse("200Mi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("200m"),
+							corev1.ResourceMemory: resource.MustParse("512Mi"),
+						},
+					},
+					Env: env,
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: tfVolume, MountPath: "/tf"},
+						{Name: tfVarsVolume, MountPath: "/tfvars"},
+						{Name: tfStateVolume, MountPath: "/tf-state-in"},
+					},
+				},
+			},
+			ServiceAccountName: terraformerName,
+			Volumes: []corev1.Volume{
+				{
+					Name: tfVolume,
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: prefix + common.TerraformerConfigSuffix},
+						},
+					},
+				},
+				{
+					Name: tfVarsVolume,
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{SecretName: prefix + common.TerraformerVariablesSuffix},
+					},
+				},
+				{
+					Name: tfStateVolume,
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: prefix + common.TerraformerStateSuffix},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	Describe("#ParsePlanOutput", func() {
+		It("should parse the output of Terraform >= 0.12", func() {
+			output := `Refreshing Terraform state in-memory prior to plan...
+
+An execution plan has been generated and is shown below.
+Resource actions are indicated with the following symbols:
+  + create
+  ~ update in-place
+  - destroy
+-/+ destroy and then create replacement
+
+Terraform will perform the following actions:
+
+  # aws_security_group.nodes will be updated in-place
+  ~ resource "aws_security_group" "nodes" {
+      ~ tags = {
+          - "kubernetes.io/cluster/shoot--foo--bar" = "1" -> null
+        }
+    }
+
+  # aws_subnet.nodes_z0 must be replaced
+-/+ resource "aws_subnet" "nodes_z0" {
+      ~ cidr_block = "10.250.0.0/19" -> "10.250.0.0/20" # forces replacement
+    }
+
+  # aws_vpc.vpc[0] will be created
+  + resource "aws_vpc" "vpc" {
+      + cidr_block = "10.250.0.0/16"
+    }
+
+  # module.nat.aws_eip.eip_natgw_z0 will be destroyed
+  - resource "aws_eip" "eip_natgw_z0" {}
+
+  # data.aws_vpc.default will be read during apply
+ <= data "aws_vpc" "default" {}
+
+Plan: 2 to add, 1 to change, 2 to destroy.`
+
+			Expect(ParsePlanOutput(output)).To(Equal(&Plan{Changes: []ResourceChange{
+				{Address: "aws_security_group.nodes", Type: "aws_security_group", Action: ResourceChangeActionUpdate},
+				{Address: "aws_subnet.nodes_z0", Type: "aws_subnet", Action: ResourceChangeActionReplace},
+				{Address: "aws_vpc.vpc[0]", Type: "aws_vpc", Action: ResourceChangeActionCreate},
+				{Address: "module.nat.aws_eip.eip_natgw_z0", Type: "aws_eip", Action: ResourceChangeActionDelete},
+			}}))
+		})
+
+		It("should parse the colored output of Terraform < 0.12", func() {
+			output := "Terraform will perform the following actions:\n\n" +
+				"\x1b[33m  ~ aws_security_group.nodes\n\x1b[0m      tags.%:   \"1\" => \"0\"\n\n" +
+				"\x1b[32m  + aws_vpc.vpc\n\x1b[0m      id: <computed>\n\n" +
+				"\x1b[31m-/+ aws_subnet.nodes_z0 (new resource required)\n\x1b[0m\n" +
+				"\x1b[31m  - aws_eip.eip_natgw_z0\n\x1b[0m\n" +
+				"\x1b[36m <= data.aws_vpc.default\n\x1b[0m\n\n" +
+				"Plan: 2 to add, 1 to change, 2 to destroy.\n"
+
+			Expect(ParsePlanOutput(output)).To(Equal(&Plan{Changes: []ResourceChange{
+				{Address: "aws_security_group.nodes", Type: "aws_security_group", Action: ResourceChangeActionUpdate},
+				{Address: "aws_vpc.vpc", Type: "aws_vpc", Action: ResourceChangeActionCreate},
+				{Address: "aws_subnet.nodes_z0", Type: "aws_subnet", Action: ResourceChangeActionReplace},
+				{Address: "aws_eip.eip_natgw_z0", Type: "aws_eip", Action: ResourceChangeActionDelete},
+			}}))
+		})
+
+		It("should return an empty plan if there are no changes", func() {
+			plan := ParsePlanOutput("No changes. Infrastructure is up-to-date.\n")
+
+			Expect(plan.HasChanges()).To(BeFalse())
+		})
+	})
+})
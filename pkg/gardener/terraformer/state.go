@@ -0,0 +1,551 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ResourceMode is the mode of a Terraform resource.
+type ResourceMode string
+
+const (
+	// ResourceModeManaged is the mode of resources that are managed by Terraform.
+	ResourceModeManaged ResourceMode = "managed"
+	// ResourceModeData is the mode of data sources.
+	ResourceModeData ResourceMode = "data"
+
+	rootModule = "root"
+)
+
+// StateGetter returns the raw Terraform state, e.g. a Terraformer.
+type StateGetter interface {
+	GetState() ([]byte, error)
+}
+
+// State is the typed content of a Terraform state.
+type State struct {
+	// Outputs are the output variables of the root module.
+	Outputs map[string]Output
+	// Resources are the resource instances of all modules.
+	Resources []Resource
+}
+
+// Output is a Terraform output variable.
+type Output struct {
+	// Value is the value of the output variable. It is a string, a []interface{} or a map[string]interface{}.
+	Value interface{}
+	// Sensitive specifies whether the output variable is sensitive.
+	Sensitive bool
+}
+
+// Resource is an instance of a Terraform resource.
+type Resource struct {
+	// Module is the address of the module of the resource, e.g. 'module.vpc'. It is empty for the root module.
+	Module string
+	// Mode is the mode of the resource.
+	Mode ResourceMode
+	// Type is the type of the resource, e.g. 'aws_subnet'.
+	Type string
+	// Name is the name of the resource, e.g. 'nodes_z0'.
+	Name string
+	// Index is the index of the resource instance if the resource uses 'count' or 'for_each'.
+	Index string
+	// ID is the id of the resource.
+	ID string
+	// Attributes are the attributes of the resource. Nested blocks, lists and maps are represented as
+	// []interface{} and map[string]interface{}.
+	Attributes map[string]interface{}
+}
+
+// ReadState reads the Terraform state of the given StateGetter and parses it.
+func ReadState(getter StateGetter) (*State, error) {
+	data, err := getter.GetState()
+	if err != nil {
+		return nil, err
+	}
+	return ParseState(data)
+}
+
+// ParseState parses the given Terraform state. Both the state format of Terraform < 0.12 (version 3) and the
+// format of Terraform >= 0.12 (version 4) are supported. An empty state results in an empty State.
+func ParseState(data []byte) (*State, error) {
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return &State{Outputs: map[string]Output{}}, nil
+	}
+
+	var version struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &version); err != nil {
+		return nil, fmt.Errorf("could not parse Terraform state: %+v", err)
+	}
+
+	switch version.Version {
+	case 3:
+		return parseStateV3(data)
+	case 4:
+		return parseStateV4(data)
+	default:
+		return nil, fmt.Errorf("unsupported Terraform state version %d", version.Version)
+	}
+}
+
+type stateV3 struct {
+	Modules []struct {
+		Path    []string `json:"path"`
+		Outputs map[string]struct {
+			Sensitive bool        `json:"sensitive"`
+			Value     interface{} `json:"value"`
+		} `json:"outputs"`
+		Resources map[string]struct {
+			Type    string `json:"type"`
+			Primary struct {
+				ID         string            `json:"id"`
+				Attributes map[string]string `json:"attributes"`
+			} `json:"primary"`
+		} `json:"resources"`
+	} `json:"modules"`
+}
+
+func parseStateV3(data []byte) (*State, error) {
+	raw := &stateV3{}
+	if err := json.Unmarshal(data, raw); err != nil {
+		return nil, fmt.Errorf("could not parse Terraform state: %+v", err)
+	}
+
+	state := &State{Outputs: map[string]Output{}}
+	for _, module := range raw.Modules {
+		var modulePath []string
+		for _, name := range module.Path {
+			if name != rootModule {
+				modulePath = append(modulePath, "module."+name)
+			}
+		}
+		moduleAddress := strings.Join(modulePath, ".")
+
+		if len(modulePath) == 0 {
+			for name, output := range module.Outputs {
+				state.Outputs[name] = Output{Value: output.Value, Sensitive: output.Sensitive}
+			}
+		}
+
+		for key, resource := range module.Resources {
+			mode := ResourceModeManaged
+			if strings.HasPrefix(key, "data.") {
+				mode = ResourceModeData
+				key = strings.TrimPrefix(key, "data.")
+			}
+
+			parts := strings.Split(key, ".")
+			if len(parts) < 2 {
+				return nil, fmt.Errorf("invalid resource key %q in Terraform state", key)
+			}
+
+			var index string
+			if len(parts) > 2 {
+				index = parts[2]
+			}
+
+			state.Resources = append(state.Resources, Resource{
+				Module:     moduleAddress,
+				Mode:       mode,
+				Type:       resource.Type,
+				Name:       parts[1],
+				Index:      index,
+				ID:         resource.Primary.ID,
+				Attributes: unflattenAttributes(resource.Primary.Attributes),
+			})
+		}
+	}
+
+	sortResources(state.Resources)
+	return state, nil
+}
+
+type stateV4 struct {
+	Outputs map[string]struct {
+		Sensitive bool        `json:"sensitive"`
+		Value     interface{} `json:"value"`
+	} `json:"outputs"`
+	Resources []struct {
+		Module    string       `json:"module"`
+		Mode      ResourceMode `json:"mode"`
+		Type      string       `json:"type"`
+		Name      string       `json:"name"`
+		Instances []struct {
+			IndexKey   interface{}            `json:"index_key"`
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+func parseStateV4(data []byte) (*State, error) {
+	raw := &stateV4{}
+	if err := json.Unmarshal(data, raw); err != nil {
+		return nil, fmt.Errorf("could not parse Terraform state: %+v", err)
+	}
+
+	state := &State{Outputs: map[string]Output{}}
+	for name, output := range raw.Outputs {
+		state.Outputs[name] = Output{Value: output.Value, Sensitive: output.Sensitive}
+	}
+
+	for _, resource := range raw.Resources {
+		for _, instance := range resource.Instances {
+			var index string
+			if instance.IndexKey != nil {
+				index = stringValue(instance.IndexKey)
+			}
+
+			var id string
+			if v, ok := instance.Attributes["id"]; ok && v != nil {
+				id = stringValue(v)
+			}
+
+			state.Resources = append(state.Resources, Resource{
+				Module:     resource.Module,
+				Mode:       resource.Mode,
+				Type:       resource.Type,
+				Name:       resource.Name,
+				Index:      index,
+				ID:         id,
+				Attributes: instance.Attributes,
+			})
+		}
+	}
+
+	sortResources(state.Resources)
+	return state, nil
+}
+
+// unflattenAttributes converts the flat attributes of a Terraform state of version 3 into nested attributes.
+// Lists and sets are marked with a '<name>.#' key holding their length, maps with a '<name>.%' key.
+func unflattenAttributes(flat map[string]string) map[string]interface{} {
+	var (
+		lists = map[string]bool{}
+		maps  = map[string]bool{}
+		keys  = make([]string, 0, len(flat))
+	)
+
+	for key := range flat {
+		switch {
+		case strings.HasSuffix(key, ".#"):
+			lists[strings.TrimSuffix(key, ".#")] = true
+		case strings.HasSuffix(key, ".%"):
+			maps[strings.TrimSuffix(key, ".%")] = true
+		default:
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	root := map[string]interface{}{}
+	for prefix := range lists {
+		ensureNode(root, splitAttributeKey(prefix, maps))
+	}
+	for prefix := range maps {
+		ensureNode(root, splitAttributeKey(prefix, maps))
+	}
+	for _, key := range keys {
+		path := splitAttributeKey(key, maps)
+		parent := ensureNode(root, path[:len(path)-1])
+		parent[path[len(path)-1]] = flat[key]
+	}
+
+	return convertLists(root, "", lists).(map[string]interface{})
+}
+
+// splitAttributeKey splits the given flat attribute key into its path. Keys of maps may contain dots, hence
+// everything following a map prefix is treated as a single path element.
+func splitAttributeKey(key string, maps map[string]bool) []string {
+	parts := strings.Split(key, ".")
+	for i := 1; i < len(parts); i++ {
+		if maps[strings.Join(parts[:i], ".")] {
+			return append(parts[:i:i], strings.Join(parts[i:], "."))
+		}
+	}
+	return parts
+}
+
+func ensureNode(root map[string]interface{}, path []string) map[string]interface{} {
+	node := root
+	for _, name := range path {
+		child, ok := node[name].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			node[name] = child
+		}
+		node = child
+	}
+	return node
+}
+
+// convertLists converts the nodes at the given list paths into slices ordered by their index.
+func convertLists(node interface{}, path string, lists map[string]bool) interface{} {
+	m, ok := node.(map[string]interface{})
+	if !ok {
+		return node
+	}
+
+	for key, value := range m {
+		childPath := key
+		if path != "" {
+			childPath = path + "." + key
+		}
+		m[key] = convertLists(value, childPath, lists)
+	}
+
+	if !lists[path] {
+		return m
+	}
+
+	indices := make([]string, 0, len(m))
+	for index := range m {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		a, errA := strconv.Atoi(indices[i])
+		b, errB := strconv.Atoi(indices[j])
+		if errA == nil && errB == nil {
+			return a < b
+		}
+		return indices[i] < indices[j]
+	})
+
+	list := make([]interface{}, 0, len(indices))
+	for _, index := range indices {
+		list = append(list, m[index])
+	}
+	return list
+}
+
+func sortResources(resources []Resource) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		a, b := resources[i], resources[j]
+		if a.Module != b.Module {
+			return a.Module < b.Module
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Index < b.Index
+	})
+}
+
+// Resource returns the managed resource of the root module with the given type and name. If the resource has
+// multiple instances, the first one is returned. It returns nil if there is no such resource.
+func (s *State) Resource(resourceType, name string) *Resource {
+	for i, resource := range s.Resources {
+		if resource.Module == "" && resource.Mode == ResourceModeManaged && resource.Type == resourceType && resource.Name == name {
+			return &s.Resources[i]
+		}
+	}
+	return nil
+}
+
+// ResourcesByType returns all managed resources of the root module with the given type.
+func (s *State) ResourcesByType(resourceType string) []Resource {
+	var out []Resource
+	for _, resource := range s.Resources {
+		if resource.Module == "" && resource.Mode == ResourceModeManaged && resource.Type == resourceType {
+			out = append(out, resource)
+		}
+	}
+	return out
+}
+
+// OutputString returns the value of the output variable with the given name as string.
+func (s *State) OutputString(name string) (string, error) {
+	output, ok := s.Outputs[name]
+	if !ok {
+		return "", fmt.Errorf("output variable %q not found in Terraform state", name)
+	}
+	return toString(output.Value, "output variable "+name)
+}
+
+// GetStateOutputVariables returns the values of the output variables with the given names as strings. It returns an
+// error for which IsVariablesNotFoundError is true if not all output variables exist.
+func (s *State) GetStateOutputVariables(names ...string) (map[string]string, error) {
+	var (
+		output  = make(map[string]string, len(names))
+		missing []string
+	)
+	for _, name := range names {
+		value, err := s.OutputString(name)
+		if err != nil {
+			missing = append(missing, name)
+			continue
+		}
+		output[name] = value
+	}
+
+	if len(missing) > 0 {
+		return nil, &variablesNotFoundError{missing}
+	}
+	return output, nil
+}
+
+// OutputStringList returns the value of the output variable with the given name as list of strings.
+func (s *State) OutputStringList(name string) ([]string, error) {
+	output, ok := s.Outputs[name]
+	if !ok {
+		return nil, fmt.Errorf("output variable %q not found in Terraform state", name)
+	}
+	return toStringList(output.Value, "output variable "+name)
+}
+
+// OutputStringMap returns the value of the output variable with the given name as map of strings.
+func (s *State) OutputStringMap(name string) (map[string]string, error) {
+	output, ok := s.Outputs[name]
+	if !ok {
+		return nil, fmt.Errorf("output variable %q not found in Terraform state", name)
+	}
+	return toStringMap(output.Value, "output variable "+name)
+}
+
+// Attribute returns the attribute at the given path. Path elements address keys of maps and nested blocks as
+// well as indices of lists, e.g. Attribute("ingress", "0", "from_port").
+func (r *Resource) Attribute(path ...string) (interface{}, bool) {
+	var node interface{} = r.Attributes
+	for _, name := range path {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			child, ok := n[name]
+			if !ok {
+				return nil, false
+			}
+			node = child
+		case []interface{}:
+			index, err := strconv.Atoi(name)
+			if err != nil || index < 0 || index >= len(n) {
+				return nil, false
+			}
+			node = n[index]
+		default:
+			return nil, false
+		}
+	}
+	return node, true
+}
+
+// StringAttribute returns the attribute at the given path as string.
+func (r *Resource) StringAttribute(path ...string) (string, error) {
+	value, ok := r.Attribute(path...)
+	if !ok {
+		return "", r.attributeNotFoundError(path)
+	}
+	return toString(value, r.attributeDescription(path))
+}
+
+// StringListAttribute returns the attribute at the given path as list of strings.
+func (r *Resource) StringListAttribute(path ...string) ([]string, error) {
+	value, ok := r.Attribute(path...)
+	if !ok {
+		return nil, r.attributeNotFoundError(path)
+	}
+	return toStringList(value, r.attributeDescription(path))
+}
+
+// StringMapAttribute returns the attribute at the given path as map of strings.
+func (r *Resource) StringMapAttribute(path ...string) (map[string]string, error) {
+	value, ok := r.Attribute(path...)
+	if !ok {
+		return nil, r.attributeNotFoundError(path)
+	}
+	return toStringMap(value, r.attributeDescription(path))
+}
+
+// Address returns the address of the resource, e.g. 'module.vpc.aws_subnet.nodes[0]'.
+func (r *Resource) Address() string {
+	address := r.Type + "." + r.Name
+	if r.Mode == ResourceModeData {
+		address = "data." + address
+	}
+	if r.Module != "" {
+		address = r.Module + "." + address
+	}
+	if r.Index != "" {
+		address += "[" + r.Index + "]"
+	}
+	return address
+}
+
+func (r *Resource) attributeDescription(path []string) string {
+	return fmt.Sprintf("attribute %q of resource %s", strings.Join(path, "."), r.Address())
+}
+
+func (r *Resource) attributeNotFoundError(path []string) error {
+	return fmt.Errorf("%s not found in Terraform state", r.attributeDescription(path))
+}
+
+func stringValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func toString(value interface{}, description string) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool, float64:
+		return stringValue(v), nil
+	default:
+		return "", fmt.Errorf("%s is not a string but %T", description, value)
+	}
+}
+
+func toStringList(value interface{}, description string) ([]string, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s is not a list but %T", description, value)
+	}
+
+	out := make([]string, 0, len(list))
+	for i, item := range list {
+		s, err := toString(item, fmt.Sprintf("element %d of %s", i, description))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+func toStringMap(value interface{}, description string) (map[string]string, error) {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s is not a map but %T", description, value)
+	}
+
+	out := make(map[string]string, len(m))
+	for key, item := range m {
+		s, err := toString(item, fmt.Sprintf("key %q of %s", key, description))
+		if err != nil {
+			return nil, err
+		}
+		out[key] = s
+	}
+	return out, nil
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("State", func() {
+	Describe("#ParseState", func() {
+		It("should return an empty state for empty data", func() {
+			state, err := ParseState(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.Outputs).To(BeEmpty())
+			Expect(state.Resources).To(BeEmpty())
+		})
+
+		It("should fail for unsupported versions", func() {
+			_, err := ParseState([]byte(`{"version": 2}`))
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should parse the state of Terraform < 0.12", func() {
+			state, err := ParseState([]byte(`{
+  "version": 3,
+  "terraform_version": "0.11.14",
+  "modules": [
+    {
+      "path": ["root"],
+      "outputs": {
+        "vpc_id": {"sensitive": false, "type": "string", "value": "vpc-1234"},
+        "zones": {"sensitive": false, "type": "list", "value": ["eu-west-1a", "eu-west-1b"]}
+      },
+      "resources": {
+        "aws_subnet.nodes_z0": {
+          "type": "aws_subnet",
+          "primary": {
+            "id": "subnet-0",
+            "attributes": {
+              "id": "subnet-0",
+              "availability_zone": "eu-west-1a",
+              "tags.%": "2",
+              "tags.Name": "shoot--foo--bar-nodes-z0",
+              "tags.kubernetes.io/cluster/shoot--foo--bar": "1"
+            }
+          }
+        },
+        "aws_security_group.nodes": {
+          "type": "aws_security_group",
+          "primary": {
+            "id": "sg-1",
+            "attributes": {
+              "id": "sg-1",
+              "ingress.#": "2",
+              "ingress.2541437006.from_port": "30000",
+              "ingress.2541437006.cidr_blocks.#": "1",
+              "ingress.2541437006.cidr_blocks.0": "0.0.0.0/0",
+              "ingress.10.from_port": "0",
+              "ingress.10.cidr_blocks.#": "0"
+            }
+          }
+        },
+        "data.aws_vpc.default": {
+          "type": "aws_vpc",
+          "primary": {"id": "vpc-1234", "attributes": {"id": "vpc-1234"}}
+        }
+      }
+    },
+    {
+      "path": ["root", "nat"],
+      "outputs": {},
+      "resources": {
+        "aws_eip.eip.1": {
+          "type": "aws_eip",
+          "primary": {"id": "eip-1", "attributes": {"id": "eip-1"}}
+        }
+      }
+    }
+  ]
+}`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.OutputString("vpc_id")).To(Equal("vpc-1234"))
+			Expect(state.OutputStringList("zones")).To(Equal([]string{"eu-west-1a", "eu-west-1b"}))
+
+			subnet := state.Resource("aws_subnet", "nodes_z0")
+			Expect(subnet).NotTo(BeNil())
+			Expect(subnet.ID).To(Equal("subnet-0"))
+			Expect(subnet.StringAttribute("availability_zone")).To(Equal("eu-west-1a"))
+			Expect(subnet.StringMapAttribute("tags")).To(Equal(map[string]string{
+				"Name":                                  "shoot--foo--bar-nodes-z0",
+				"kubernetes.io/cluster/shoot--foo--bar": "1",
+			}))
+
+			securityGroup := state.Resource("aws_security_group", "nodes")
+			Expect(securityGroup).NotTo(BeNil())
+			Expect(securityGroup.StringAttribute("ingress", "0", "from_port")).To(Equal("0"))
+			Expect(securityGroup.StringListAttribute("ingress", "0", "cidr_blocks")).To(BeEmpty())
+			Expect(securityGroup.StringAttribute("ingress", "1", "from_port")).To(Equal("30000"))
+			Expect(securityGroup.StringListAttribute("ingress", "1", "cidr_blocks")).To(Equal([]string{"0.0.0.0/0"}))
+
+			Expect(state.Resource("aws_vpc", "default")).To(BeNil())
+			Expect(state.ResourcesByType("aws_eip")).To(BeEmpty())
+			Expect(state.Resources).To(HaveLen(4))
+			Expect(state.Resources[0].Address()).To(Equal("aws_security_group.nodes"))
+			Expect(state.Resources[1].Address()).To(Equal("aws_subnet.nodes_z0"))
+			Expect(state.Resources[2].Address()).To(Equal("data.aws_vpc.default"))
+			Expect(state.Resources[3].Address()).To(Equal("module.nat.aws_eip.eip[1]"))
+		})
+
+		It("should parse the state of Terraform >= 0.12", func() {
+			state, err := ParseState([]byte(`{
+  "version": 4,
+  "terraform_version": "0.12.20",
+  "outputs": {
+    "vpc_id": {"value": "vpc-1234", "type": "string"},
+    "tags": {"value": {"foo": "bar"}, "type": ["map", "string"], "sensitive": true}
+  },
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "aws_subnet",
+      "name": "nodes",
+      "each": "list",
+      "provider": "provider.aws",
+      "instances": [
+        {"index_key": 0, "attributes": {"id": "subnet-0", "availability_zone": "eu-west-1a", "map_public_ip_on_launch": false}},
+        {"index_key": 1, "attributes": {"id": "subnet-1", "availability_zone": "eu-west-1b", "map_public_ip_on_launch": false}}
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "aws_security_group",
+      "name": "nodes",
+      "provider": "provider.aws",
+      "instances": [
+        {"attributes": {"id": "sg-1", "ingress": [{"from_port": 30000, "cidr_blocks": ["0.0.0.0/0"]}], "tags": {"Name": "nodes"}}}
+      ]
+    },
+    {
+      "module": "module.nat",
+      "mode": "managed",
+      "type": "aws_eip",
+      "name": "eip",
+      "provider": "provider.aws",
+      "instances": [
+        {"attributes": {"id": "eip-1"}}
+      ]
+    }
+  ]
+}`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.OutputString("vpc_id")).To(Equal("vpc-1234"))
+			Expect(state.OutputStringMap("tags")).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(state.Outputs["tags"].Sensitive).To(BeTrue())
+			_, err = state.OutputString("tags")
+			Expect(err).To(HaveOccurred())
+			_, err = state.OutputString("unknown")
+			Expect(err).To(HaveOccurred())
+
+			subnets := state.ResourcesByType("aws_subnet")
+			Expect(subnets).To(HaveLen(2))
+			Expect(subnets[1].Index).To(Equal("1"))
+			Expect(subnets[1].ID).To(Equal("subnet-1"))
+			Expect(subnets[1].StringAttribute("availability_zone")).To(Equal("eu-west-1b"))
+			Expect(subnets[1].StringAttribute("map_public_ip_on_launch")).To(Equal("false"))
+			Expect(subnets[1].Address()).To(Equal("aws_subnet.nodes[1]"))
+
+			securityGroup := state.Resource("aws_security_group", "nodes")
+			Expect(securityGroup).NotTo(BeNil())
+			Expect(securityGroup.StringAttribute("ingress", "0", "from_port")).To(Equal("30000"))
+			Expect(securityGroup.StringListAttribute("ingress", "0", "cidr_blocks")).To(Equal([]string{"0.0.0.0/0"}))
+			Expect(securityGroup.StringMapAttribute("tags")).To(Equal(map[string]string{"Name": "nodes"}))
+			_, err = securityGroup.StringAttribute("ingress", "1", "from_port")
+			Expect(err).To(HaveOccurred())
+
+			Expect(state.Resource("aws_eip", "eip")).To(BeNil())
+		})
+	})
+	Describe("#GetStateOutputVariables", func() {
+		state := &State{Outputs: map[string]Output{
+			"vpc_id": {Value: "vpc-1234"},
+			"zones":  {Value: []interface{}{"eu-west-1a"}},
+		}}
+
+		It("should return the requested output variables", func() {
+			Expect(state.GetStateOutputVariables("vpc_id")).To(Equal(map[string]string{"vpc_id": "vpc-1234"}))
+		})
+
+		It("should fail if not all output variables exist", func() {
+			_, err := state.GetStateOutputVariables("vpc_id", "unknown")
+			Expect(IsVariablesNotFoundError(err)).To(BeTrue())
+		})
+	})
+})
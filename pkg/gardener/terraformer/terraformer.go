@@ -15,57 +15,95 @@
 package terraformer
 
 import (
+	"context"
 	"time"
 
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/controller/metrics"
+
 	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/sirupsen/logrus"
-	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type terraformer struct {
 	tf *gardenerterraformer.Terraformer
+
+	logger       logrus.FieldLogger
+	client       client.Client
+	coreV1Client corev1client.CoreV1Interface
+
+	purpose   string
+	namespace string
+	name      string
+	image     string
+
+	variablesEnvironment  map[string]string
+	configurationDefined  bool
+	activeDeadlineSeconds int64
+	deadlinePod           time.Duration
 }
 
 // SetVariablesEnvironment implements Terraformer.
 func (t *terraformer) SetVariablesEnvironment(tfVarsEnvironment map[string]string) Interface {
-	return &terraformer{t.tf.SetVariablesEnvironment(tfVarsEnvironment)}
+	out := *t
+	out.tf = t.tf.SetVariablesEnvironment(tfVarsEnvironment)
+	out.variablesEnvironment = tfVarsEnvironment
+	return &out
 }
 
 // SetJobBackoffLimit implements Terraformer.
 func (t *terraformer) SetJobBackoffLimit(val int32) Interface {
-	return &terraformer{t.tf.SetJobBackoffLimit(val)}
+	out := *t
+	out.tf = t.tf.SetJobBackoffLimit(val)
+	return &out
 }
 
 // SetActiveDeadlineSeconds implements Terraformer.
 func (t *terraformer) SetActiveDeadlineSeconds(val int64) Interface {
-	return &terraformer{t.tf.SetActiveDeadlineSeconds(val)}
+	out := *t
+	out.tf = t.tf.SetActiveDeadlineSeconds(val)
+	out.activeDeadlineSeconds = val
+	return &out
 }
 
 // SetDeadlineCleaning implements Terraformer.
 func (t *terraformer) SetDeadlineCleaning(val time.Duration) Interface {
-	return &terraformer{t.tf.SetDeadlineCleaning(val)}
+	out := *t
+	out.tf = t.tf.SetDeadlineCleaning(val)
+	return &out
 }
 
 // SetDeadlinePod implements Terraformer.
 func (t *terraformer) SetDeadlinePod(val time.Duration) Interface {
-	return &terraformer{t.tf.SetDeadlinePod(val)}
+	out := *t
+	out.tf = t.tf.SetDeadlinePod(val)
+	out.deadlinePod = val
+	return &out
 }
 
 // SetDeadlineJob implements Terraformer.
 func (t *terraformer) SetDeadlineJob(val time.Duration) Interface {
-	return &terraformer{t.tf.SetDeadlineJob(val)}
+	out := *t
+	out.tf = t.tf.SetDeadlineJob(val)
+	return &out
 }
 
 // InitializeWith implements Terraformer.
 func (t *terraformer) InitializeWith(initializer Initializer) Interface {
-	return &terraformer{t.tf.InitializeWith(initializer.Initialize)}
+	out := *t
+	out.tf = t.tf.InitializeWith(func(config *gardenerterraformer.InitializerConfig) error {
+		err := initializer.Initialize(config)
+		out.configurationDefined = err == nil
+		return err
+	})
+	return &out
 }
 
 // Apply implements Terraformer.
 func (t *terraformer) Apply() error {
-	return t.tf.Apply()
+	return extensionsmetrics.ObserveTerraformApply(t.purpose)(t.tf.Apply())
 }
 
 // Destroy implements Terraformer.
@@ -78,11 +116,21 @@ func (t *terraformer) GetStateOutputVariables(variables ...string) (map[string]s
 	return t.tf.GetStateOutputVariables(variables...)
 }
 
+// GetState implements Terraformer.
+func (t *terraformer) GetState() ([]byte, error) {
+	return t.tf.GetState()
+}
+
 // ConfigExists implements Terraformer.
 func (t *terraformer) ConfigExists() (bool, error) {
 	return t.tf.ConfigExists()
 }
 
+// CleanupConfiguration implements Terraformer.
+func (t *terraformer) CleanupConfiguration(ctx context.Context) error {
+	return t.tf.CleanupConfiguration(ctx)
+}
+
 type initializerFunc func(config *gardenerterraformer.InitializerConfig) error
 
 // Initialize implements Initializer.
@@ -90,21 +138,71 @@ func (f initializerFunc) Initialize(config *gardenerterraformer.InitializerConfi
 	return f(config)
 }
 
+// StateInitializer returns an Initializer that invokes the given Initializer and afterwards initializes an empty
+// Terraform state with the given state, e.g. a state that was persisted in the status of an extension resource.
+// The given Initializer is returned as is if the given state is empty.
+func StateInitializer(c client.Client, initializer gardenerterraformer.Initializer, state []byte) gardenerterraformer.Initializer {
+	if len(state) == 0 {
+		return initializer
+	}
+
+	return func(config *gardenerterraformer.InitializerConfig) error {
+		if err := initializer(config); err != nil {
+			return err
+		}
+
+		if !config.InitializeState {
+			return nil
+		}
+
+		_, err := gardenerterraformer.CreateOrUpdateStateConfigMap(context.TODO(), c, config.Namespace, config.StateName, string(state))
+		return err
+	}
+}
+
+// WithState returns an Initializer that invokes the given Initializer and afterwards initializes an empty
+// Terraform state with the given state, see StateInitializer.
+func WithState(c client.Client, initializer Initializer, state []byte) Initializer {
+	if len(state) == 0 {
+		return initializer
+	}
+	return initializerFunc(StateInitializer(c, initializer.Initialize, state))
+}
+
 type factory struct{}
 
 // NewForConfig implements Factory.
-func (factory) NewForConfig(logger logrus.FieldLogger, config *rest.Config, purpose, namespace, name, image string) (Interface, error) {
-	tf, err := gardenerterraformer.NewForConfig(logger, config, purpose, namespace, name, image)
+func (f factory) NewForConfig(logger logrus.FieldLogger, config *rest.Config, purpose, namespace, name, image string) (Interface, error) {
+	c, err := client.New(config, client.Options{})
 	if err != nil {
 		return nil, err
 	}
 
-	return &terraformer{tf}, nil
+	coreV1Client, err := corev1client.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.New(logger, c, coreV1Client, purpose, namespace, name, image), nil
 }
 
 // New implements Factory.
-func (factory) New(logger logrus.FieldLogger, client client.Client, coreV1Client v1.CoreV1Interface, purpose, namespace, name, image string) Interface {
-	return &terraformer{gardenerterraformer.New(logger, client, coreV1Client, purpose, namespace, name, image)}
+func (factory) New(logger logrus.FieldLogger, client client.Client, coreV1Client corev1client.CoreV1Interface, purpose, namespace, name, image string) Interface {
+	return &terraformer{
+		tf: gardenerterraformer.New(logger, client, coreV1Client, purpose, namespace, name, image),
+
+		logger:       logger,
+		client:       client,
+		coreV1Client: coreV1Client,
+
+		purpose:   purpose,
+		namespace: namespace,
+		name:      name,
+		image:     image,
+
+		activeDeadlineSeconds: 3600,
+		deadlinePod:           10 * time.Minute,
+	}
 }
 
 // DefaultInitializer implements Factory.
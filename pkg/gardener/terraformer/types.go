@@ -15,6 +15,7 @@
 package terraformer
 
 import (
+	"context"
 	"time"
 
 	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
@@ -36,8 +37,11 @@ type Interface interface {
 	InitializeWith(initializer Initializer) Interface
 	Apply() error
 	Destroy() error
+	Plan() (*Plan, error)
 	GetStateOutputVariables(variables ...string) (map[string]string, error)
+	GetState() ([]byte, error)
 	ConfigExists() (bool, error)
+	CleanupConfiguration(ctx context.Context) error
 }
 
 // Factory is a factory that can produce Interface and Initializer.
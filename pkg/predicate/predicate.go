@@ -202,10 +202,15 @@ func HasName(name string) predicate.Predicate {
 	}), CreateTrigger, UpdateNewTrigger, DeleteTrigger, GenericTrigger)
 }
 
-// HasOperationAnnotation is a predicate for the operation annotation.
+// HasOperationAnnotation is a predicate for the operation annotation. It matches the reconcile, migrate and
+// restore operations.
 func HasOperationAnnotation() predicate.Predicate {
 	return FromMapper(MapperFunc(func(e event.GenericEvent) bool {
-		return e.Meta.GetAnnotations()[v1alpha1constants.GardenerOperation] == v1alpha1constants.GardenerOperationReconcile
+		switch e.Meta.GetAnnotations()[v1alpha1constants.GardenerOperation] {
+		case v1alpha1constants.GardenerOperationReconcile, v1alpha1constants.GardenerOperationMigrate, controller.GardenerOperationRestore, controller.GardenerOperationDryRun:
+			return true
+		}
+		return false
 	}), CreateTrigger, UpdateNewTrigger, GenericTrigger)
 }
 
@@ -175,6 +175,28 @@ var _ = Describe("Predicate", func() {
 		Entry("no update", "machineFoo", "machineFoo", BeFalse()),
 		Entry("generation update", "machineFoo", "machineBar", BeTrue()),
 	)
+
+	DescribeTable("#HasOperationAnnotation",
+		func(annotations map[string]string, conditionMatcher types.GomegaMatcher) {
+			infrastructure := &v1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: annotations,
+				},
+			}
+
+			createEvent := event.CreateEvent{
+				Meta:   infrastructure,
+				Object: infrastructure,
+			}
+
+			Expect(predicate.HasOperationAnnotation().Create(createEvent)).To(conditionMatcher)
+		},
+		Entry("no annotation", nil, BeFalse()),
+		Entry("unknown operation", map[string]string{"gardener.cloud/operation": "foo"}, BeFalse()),
+		Entry("reconcile operation", map[string]string{"gardener.cloud/operation": "reconcile"}, BeTrue()),
+		Entry("migrate operation", map[string]string{"gardener.cloud/operation": "migrate"}, BeTrue()),
+		Entry("restore operation", map[string]string{"gardener.cloud/operation": "restore"}, BeTrue()),
+	)
 })
 
 func encode(obj runtime.Object) []byte {
@@ -122,6 +122,25 @@ func VersionMajorMinor(version string) (string, error) {
 	return fmt.Sprintf("%d.%d", v.Major(), v.Minor()), nil
 }
 
+// VersionMatchesConstraint returns true if the given version matches the given semantic version constraint like
+// `>= 1.16` or `< 1.15`. Pre-release and build metadata of the version are ignored, e.g. `v1.15.2-gke.1` is treated
+// as `1.15.2`.
+func VersionMatchesConstraint(version, constraint string) (bool, error) {
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false, errors.Wrapf(err, "Invalid version constraint '%s'", constraint)
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false, errors.Wrapf(err, "Invalid version string '%s'", version)
+	}
+	release, err := semver.NewVersion(fmt.Sprintf("%d.%d.%d", v.Major(), v.Minor(), v.Patch()))
+	if err != nil {
+		return false, err
+	}
+	return c.Check(release), nil
+}
+
 // VersionInfo converts the given version string to version.Info (input must be a semantic version).
 func VersionInfo(vs string) (*version.Info, error) {
 	v, err := semver.NewVersion(vs)
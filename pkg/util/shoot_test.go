@@ -209,6 +209,29 @@ var _ = Describe("Shoot", func() {
 		})
 	})
 
+	Describe("#VersionMatchesConstraint", func() {
+		It("should return an error due to an invalid version format", func() {
+			_, err := VersionMatchesConstraint("invalid-semver", ">= 1.16")
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error due to an invalid constraint", func() {
+			_, err := VersionMatchesConstraint("1.16.0", "invalid-constraint")
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return whether the given version matches the constraint", func() {
+			Expect(VersionMatchesConstraint("1.16.0", ">= 1.16")).To(BeTrue())
+			Expect(VersionMatchesConstraint("1.15.4", ">= 1.16")).To(BeFalse())
+		})
+
+		It("should ignore pre-release and build metadata of the given version", func() {
+			Expect(VersionMatchesConstraint("v1.16.2-gke.1", ">= 1.16")).To(BeTrue())
+		})
+	})
+
 	Describe("#VersionInfo", func() {
 		It("should return an error due to an invalid version format", func() {
 			v, err := VersionInfo("invalid-semver")
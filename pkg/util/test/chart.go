@@ -0,0 +1,57 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"strings"
+
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/helm/pkg/chartutil"
+	"k8s.io/helm/pkg/engine"
+)
+
+// RenderStorageClasses renders the chart at the given path with the given values for a cluster with the given
+// Kubernetes version and returns the rendered StorageClasses by their names.
+func RenderStorageClasses(chartPath, kubernetesVersion string, values map[string]interface{}) (map[string]*storagev1.StorageClass, error) {
+	renderer := chartrenderer.New(engine.New(), &chartutil.Capabilities{
+		KubeVersion: &version.Info{GitVersion: "v" + strings.TrimPrefix(kubernetesVersion, "v")},
+	})
+
+	release, err := renderer.Render(chartPath, "test", "kube-system", values)
+	if err != nil {
+		return nil, err
+	}
+
+	storageClasses := map[string]*storagev1.StorageClass{}
+	for _, content := range release.Files() {
+		for _, document := range strings.Split(content, "\n---") {
+			if len(strings.TrimSpace(document)) == 0 {
+				continue
+			}
+
+			obj, _, err := scheme.Codecs.UniversalDeserializer().Decode([]byte(document), nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			if storageClass, ok := obj.(*storagev1.StorageClass); ok {
+				storageClasses[storageClass.Name] = storageClass
+			}
+		}
+	}
+	return storageClasses, nil
+}
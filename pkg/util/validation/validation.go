@@ -0,0 +1,140 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateCIDR validates that the given value is a valid CIDR in canonical form, e.g. `10.250.0.0/16`.
+func ValidateCIDR(cidr string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	ip, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, cidr, "must be a valid CIDR"))
+	}
+	if !ip.Equal(ipNet.IP) {
+		allErrs = append(allErrs, field.Invalid(fldPath, cidr, fmt.Sprintf("must be in canonical form %q", ipNet.String())))
+	}
+
+	return allErrs
+}
+
+// ValidateCIDRIsSubset validates that the given CIDR is contained in the given superset CIDR. Invalid CIDRs are
+// ignored as they are reported by ValidateCIDR.
+func ValidateCIDRIsSubset(cidr, superset string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return allErrs
+	}
+	_, supersetNet, err := net.ParseCIDR(superset)
+	if err != nil {
+		return allErrs
+	}
+
+	ones, _ := ipNet.Mask.Size()
+	supersetOnes, _ := supersetNet.Mask.Size()
+	if !supersetNet.Contains(ipNet.IP) || ones < supersetOnes {
+		allErrs = append(allErrs, field.Invalid(fldPath, cidr, fmt.Sprintf("must be a subset of %q", superset)))
+	}
+
+	return allErrs
+}
+
+// ValidateImmutableField validates that the given new value equals the given old value.
+func ValidateImmutableField(newVal, oldVal interface{}, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !equality.Semantic.DeepEqual(oldVal, newVal) {
+		allErrs = append(allErrs, field.Invalid(fldPath, newVal, "field is immutable"))
+	}
+
+	return allErrs
+}
+
+// ValidateFeatureGates validates that the names of the given feature gates are not empty.
+func ValidateFeatureGates(featureGates map[string]bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name := range featureGates {
+		if len(name) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, name, "feature gate name must not be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateDiskSize validates that the given size is a valid positive quantity, e.g. `20Gi`.
+func ValidateDiskSize(size string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(size) == 0 {
+		return append(allErrs, field.Required(fldPath, "must provide a size"))
+	}
+
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, size, fmt.Sprintf("must be a valid quantity: %v", err)))
+	}
+	if quantity.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, size, "must be positive"))
+	}
+
+	return allErrs
+}
+
+// ValidateTags validates that the keys of the given tags are not empty and do not use any of the given reserved
+// prefixes. Keys and values must not exceed the given maximum lengths; a maximum length of zero disables the check.
+func ValidateTags(tags map[string]string, reservedKeyPrefixes []string, maxKeyLength, maxValueLength int, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for key, value := range tags {
+		keyPath := fldPath.Key(key)
+
+		switch {
+		case len(key) == 0:
+			allErrs = append(allErrs, field.Required(keyPath, "must not use an empty key"))
+		case maxKeyLength > 0 && len(key) > maxKeyLength:
+			allErrs = append(allErrs, field.TooLong(keyPath, key, maxKeyLength))
+		case hasPrefix(key, reservedKeyPrefixes):
+			allErrs = append(allErrs, field.Forbidden(keyPath, fmt.Sprintf("must not use a reserved key prefix %v", reservedKeyPrefixes)))
+		}
+
+		if maxValueLength > 0 && len(value) > maxValueLength {
+			allErrs = append(allErrs, field.TooLong(keyPath, value, maxValueLength))
+		}
+	}
+
+	return allErrs
+}
+
+func hasPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Validation", func() {
+	fldPath := field.NewPath("cidr")
+
+	Describe("#ValidateCIDR", func() {
+		It("should allow a valid CIDR", func() {
+			Expect(ValidateCIDR("10.250.0.0/16", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid CIDR", func() {
+			Expect(ValidateCIDR("10.250.0.0", fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("cidr"),
+			}))))
+		})
+
+		It("should forbid a CIDR that is not in canonical form", func() {
+			Expect(ValidateCIDR("10.250.1.0/16", fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("cidr"),
+				"Detail": Equal(`must be in canonical form "10.250.0.0/16"`),
+			}))))
+		})
+	})
+
+	Describe("#ValidateCIDRIsSubset", func() {
+		It("should allow a subset", func() {
+			Expect(ValidateCIDRIsSubset("10.250.0.0/19", "10.250.0.0/16", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid a CIDR outside of the superset", func() {
+			Expect(ValidateCIDRIsSubset("10.251.0.0/19", "10.250.0.0/16", fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("cidr"),
+			}))))
+		})
+
+		It("should forbid a CIDR larger than the superset", func() {
+			Expect(ValidateCIDRIsSubset("10.250.0.0/15", "10.250.0.0/16", fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("cidr"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateImmutableField", func() {
+		It("should allow unchanged values", func() {
+			Expect(ValidateImmutableField([]string{"a"}, []string{"a"}, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid changed values", func() {
+			Expect(ValidateImmutableField([]string{"b"}, []string{"a"}, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("cidr"),
+				"Detail": Equal("field is immutable"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateFeatureGates", func() {
+		It("should allow named feature gates", func() {
+			Expect(ValidateFeatureGates(map[string]bool{"Foo": true}, field.NewPath("featureGates"))).To(BeEmpty())
+		})
+
+		It("should forbid empty feature gate names", func() {
+			Expect(ValidateFeatureGates(map[string]bool{"": true}, field.NewPath("featureGates"))).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("featureGates"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateDiskSize", func() {
+		fldPath := field.NewPath("size")
+
+		It("should allow positive quantities", func() {
+			Expect(ValidateDiskSize("20Gi", fldPath)).To(BeEmpty())
+		})
+
+		It("should require a size", func() {
+			Expect(ValidateDiskSize("", fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("size"),
+			}))))
+		})
+
+		It("should forbid invalid or non-positive quantities", func() {
+			Expect(ValidateDiskSize("foo", fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("size"),
+			}))))
+			Expect(ValidateDiskSize("0", fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("size"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateTags", func() {
+		var (
+			fldPath  = field.NewPath("tags")
+			reserved = []string{"kubernetes.io/"}
+		)
+
+		It("should allow regular tags", func() {
+			Expect(ValidateTags(map[string]string{"foo": "bar"}, reserved, 5, 5, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid empty, reserved and too long keys", func() {
+			Expect(ValidateTags(map[string]string{
+				"":                "bar",
+				"kubernetes.io/x": "bar",
+				"foobar":          "bar",
+				"foo":             "barbaz",
+			}, reserved, 5, 5, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("tags[]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooLong),
+					"Field": Equal("tags[kubernetes.io/x]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooLong),
+					"Field": Equal("tags[foobar]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooLong),
+					"Field": Equal("tags[foo]"),
+				})),
+			))
+		})
+
+		It("should forbid reserved key prefixes if no maximum length is given", func() {
+			Expect(ValidateTags(map[string]string{"kubernetes.io/x": "bar"}, reserved, 0, 0, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("tags[kubernetes.io/x]"),
+			}))))
+		})
+	})
+})
@@ -0,0 +1,92 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// MutationResultMutated is the result label value for requests in which the webhook changed the object.
+	MutationResultMutated = "mutated"
+	// MutationResultNoop is the result label value for requests in which the webhook did not change the object.
+	MutationResultNoop = "noop"
+	// MutationResultError is the result label value for requests that failed.
+	MutationResultError = "error"
+
+	// EventReasonMutated is the reason of events recorded for objects that have been mutated by a webhook.
+	EventReasonMutated = "WebhookMutated"
+	// EventReasonMutationReported is the reason of events recorded for objects that would have been mutated by
+	// a webhook in report-only mode.
+	EventReasonMutationReported = "WebhookMutationReported"
+
+	// maxEventPatchLength is the maximum length of a patch that is included in an event message.
+	maxEventPatchLength = 1024
+)
+
+var mutationsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "gardener_extensions_webhook_mutations_total",
+		Help: "Total number of admission requests handled by mutating webhooks, partitioned by webhook, kind, and result.",
+	},
+	[]string{"webhook", "kind", "result"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(mutationsTotal)
+}
+
+// AuditOptions configure how a mutating handler audits the mutations it performs.
+type AuditOptions struct {
+	// WebhookName is the name of the webhook, used to partition the metrics and in event messages.
+	WebhookName string
+	// ReportOnly specifies that the handler only logs and reports the computed patch without applying it.
+	ReportOnly bool
+	// Recorder is used to record an event on each mutated object. If nil, no events are recorded.
+	Recorder record.EventRecorder
+}
+
+type auditOptionsInjector interface {
+	InjectAuditOptions(opts AuditOptions)
+}
+
+// InjectAuditOptions injects the given audit options into the handler of the given webhook. It returns false if
+// the handler does not support auditing (e.g. because it is a validating handler).
+func InjectAuditOptions(wh *Webhook, opts AuditOptions) bool {
+	var handler interface{} = wh.Handler
+	if wh.Webhook != nil {
+		handler = wh.Webhook.Handler
+	}
+
+	injector, ok := handler.(auditOptionsInjector)
+	if !ok {
+		return false
+	}
+	injector.InjectAuditOptions(opts)
+	return true
+}
+
+func recordMutation(opts AuditOptions, kind, result string) {
+	mutationsTotal.WithLabelValues(opts.WebhookName, kind, result).Inc()
+}
+
+func truncatePatch(patch string) string {
+	if len(patch) <= maxEventPatchLength {
+		return patch
+	}
+	return patch[:maxEventPatchLength] + "..."
+}
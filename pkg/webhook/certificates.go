@@ -45,6 +45,13 @@ const (
 	ModeURLWithServiceName = "url-service"
 
 	certSecretName = "gardener-extension-webhook-cert"
+
+	// dataKeyCertificateNextCA is the key in the cert secret that contains the certificate of the CA that replaces the
+	// current CA once the overlap period passed.
+	dataKeyCertificateNextCA = "next-ca.crt"
+	// dataKeyPrivateKeyNextCA is the key in the cert secret that contains the private key of the CA that replaces the
+	// current CA once the overlap period passed.
+	dataKeyPrivateKeyNextCA = "next-ca.key"
 )
 
 // GenerateCertificates generates the certificates that are required for a webhook. It returns the ca bundle, and it
@@ -104,20 +111,40 @@ func GenerateCertificates(ctx context.Context, mgr manager.Manager, certDir, nam
 	if err != nil {
 		return nil, errors.Wrapf(err, "error reading data of secret %s/%s", namespace, certSecretName)
 	}
-	return writeCertificates(certDir, caCert, serverCert)
+	nextCACert, err := loadExistingNextCA(secret.Data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading data of secret %s/%s", namespace, certSecretName)
+	}
+	if _, err := writeCertificates(certDir, caCert, serverCert); err != nil {
+		return nil, err
+	}
+	return caBundle(caCert, nextCACert), nil
 }
 
 func generateNewCAAndServerCert(mode, namespace, name, url string) (*secrets.Certificate, *secrets.Certificate, error) {
-	caConfig := &secrets.CertificateSecretConfig{
-		CommonName: "webhook-ca",
-		CertType:   secrets.CACert,
+	caCert, err := generateNewCA()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	caCert, err := caConfig.GenerateCertificate()
+	serverCert, err := generateNewServerCert(caCert, mode, namespace, name, url)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	return caCert, serverCert, nil
+}
+
+func generateNewCA() (*secrets.Certificate, error) {
+	caConfig := &secrets.CertificateSecretConfig{
+		CommonName: "webhook-ca",
+		CertType:   secrets.CACert,
+	}
+
+	return caConfig.GenerateCertificate()
+}
+
+func generateNewServerCert(caCert *secrets.Certificate, mode, namespace, name, url string) (*secrets.Certificate, error) {
 	var dnsNames []string
 	switch mode {
 	case ModeURL:
@@ -139,12 +166,7 @@ func generateNewCAAndServerCert(mode, namespace, name, url string) (*secrets.Cer
 		SigningCA:  caCert,
 	}
 
-	serverCert, err := serverConfig.GenerateCertificate()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return caCert, serverCert, nil
+	return serverConfig.GenerateCertificate()
 }
 
 func loadExistingCAAndServerCert(data map[string][]byte) (*secrets.Certificate, *secrets.Certificate, error) {
@@ -177,6 +199,35 @@ func loadExistingCAAndServerCert(data map[string][]byte) (*secrets.Certificate,
 	return caCert, serverCert, nil
 }
 
+// loadExistingNextCA loads the CA that replaces the current CA once the overlap period passed. It returns nil if
+// no CA rotation is in progress.
+func loadExistingNextCA(data map[string][]byte) (*secrets.Certificate, error) {
+	secretDataCACert, ok := data[dataKeyCertificateNextCA]
+	if !ok {
+		return nil, nil
+	}
+	secretDataCAKey, ok := data[dataKeyPrivateKeyNextCA]
+	if !ok {
+		return nil, fmt.Errorf("secret does not contain %s key", dataKeyPrivateKeyNextCA)
+	}
+	caCert, err := secrets.LoadCertificate("", secretDataCAKey, secretDataCACert)
+	if err != nil {
+		return nil, fmt.Errorf("could not load next ca certificate")
+	}
+
+	return caCert, nil
+}
+
+// caBundle returns the CA bundle for the given CA certificates. The next CA is part of the bundle during the overlap
+// period so that clients trust the server certificate before and after it is signed by the next CA.
+func caBundle(caCert, nextCACert *secrets.Certificate) []byte {
+	bundle := append([]byte{}, caCert.CertificatePEM...)
+	if nextCACert != nil {
+		bundle = append(bundle, nextCACert.CertificatePEM...)
+	}
+	return bundle
+}
+
 func writeCertificates(certDir string, caCert, serverCert *secrets.Certificate) ([]byte, error) {
 	var (
 		serverKeyPath  = filepath.Join(certDir, secrets.DataKeyPrivateKey)
@@ -0,0 +1,282 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// DefaultCertificateCheckInterval is the default interval in which the expiry of the webhook certificates is checked.
+	DefaultCertificateCheckInterval = time.Hour
+	// DefaultServerCertificateRenewBefore is the default duration before its expiry after which the webhook server
+	// certificate is renewed.
+	DefaultServerCertificateRenewBefore = 30 * 24 * time.Hour
+	// DefaultCARenewBefore is the default duration before its expiry after which the webhook CA is rotated.
+	DefaultCARenewBefore = 90 * 24 * time.Hour
+	// DefaultCAOverlap is the default duration in which both the current and the next CA are part of the CA bundle
+	// before the server certificate is signed by the next CA.
+	DefaultCAOverlap = 30 * 24 * time.Hour
+
+	// annotationNextCACreationTimestamp is the annotation of the cert secret that contains the time at which the next
+	// CA was added to the CA bundle.
+	annotationNextCACreationTimestamp = "webhook.extensions.gardener.cloud/next-ca-creation-timestamp"
+)
+
+// CertificateRotatorOptions are options for a CertificateRotator.
+type CertificateRotatorOptions struct {
+	// CertDir is the directory that contains the webhook server key and certificate.
+	CertDir string
+	// Namespace is the namespace of the secret that stores the webhook certificates.
+	Namespace string
+	// Name is the name of the webhook server.
+	Name string
+	// Mode is the webhook mode, see ModeService and ModeURL.
+	Mode string
+	// URL is the URL that is used to register the webhooks in Kubernetes.
+	URL string
+
+	// CheckInterval is the interval in which the expiry of the certificates is checked.
+	CheckInterval time.Duration
+	// ServerCertificateRenewBefore is the duration before its expiry after which the server certificate is renewed.
+	ServerCertificateRenewBefore time.Duration
+	// CARenewBefore is the duration before its expiry after which the CA is rotated. It must be greater than CAOverlap.
+	CARenewBefore time.Duration
+	// CAOverlap is the duration in which both the current and the next CA are part of the CA bundle before the server
+	// certificate is signed by the next CA.
+	CAOverlap time.Duration
+}
+
+// applyDefaults sets the defaults for all durations that are not set.
+func (o *CertificateRotatorOptions) applyDefaults() {
+	if o.CheckInterval == 0 {
+		o.CheckInterval = DefaultCertificateCheckInterval
+	}
+	if o.ServerCertificateRenewBefore == 0 {
+		o.ServerCertificateRenewBefore = DefaultServerCertificateRenewBefore
+	}
+	if o.CARenewBefore == 0 {
+		o.CARenewBefore = DefaultCARenewBefore
+	}
+	if o.CAOverlap == 0 {
+		o.CAOverlap = DefaultCAOverlap
+	}
+}
+
+// CertificateRotator rotates the webhook certificates that are stored in the cert secret before they expire.
+//
+// The server certificate is renewed and written to the cert dir, from which the webhook server reloads it. The CA is
+// rotated with an overlap period: The next CA is added to the CA bundle first, and only after the overlap period the
+// server certificate is signed by it and the old CA is removed from the bundle. Whenever the CA bundle changes, the
+// given function is called so that the webhooks can be registered with the new CA bundle.
+type CertificateRotator struct {
+	client           client.Client
+	logger           logr.Logger
+	clock            clock.Clock
+	options          CertificateRotatorOptions
+	caBundle         []byte
+	onCABundleChange func(ctx context.Context, caBundle []byte) error
+}
+
+// NewCertificateRotator creates a new CertificateRotator. The given CA bundle is the one the webhooks are currently
+// registered with, e.g. the one returned by GenerateCertificates.
+func NewCertificateRotator(c client.Client, logger logr.Logger, options CertificateRotatorOptions, caBundle []byte, onCABundleChange func(ctx context.Context, caBundle []byte) error) *CertificateRotator {
+	return NewCertificateRotatorWithClock(c, logger, options, caBundle, onCABundleChange, clock.RealClock{})
+}
+
+// NewCertificateRotatorWithClock creates a new CertificateRotator like NewCertificateRotator that uses the given clock.
+func NewCertificateRotatorWithClock(c client.Client, logger logr.Logger, options CertificateRotatorOptions, caBundle []byte, onCABundleChange func(ctx context.Context, caBundle []byte) error, clock clock.Clock) *CertificateRotator {
+	options.applyDefaults()
+	return &CertificateRotator{
+		client:           c,
+		logger:           logger.WithName("webhook-certificate-rotator"),
+		clock:            clock,
+		options:          options,
+		caBundle:         caBundle,
+		onCABundleChange: onCABundleChange,
+	}
+}
+
+// AddCertificateRotator adds a CertificateRotator with the given options to the given manager.
+func AddCertificateRotator(mgr manager.Manager, logger logr.Logger, options CertificateRotatorOptions, caBundle []byte, onCABundleChange func(ctx context.Context, caBundle []byte) error) error {
+	c, err := getClient(mgr)
+	if err != nil {
+		return err
+	}
+
+	return mgr.Add(NewCertificateRotator(c, logger, options, caBundle, onCABundleChange))
+}
+
+// Start implements manager.Runnable. It checks the certificates in the configured interval until the given channel
+// is closed.
+func (r *CertificateRotator) Start(stopCh <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	wait.Until(func() {
+		if err := r.Rotate(ctx); err != nil {
+			r.logger.Error(err, "Could not rotate the webhook certificates")
+		}
+	}, r.options.CheckInterval, stopCh)
+	return nil
+}
+
+// Rotate checks the certificates stored in the cert secret and rotates them if required. Afterwards, it writes the
+// server certificate to the cert dir and calls the CA bundle change function if they differ from the current ones.
+// The latter also takes effect if the certificates were rotated by another replica.
+func (r *CertificateRotator) Rotate(ctx context.Context) error {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, kutil.Key(r.options.Namespace, certSecretName), secret); err != nil {
+		return errors.Wrapf(err, "error getting cert secret")
+	}
+
+	caCert, serverCert, err := loadExistingCAAndServerCert(secret.Data)
+	if err != nil {
+		return errors.Wrapf(err, "error reading data of secret %s/%s", r.options.Namespace, certSecretName)
+	}
+	nextCACert, err := loadExistingNextCA(secret.Data)
+	if err != nil {
+		return errors.Wrapf(err, "error reading data of secret %s/%s", r.options.Namespace, certSecretName)
+	}
+
+	var (
+		now       = r.clock.Now()
+		caExpired = !now.Before(caCert.Certificate.NotAfter)
+		updated   bool
+	)
+
+	// Add a new CA to the CA bundle if the current one expires soon. If it already expired, there is no point in
+	// waiting for the overlap period as the current server certificate is not trusted anymore anyway.
+	if nextCACert == nil && (caExpired || !now.Before(caCert.Certificate.NotAfter.Add(-r.options.CARenewBefore))) {
+		r.logger.Info("Adding next CA to the webhook CA bundle", "expiry", caCert.Certificate.NotAfter)
+		if nextCACert, err = generateNewCA(); err != nil {
+			return errors.Wrapf(err, "error generating next CA for webhook server")
+		}
+		setNextCACreationTimestamp(secret, now)
+		updated = true
+	}
+
+	// Replace the current CA by the next CA once the overlap period passed.
+	if nextCACert != nil {
+		creationTimestamp, ok := getNextCACreationTimestamp(secret)
+		if !ok {
+			creationTimestamp = now
+			setNextCACreationTimestamp(secret, now)
+			updated = true
+		}
+
+		if caExpired || !now.Before(creationTimestamp.Add(r.options.CAOverlap)) {
+			r.logger.Info("Replacing the webhook CA by the next CA")
+			caCert, nextCACert = nextCACert, nil
+			delete(secret.Annotations, annotationNextCACreationTimestamp)
+			updated = true
+		}
+	}
+
+	// Renew the server certificate if it expires soon or if it is not signed by the current CA.
+	if serverCert.Certificate.CheckSignatureFrom(caCert.Certificate) != nil || !now.Before(serverCert.Certificate.NotAfter.Add(-r.options.ServerCertificateRenewBefore)) {
+		r.logger.Info("Renewing the webhook server certificate", "expiry", serverCert.Certificate.NotAfter)
+		if serverCert, err = generateNewServerCert(caCert, r.options.Mode, r.options.Namespace, r.options.Name, r.options.URL); err != nil {
+			return errors.Wrapf(err, "error generating new server certificate for webhook server")
+		}
+		updated = true
+	}
+
+	if updated {
+		secret.Data = map[string][]byte{
+			secrets.DataKeyCertificateCA: caCert.CertificatePEM,
+			secrets.DataKeyPrivateKeyCA:  caCert.PrivateKeyPEM,
+			secrets.DataKeyCertificate:   serverCert.CertificatePEM,
+			secrets.DataKeyPrivateKey:    serverCert.PrivateKeyPEM,
+		}
+		if nextCACert != nil {
+			secret.Data[dataKeyCertificateNextCA] = nextCACert.CertificatePEM
+			secret.Data[dataKeyPrivateKeyNextCA] = nextCACert.PrivateKeyPEM
+		}
+
+		// A conflict means that another replica rotated the certificates in the meantime, they are picked up with
+		// the next check.
+		if err := r.client.Update(ctx, secret); err != nil {
+			return errors.Wrapf(err, "error updating cert secret")
+		}
+	}
+
+	if err := r.writeServerCertificate(caCert, serverCert); err != nil {
+		return errors.Wrapf(err, "error writing the webhook server certificate")
+	}
+
+	if bundle := caBundle(caCert, nextCACert); !bytes.Equal(bundle, r.caBundle) {
+		r.logger.Info("Webhook CA bundle changed, registering webhooks")
+		if err := r.onCABundleChange(ctx, bundle); err != nil {
+			return errors.Wrapf(err, "error updating the webhook CA bundle")
+		}
+		r.caBundle = bundle
+	}
+
+	return nil
+}
+
+// writeServerCertificate writes the given server certificate to the cert dir unless it is already there. The webhook
+// server watches the files and reloads the certificate.
+func (r *CertificateRotator) writeServerCertificate(caCert, serverCert *secrets.Certificate) error {
+	current, err := ioutil.ReadFile(filepath.Join(r.options.CertDir, secrets.DataKeyCertificate))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if bytes.Equal(current, serverCert.CertificatePEM) {
+		return nil
+	}
+
+	_, err = writeCertificates(r.options.CertDir, caCert, serverCert)
+	return err
+}
+
+func getNextCACreationTimestamp(secret *corev1.Secret) (time.Time, bool) {
+	value, ok := secret.Annotations[annotationNextCACreationTimestamp]
+	if !ok {
+		return time.Time{}, false
+	}
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return timestamp, true
+}
+
+func setNextCACreationTimestamp(secret *corev1.Secret, timestamp time.Time) {
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[annotationNextCACreationTimestamp] = timestamp.UTC().Format(time.RFC3339)
+}
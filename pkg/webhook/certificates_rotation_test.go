@@ -0,0 +1,154 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/secrets"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("CertificateRotator", func() {
+	const (
+		namespace = "extension"
+		name      = "provider-test"
+	)
+
+	var (
+		ctx        = context.TODO()
+		c          client.Client
+		fakeClock  *clock.FakeClock
+		certDir    string
+		caCert     *secrets.Certificate
+		serverCert *secrets.Certificate
+		caBundles  [][]byte
+		rotator    *CertificateRotator
+	)
+
+	BeforeEach(func() {
+		var err error
+		certDir, err = ioutil.TempDir("", "webhook-certs")
+		Expect(err).NotTo(HaveOccurred())
+
+		caCert, serverCert, err = generateNewCAAndServerCert(ModeService, namespace, name, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		c = fake.NewFakeClient(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: certSecretName},
+			Data: map[string][]byte{
+				secrets.DataKeyCertificateCA: caCert.CertificatePEM,
+				secrets.DataKeyPrivateKeyCA:  caCert.PrivateKeyPEM,
+				secrets.DataKeyCertificate:   serverCert.CertificatePEM,
+				secrets.DataKeyPrivateKey:    serverCert.PrivateKeyPEM,
+			},
+		})
+
+		fakeClock = clock.NewFakeClock(time.Now())
+		caBundles = nil
+		rotator = NewCertificateRotatorWithClock(c, logger, CertificateRotatorOptions{
+			CertDir:   certDir,
+			Namespace: namespace,
+			Name:      name,
+			Mode:      ModeService,
+		}, caCert.CertificatePEM, func(_ context.Context, caBundle []byte) error {
+			caBundles = append(caBundles, caBundle)
+			return nil
+		}, fakeClock)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(certDir)).To(Succeed())
+	})
+
+	readServerCert := func() []byte {
+		data, err := ioutil.ReadFile(filepath.Join(certDir, secrets.DataKeyCertificate))
+		Expect(err).NotTo(HaveOccurred())
+		return data
+	}
+
+	readSecret := func() *corev1.Secret {
+		secret := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: certSecretName}, secret)).To(Succeed())
+		return secret
+	}
+
+	It("should keep valid certificates", func() {
+		Expect(rotator.Rotate(ctx)).To(Succeed())
+
+		Expect(readServerCert()).To(Equal(serverCert.CertificatePEM))
+		Expect(readSecret().Data[secrets.DataKeyCertificate]).To(Equal(serverCert.CertificatePEM))
+		Expect(caBundles).To(BeEmpty())
+	})
+
+	It("should renew the server certificate before it expires", func() {
+		fakeClock.SetTime(serverCert.Certificate.NotAfter.Add(-DefaultServerCertificateRenewBefore))
+		rotator.options.CARenewBefore = time.Hour
+		rotator.options.CAOverlap = time.Minute
+
+		Expect(rotator.Rotate(ctx)).To(Succeed())
+
+		secret := readSecret()
+		Expect(secret.Data[secrets.DataKeyCertificate]).NotTo(Equal(serverCert.CertificatePEM))
+		Expect(secret.Data[secrets.DataKeyCertificateCA]).To(Equal(caCert.CertificatePEM))
+		Expect(readServerCert()).To(Equal(secret.Data[secrets.DataKeyCertificate]))
+		Expect(caBundles).To(BeEmpty())
+	})
+
+	It("should rotate the CA with an overlap period", func() {
+		fakeClock.SetTime(caCert.Certificate.NotAfter.Add(-DefaultCARenewBefore))
+
+		By("adding the next CA to the CA bundle")
+		Expect(rotator.Rotate(ctx)).To(Succeed())
+
+		secret := readSecret()
+		nextCACertPEM := secret.Data[dataKeyCertificateNextCA]
+		Expect(nextCACertPEM).NotTo(BeEmpty())
+		Expect(secret.Data[secrets.DataKeyCertificate]).To(Equal(serverCert.CertificatePEM))
+		Expect(caBundles).To(Equal([][]byte{append(append([]byte{}, caCert.CertificatePEM...), nextCACertPEM...)}))
+
+		By("keeping both CAs during the overlap period")
+		fakeClock.Step(DefaultCAOverlap - time.Minute)
+		Expect(rotator.Rotate(ctx)).To(Succeed())
+		Expect(caBundles).To(HaveLen(1))
+
+		By("replacing the CA after the overlap period")
+		fakeClock.Step(time.Minute)
+		Expect(rotator.Rotate(ctx)).To(Succeed())
+
+		secret = readSecret()
+		Expect(secret.Data[secrets.DataKeyCertificateCA]).To(Equal(nextCACertPEM))
+		Expect(secret.Data).NotTo(HaveKey(dataKeyCertificateNextCA))
+		Expect(secret.Annotations).NotTo(HaveKey(annotationNextCACreationTimestamp))
+		Expect(readServerCert()).To(Equal(secret.Data[secrets.DataKeyCertificate]))
+		Expect(caBundles).To(Equal([][]byte{append(append([]byte{}, caCert.CertificatePEM...), nextCACertPEM...), nextCACertPEM}))
+
+		newServerCert, err := secrets.LoadCertificate("", secret.Data[secrets.DataKeyPrivateKey], secret.Data[secrets.DataKeyCertificate])
+		Expect(err).NotTo(HaveOccurred())
+		newCACert, err := secrets.LoadCertificate("", secret.Data[secrets.DataKeyPrivateKeyCA], nextCACertPEM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newServerCert.Certificate.CheckSignatureFrom(newCACert.Certificate)).To(Succeed())
+	})
+})
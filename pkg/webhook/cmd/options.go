@@ -17,13 +17,16 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	extensionswebhookshoot "github.com/gardener/gardener-extensions/pkg/webhook/shoot"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
@@ -93,33 +96,50 @@ func (w *ServerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&w.Namespace, NamespaceFlag, w.Namespace, "The webhook config namespace for 'service' mode.")
 }
 
-// DisableFlag is the name of the command line flag to disable individual webhooks.
-const DisableFlag = "disable-webhooks"
+const (
+	// DisableFlag is the name of the command line flag to disable individual webhooks.
+	DisableFlag = "disable-webhooks"
+	// EnableFlag is the name of the command line flag to enable individual webhooks that are disabled by default.
+	EnableFlag = "enable-webhooks"
+	// ReportOnlyFlag is the name of the command line flag to run individual webhooks in report-only mode.
+	ReportOnlyFlag = "report-only-webhooks"
+)
 
 // NameToFactory binds a specific name to a webhook's factory function.
 type NameToFactory struct {
 	Name string
 	Func func(manager.Manager) (*extensionswebhook.Webhook, error)
+	// OptIn indicates that the webhook is disabled unless it is enabled explicitly.
+	OptIn bool
 }
 
 // SwitchOptions are options to build an AddToManager function that filters the disabled webhooks.
 type SwitchOptions struct {
-	Disabled []string
+	Disabled   []string
+	Enabled    []string
+	ReportOnly []string
 
 	nameToWebhookFactory     map[string]func(manager.Manager) (*extensionswebhook.Webhook, error)
+	optIn                    sets.String
 	webhookFactoryAggregator extensionswebhook.FactoryAggregator
+	reportOnly               sets.String
 }
 
 // Register registers the given NameToWebhookFuncs in the options.
 func (w *SwitchOptions) Register(pairs ...NameToFactory) {
 	for _, pair := range pairs {
 		w.nameToWebhookFactory[pair.Name] = pair.Func
+		if pair.OptIn {
+			w.optIn.Insert(pair.Name)
+		}
 	}
 }
 
 // AddFlags implements Option.
 func (w *SwitchOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringSliceVar(&w.Disabled, DisableFlag, w.Disabled, "List of webhooks to disable")
+	fs.StringSliceVar(&w.Enabled, EnableFlag, w.Enabled, "List of webhooks to enable that are disabled by default")
+	fs.StringSliceVar(&w.ReportOnly, ReportOnlyFlag, w.ReportOnly, "List of webhooks that only log and report their mutations without applying them")
 }
 
 // Complete implements Option.
@@ -132,7 +152,26 @@ func (w *SwitchOptions) Complete() error {
 		disabled.Insert(disabledName)
 	}
 
+	enabled := sets.NewString()
+	for _, enabledName := range w.Enabled {
+		if _, ok := w.nameToWebhookFactory[enabledName]; !ok {
+			return fmt.Errorf("cannot enable unknown webhook %q", enabledName)
+		}
+		enabled.Insert(enabledName)
+	}
+
+	w.reportOnly = sets.NewString()
+	for _, reportOnlyName := range w.ReportOnly {
+		if _, ok := w.nameToWebhookFactory[reportOnlyName]; !ok {
+			return fmt.Errorf("cannot run unknown webhook %q in report-only mode", reportOnlyName)
+		}
+		w.reportOnly.Insert(reportOnlyName)
+	}
+
 	for name, addToManager := range w.nameToWebhookFactory {
+		if w.optIn.Has(name) && !enabled.Has(name) {
+			continue
+		}
 		if !disabled.Has(name) {
 			w.webhookFactoryAggregator.Register(addToManager)
 		}
@@ -142,12 +181,14 @@ func (w *SwitchOptions) Complete() error {
 
 // Completed returns the completed SwitchConfig. Call this only after successfully calling `Completed`.
 func (w *SwitchOptions) Completed() *SwitchConfig {
-	return &SwitchConfig{WebhooksFactory: w.webhookFactoryAggregator.Webhooks}
+	return &SwitchConfig{WebhooksFactory: w.webhookFactoryAggregator.Webhooks, ReportOnly: w.reportOnly}
 }
 
 // SwitchConfig is the completed configuration of SwitchOptions.
 type SwitchConfig struct {
 	WebhooksFactory func(manager.Manager) ([]*extensionswebhook.Webhook, error)
+	// ReportOnly is the set of names of the webhooks that only log and report their mutations without applying them.
+	ReportOnly sets.String
 }
 
 // Switch binds the given name to the given AddToManager function.
@@ -158,9 +199,19 @@ func Switch(name string, f func(manager.Manager) (*extensionswebhook.Webhook, er
 	}
 }
 
+// OptInSwitch binds the given name to the given AddToManager function. The webhook is disabled unless it is enabled
+// explicitly.
+func OptInSwitch(name string, f func(manager.Manager) (*extensionswebhook.Webhook, error)) NameToFactory {
+	return NameToFactory{
+		Name:  name,
+		Func:  f,
+		OptIn: true,
+	}
+}
+
 // NewSwitchOptions creates new SwitchOptions with the given initial pairs.
 func NewSwitchOptions(pairs ...NameToFactory) *SwitchOptions {
-	opts := SwitchOptions{nameToWebhookFactory: map[string]func(manager.Manager) (*extensionswebhook.Webhook, error){}, webhookFactoryAggregator: extensionswebhook.FactoryAggregator{}}
+	opts := SwitchOptions{nameToWebhookFactory: map[string]func(manager.Manager) (*extensionswebhook.Webhook, error){}, optIn: sets.NewString(), webhookFactoryAggregator: extensionswebhook.FactoryAggregator{}}
 	opts.Register(pairs...)
 	return &opts
 }
@@ -210,10 +261,30 @@ type AddToManagerConfig struct {
 	serverName string
 	Server     ServerConfig
 	Switch     SwitchConfig
+
+	shootWebhooksLock sync.RWMutex
+	shootWebhooks     []admissionregistrationv1beta1.Webhook
+}
+
+// ShootWebhooks returns the shoot webhooks that were registered most recently. They change when the CA bundle is
+// rotated, hence consumers should call this function whenever they need the shoot webhooks.
+func (c *AddToManagerConfig) ShootWebhooks() []admissionregistrationv1beta1.Webhook {
+	c.shootWebhooksLock.RLock()
+	defer c.shootWebhooksLock.RUnlock()
+	return c.shootWebhooks
+}
+
+func (c *AddToManagerConfig) setShootWebhooks(shootWebhooks []admissionregistrationv1beta1.Webhook) {
+	c.shootWebhooksLock.Lock()
+	defer c.shootWebhooksLock.Unlock()
+	c.shootWebhooks = shootWebhooks
 }
 
 // AddToManager instantiates all webhooks of this configuration. If there are any webhooks, it creates a
 // webhook server, registers the webhooks and adds the server to the manager. Otherwise, it is a no-op.
+// If the certificates are stored in a secret, it also adds a certificate rotator to the manager that registers
+// the webhooks again whenever the CA bundle was rotated and triggers the reconciliation of the controlplanes the
+// shoot webhooks were deployed for.
 func (c *AddToManagerConfig) AddToManager(mgr manager.Manager) ([]admissionregistrationv1beta1.Webhook, []admissionregistrationv1beta1.Webhook, error) {
 	ctx := context.Background()
 
@@ -225,7 +296,18 @@ func (c *AddToManagerConfig) AddToManager(mgr manager.Manager) ([]admissionregis
 	webhookServer := mgr.GetWebhookServer()
 	webhookServer.CertDir = c.Server.CertDir
 
+	recorder := mgr.GetEventRecorderFor(c.serverName + "-webhook")
 	for _, wh := range webhooks {
+		auditOptions := extensionswebhook.AuditOptions{
+			WebhookName: wh.Name,
+			ReportOnly:  c.Switch.ReportOnly.Has(wh.Name),
+		}
+		// Shoot webhooks mutate objects in the shoot cluster, hence events cannot be recorded in the seed.
+		if wh.Target != extensionswebhook.TargetShoot {
+			auditOptions.Recorder = recorder
+		}
+		extensionswebhook.InjectAuditOptions(wh, auditOptions)
+
 		if wh.Handler != nil {
 			webhookServer.Register("/"+wh.Name, wh.Handler)
 		} else {
@@ -242,6 +324,35 @@ func (c *AddToManagerConfig) AddToManager(mgr manager.Manager) ([]admissionregis
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "could not create webhooks")
 	}
+	c.setShootWebhooks(shootWebhooks)
+
+	// Without a namespace the certificates are not stored in a secret but newly generated with every start.
+	if len(c.Server.Namespace) > 0 {
+		rotatorOptions := extensionswebhook.CertificateRotatorOptions{
+			CertDir:   c.Server.CertDir,
+			Namespace: c.Server.Namespace,
+			Name:      c.serverName,
+			Mode:      c.Server.Mode,
+			URL:       c.Server.URL,
+		}
+
+		if err := extensionswebhook.AddCertificateRotator(mgr, log.Log, rotatorOptions, caBundle, func(ctx context.Context, caBundle []byte) error {
+			_, shootWebhooks, err := extensionswebhook.RegisterWebhooks(ctx, mgr, c.Server.Namespace, c.serverName, webhookServer.Port, c.Server.Mode, c.Server.URL, caBundle, webhooks)
+			if err != nil {
+				return errors.Wrap(err, "could not register webhooks")
+			}
+			c.setShootWebhooks(shootWebhooks)
+
+			// The shoot webhooks are deployed by the controlplane controller, hence it has to reconcile the controlplanes
+			// again to deploy them with the new CA bundle.
+			if len(shootWebhooks) > 0 {
+				return extensionswebhookshoot.TriggerWebhookConfigReconciliation(ctx, mgr.GetClient(), c.serverName)
+			}
+			return nil
+		}); err != nil {
+			return nil, nil, errors.Wrap(err, "could not add certificate rotator")
+		}
+	}
 
 	return seedWebhooks, shootWebhooks, nil
 }
@@ -18,11 +18,13 @@ import (
 	"testing"
 
 	"github.com/gardener/gardener-extensions/pkg/util/test"
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/pflag"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
 func TestCmd(t *testing.T) {
@@ -73,6 +75,49 @@ var _ = Describe("Options", func() {
 				Expect(switches.Disabled).To(Equal([]string{name1, name2}))
 			})
 
+			It("should correctly parse the report-only flag", func() {
+				var (
+					name1    = "foo"
+					name2    = "bar"
+					switches = NewSwitchOptions(
+						Switch(name1, nil),
+						Switch(name2, nil),
+					)
+				)
+
+				fs := pflag.NewFlagSet(commandName, pflag.ContinueOnError)
+				switches.AddFlags(fs)
+
+				err := fs.Parse(test.NewCommandBuilder(commandName).
+					Flags(
+						test.StringSliceFlag(ReportOnlyFlag, name1),
+					).
+					Command().
+					Slice())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(switches.Complete()).To(Succeed())
+
+				Expect(switches.Completed().ReportOnly.List()).To(Equal([]string{name1}))
+			})
+
+			It("should error on an unknown report-only webhook", func() {
+				switches := NewSwitchOptions()
+
+				fs := pflag.NewFlagSet(commandName, pflag.ContinueOnError)
+				switches.AddFlags(fs)
+
+				err := fs.Parse(test.NewCommandBuilder(commandName).
+					Flags(
+						test.StringSliceFlag(ReportOnlyFlag, "unknown"),
+					).
+					Command().
+					Slice())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(switches.Complete()).To(HaveOccurred())
+			})
+
 			It("should error on an unknown webhook", func() {
 				switches := NewSwitchOptions()
 
@@ -89,6 +134,55 @@ var _ = Describe("Options", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(switches.Complete()).To(HaveOccurred())
 			})
+
+			It("should only add opt-in webhooks if they are enabled", func() {
+				newWebhook := func(name string) func(manager.Manager) (*extensionswebhook.Webhook, error) {
+					return func(manager.Manager) (*extensionswebhook.Webhook, error) {
+						return &extensionswebhook.Webhook{Name: name}, nil
+					}
+				}
+				webhookNames := func(switches *SwitchOptions, flags ...test.Flag) []string {
+					fs := pflag.NewFlagSet(commandName, pflag.ContinueOnError)
+					switches.AddFlags(fs)
+					Expect(fs.Parse(test.NewCommandBuilder(commandName).Flags(flags...).Command().Slice())).To(Succeed())
+					Expect(switches.Complete()).To(Succeed())
+
+					webhooks, err := switches.Completed().WebhooksFactory(nil)
+					Expect(err).NotTo(HaveOccurred())
+					var names []string
+					for _, webhook := range webhooks {
+						names = append(names, webhook.Name)
+					}
+					return names
+				}
+
+				Expect(webhookNames(NewSwitchOptions(
+					Switch("foo", newWebhook("foo")),
+					OptInSwitch("bar", newWebhook("bar")),
+				))).To(ConsistOf("foo"))
+
+				Expect(webhookNames(NewSwitchOptions(
+					Switch("foo", newWebhook("foo")),
+					OptInSwitch("bar", newWebhook("bar")),
+				), test.StringSliceFlag(EnableFlag, "bar"))).To(ConsistOf("foo", "bar"))
+			})
+
+			It("should error on an unknown enabled webhook", func() {
+				switches := NewSwitchOptions()
+
+				fs := pflag.NewFlagSet(commandName, pflag.ContinueOnError)
+				switches.AddFlags(fs)
+
+				err := fs.Parse(test.NewCommandBuilder(commandName).
+					Flags(
+						test.StringSliceFlag(EnableFlag, "unknown"),
+					).
+					Command().
+					Slice())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(switches.Complete()).To(HaveOccurred())
+			})
 		})
 	})
 })
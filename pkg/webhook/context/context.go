@@ -0,0 +1,87 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package context
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GardenContext wraps the actual context and the Cluster of the shoot an object belongs to, so that the Cluster is
+// only read once per admission request.
+type GardenContext interface {
+	// GetCluster returns the Cluster of the shoot the object belongs to.
+	GetCluster(ctx context.Context) (*extensionscontroller.Cluster, error)
+	// GetKubernetesVersion returns the Kubernetes version of the shoot the object belongs to.
+	GetKubernetesVersion(ctx context.Context) (string, error)
+}
+
+type gardenContext struct {
+	client  client.Client
+	object  runtime.Object
+	cluster *extensionscontroller.Cluster
+}
+
+// NewGardenContext creates a new GardenContext that reads the Cluster from the namespace of the given object
+// using the given client.
+func NewGardenContext(client client.Client, object runtime.Object) GardenContext {
+	return &gardenContext{
+		client: client,
+		object: object,
+	}
+}
+
+// NewInternalGardenContext creates a new GardenContext that always returns the given Cluster.
+func NewInternalGardenContext(cluster *extensionscontroller.Cluster) GardenContext {
+	return &gardenContext{
+		cluster: cluster,
+	}
+}
+
+// GetCluster returns the Cluster of the shoot the object belongs to. It is read on the first call and cached afterwards.
+func (c *gardenContext) GetCluster(ctx context.Context) (*extensionscontroller.Cluster, error) {
+	if c.cluster == nil {
+		acc, err := meta.Accessor(c.object)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create accessor for object %v", c.object)
+		}
+
+		cluster, err := extensionscontroller.GetCluster(ctx, c.client, acc.GetNamespace())
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get cluster for namespace '%s'", acc.GetNamespace())
+		}
+		c.cluster = cluster
+	}
+	return c.cluster, nil
+}
+
+// GetKubernetesVersion returns the Kubernetes version of the shoot the object belongs to.
+func (c *gardenContext) GetKubernetesVersion(ctx context.Context) (string, error) {
+	cluster, err := c.GetCluster(ctx)
+	if err != nil {
+		return "", err
+	}
+	if cluster.Shoot == nil {
+		return "", fmt.Errorf("cluster does not contain a shoot")
+	}
+	return cluster.Shoot.Spec.Kubernetes.Version, nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	extensionscontext "github.com/gardener/gardener-extensions/pkg/webhook/context"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// EnsureVersionedFlags applies the given flags to the given command line for the Kubernetes version of the shoot
+// the GardenContext belongs to. If the Cluster of the shoot does not exist, only the flags without version constraint
+// are applied and all other flags are left untouched, as the version they are managed for is unknown.
+func EnsureVersionedFlags(ctx context.Context, gctx extensionscontext.GardenContext, command []string, flags ...extensionswebhook.VersionedFlag) ([]string, error) {
+	version, err := gctx.GetKubernetesVersion(ctx)
+	if err != nil {
+		if !apierrors.IsNotFound(errors.Cause(err)) {
+			return nil, err
+		}
+
+		logger.Info("Cluster not found, only managing flags without version constraint", "error", err.Error())
+		var unversionedFlags []extensionswebhook.VersionedFlag
+		for _, flag := range flags {
+			if len(flag.VersionConstraint) == 0 {
+				unversionedFlags = append(unversionedFlags, flag)
+			}
+		}
+		return extensionswebhook.EnsureVersionedFlags(command, "", unversionedFlags...)
+	}
+
+	return extensionswebhook.EnsureVersionedFlags(command, version, flags...)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	extensionscontext "github.com/gardener/gardener-extensions/pkg/webhook/context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Flags", func() {
+	Describe("#EnsureVersionedFlags", func() {
+		var (
+			ctx     = context.TODO()
+			command = []string{"/hyperkube", "controller-manager", "--cloud-provider=foo", "--external-cloud-volume-plugin=foo"}
+			flags   = []extensionswebhook.VersionedFlag{
+				extensionswebhook.EnsureFlag("--cloud-provider", "external"),
+				extensionswebhook.EnsureFlag("--external-cloud-volume-plugin", "bar").ForVersions("< 1.18"),
+				extensionswebhook.RemoveFlag("--external-cloud-volume-plugin").ForVersions(">= 1.18"),
+			}
+		)
+
+		It("should apply the flags for the Kubernetes version of the shoot", func() {
+			gctx := extensionscontext.NewInternalGardenContext(&extensionscontroller.Cluster{
+				Shoot: &gardenv1beta1.Shoot{
+					Spec: gardenv1beta1.ShootSpec{
+						Kubernetes: gardenv1beta1.Kubernetes{Version: "1.18.0"},
+					},
+				},
+			})
+
+			result, err := EnsureVersionedFlags(ctx, gctx, append([]string{}, command...), flags...)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]string{"/hyperkube", "controller-manager", "--cloud-provider=external"}))
+		})
+
+		It("should only apply the flags without version constraint if the cluster does not exist", func() {
+			scheme := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+			dep := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: "shoot--foo--bar"}}
+			gctx := extensionscontext.NewGardenContext(fakeclient.NewFakeClientWithScheme(scheme), dep)
+
+			result, err := EnsureVersionedFlags(ctx, gctx, append([]string{}, command...), flags...)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]string{"/hyperkube", "controller-manager", "--cloud-provider=external", "--external-cloud-volume-plugin=foo"}))
+		})
+	})
+})
@@ -17,10 +17,10 @@ package genericmutator
 import (
 	"context"
 
-	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/cloudinit"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	extensionscontext "github.com/gardener/gardener-extensions/pkg/webhook/context"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 
 	"github.com/coreos/go-systemd/unit"
@@ -37,33 +37,49 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
 )
 
+const (
+	// DeploymentNameMachineControllerManager is the name of the machine-controller-manager deployment.
+	DeploymentNameMachineControllerManager = "machine-controller-manager"
+)
+
 // Ensurer ensures that various standard Kubernets controlplane objects conform to the provider requirements.
-// If they don't initially, they are mutated accordingly.
+// If they don't initially, they are mutated accordingly. The given GardenContext provides access to the Cluster
+// of the shoot the mutated object belongs to, e.g. to make the mutations depend on the shoot's Kubernetes version.
 type Ensurer interface {
 	// EnsureKubeAPIServerService ensures that the kube-apiserver service conforms to the provider requirements.
-	EnsureKubeAPIServerService(context.Context, *corev1.Service) error
+	EnsureKubeAPIServerService(context.Context, extensionscontext.GardenContext, *corev1.Service) error
 	// EnsureKubeAPIServerDeployment ensures that the kube-apiserver deployment conforms to the provider requirements.
-	EnsureKubeAPIServerDeployment(context.Context, *appsv1.Deployment) error
+	EnsureKubeAPIServerDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error
 	// EnsureKubeControllerManagerDeployment ensures that the kube-controller-manager deployment conforms to the provider requirements.
-	EnsureKubeControllerManagerDeployment(context.Context, *appsv1.Deployment) error
+	EnsureKubeControllerManagerDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error
 	// EnsureKubeSchedulerDeployment ensures that the kube-scheduler deployment conforms to the provider requirements.
-	EnsureKubeSchedulerDeployment(context.Context, *appsv1.Deployment) error
+	EnsureKubeSchedulerDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error
+	// EnsureClusterAutoscalerDeployment ensures that the cluster-autoscaler deployment conforms to the provider requirements.
+	EnsureClusterAutoscalerDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error
+	// EnsureMachineControllerManagerDeployment ensures that the machine-controller-manager deployment conforms to the provider requirements.
+	EnsureMachineControllerManagerDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error
+	// EnsureGardenerResourceManagerDeployment ensures that the gardener-resource-manager deployment conforms to the provider requirements.
+	EnsureGardenerResourceManagerDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error
+	// EnsureDeployment ensures that any other control plane deployment conforms to the provider requirements.
+	// It is called for all deployments in the shoot namespace that are not handled by a more specific method, hence
+	// implementations should check the deployment name.
+	EnsureDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error
 	// EnsureETCDStatefulSet ensures that the etcd stateful sets conform to the provider requirements.
-	EnsureETCDStatefulSet(context.Context, *appsv1.StatefulSet, *extensionscontroller.Cluster) error
+	EnsureETCDStatefulSet(context.Context, extensionscontext.GardenContext, *appsv1.StatefulSet) error
 	// EnsureKubeletServiceUnitOptions ensures that the kubelet.service unit options conform to the provider requirements.
-	EnsureKubeletServiceUnitOptions(context.Context, []*unit.UnitOption) ([]*unit.UnitOption, error)
+	EnsureKubeletServiceUnitOptions(context.Context, extensionscontext.GardenContext, []*unit.UnitOption) ([]*unit.UnitOption, error)
 	// EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-	EnsureKubeletConfiguration(context.Context, *kubeletconfigv1beta1.KubeletConfiguration) error
+	EnsureKubeletConfiguration(context.Context, extensionscontext.GardenContext, *kubeletconfigv1beta1.KubeletConfiguration) error
 	// EnsureKubernetesGeneralConfiguration ensures that the kubernetes general configuration conforms to the provider requirements.
-	EnsureKubernetesGeneralConfiguration(context.Context, *string) error
+	EnsureKubernetesGeneralConfiguration(context.Context, extensionscontext.GardenContext, *string) error
 	// ShouldProvisionKubeletCloudProviderConfig returns true if the cloud provider config file should be added to the kubelet configuration.
 	ShouldProvisionKubeletCloudProviderConfig() bool
 	// EnsureKubeletCloudProviderConfig ensures that the cloud provider config file content conforms to the provider requirements.
-	EnsureKubeletCloudProviderConfig(context.Context, *string, string) error
+	EnsureKubeletCloudProviderConfig(context.Context, extensionscontext.GardenContext, *string, string) error
 	// EnsureAdditionalUnits ensures additional systemd units
-	EnsureAdditionalUnits(context.Context, *[]extensionsv1alpha1.Unit) error
+	EnsureAdditionalUnits(context.Context, extensionscontext.GardenContext, *[]extensionsv1alpha1.Unit) error
 	// EnsureAdditionalFile ensures additional systemd files
-	EnsureAdditionalFiles(context.Context, *[]extensionsv1alpha1.File) error
+	EnsureAdditionalFiles(context.Context, extensionscontext.GardenContext, *[]extensionsv1alpha1.File) error
 }
 
 // NewMutator creates a new controlplane mutator.
@@ -113,88 +129,95 @@ func (m *mutator) Mutate(ctx context.Context, obj runtime.Object) error {
 		return nil
 	}
 
+	gctx := extensionscontext.NewGardenContext(m.client, obj)
+
 	switch x := obj.(type) {
 	case *corev1.Service:
 		switch x.Name {
 		case v1alpha1constants.DeploymentNameKubeAPIServer:
 			extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
-			return m.ensurer.EnsureKubeAPIServerService(ctx, x)
+			return m.ensurer.EnsureKubeAPIServerService(ctx, gctx, x)
 		}
 	case *appsv1.Deployment:
 		switch x.Name {
 		case v1alpha1constants.DeploymentNameKubeAPIServer:
 			extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
-			return m.ensurer.EnsureKubeAPIServerDeployment(ctx, x)
+			return m.ensurer.EnsureKubeAPIServerDeployment(ctx, gctx, x)
 		case v1alpha1constants.DeploymentNameKubeControllerManager:
 			extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
-			return m.ensurer.EnsureKubeControllerManagerDeployment(ctx, x)
+			return m.ensurer.EnsureKubeControllerManagerDeployment(ctx, gctx, x)
 		case v1alpha1constants.DeploymentNameKubeScheduler:
 			extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
-			return m.ensurer.EnsureKubeSchedulerDeployment(ctx, x)
+			return m.ensurer.EnsureKubeSchedulerDeployment(ctx, gctx, x)
+		case v1alpha1constants.DeploymentNameClusterAutoscaler:
+			extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
+			return m.ensurer.EnsureClusterAutoscalerDeployment(ctx, gctx, x)
+		case DeploymentNameMachineControllerManager:
+			extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
+			return m.ensurer.EnsureMachineControllerManagerDeployment(ctx, gctx, x)
+		case v1alpha1constants.DeploymentNameGardenerResourceManager:
+			extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
+			return m.ensurer.EnsureGardenerResourceManagerDeployment(ctx, gctx, x)
+		default:
+			return m.ensurer.EnsureDeployment(ctx, gctx, x)
 		}
 	case *appsv1.StatefulSet:
 		switch x.Name {
 		case v1alpha1constants.StatefulSetNameETCDMain, v1alpha1constants.StatefulSetNameETCDEvents:
 			extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
-			// Get cluster info
-			cluster, err := extensionscontroller.GetCluster(ctx, m.client, x.Namespace)
-			if err != nil {
-				return errors.Wrapf(err, "could not get cluster for namespace '%s'", x.Namespace)
-			}
-
-			return m.ensurer.EnsureETCDStatefulSet(ctx, x, cluster)
+			return m.ensurer.EnsureETCDStatefulSet(ctx, gctx, x)
 		}
 	case *extensionsv1alpha1.OperatingSystemConfig:
 		if x.Spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
 			extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
-			return m.mutateOperatingSystemConfig(ctx, x)
+			return m.mutateOperatingSystemConfig(ctx, gctx, x)
 		}
 		return nil
 	}
 	return nil
 }
 
-func (m *mutator) mutateOperatingSystemConfig(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+func (m *mutator) mutateOperatingSystemConfig(ctx context.Context, gctx extensionscontext.GardenContext, osc *extensionsv1alpha1.OperatingSystemConfig) error {
 	// Mutate kubelet.service unit, if present
 	if u := extensionswebhook.UnitWithName(osc.Spec.Units, v1alpha1constants.OperatingSystemConfigUnitNameKubeletService); u != nil && u.Content != nil {
-		if err := m.ensureKubeletServiceUnitContent(ctx, u.Content); err != nil {
+		if err := m.ensureKubeletServiceUnitContent(ctx, gctx, u.Content); err != nil {
 			return err
 		}
 	}
 
 	// Mutate kubelet configuration file, if present
 	if f := extensionswebhook.FileWithPath(osc.Spec.Files, v1alpha1constants.OperatingSystemConfigFilePathKubeletConfig); f != nil && f.Content.Inline != nil {
-		if err := m.ensureKubeletConfigFileContent(ctx, f.Content.Inline); err != nil {
+		if err := m.ensureKubeletConfigFileContent(ctx, gctx, f.Content.Inline); err != nil {
 			return err
 		}
 	}
 
 	// Mutate 99 kubernetes general configuration file, if present
 	if f := extensionswebhook.FileWithPath(osc.Spec.Files, v1alpha1constants.OperatingSystemConfigFilePathKernelSettings); f != nil && f.Content.Inline != nil {
-		if err := m.ensureKubernetesGeneralConfiguration(ctx, f.Content.Inline); err != nil {
+		if err := m.ensureKubernetesGeneralConfiguration(ctx, gctx, f.Content.Inline); err != nil {
 			return err
 		}
 	}
 
 	// Check if cloud provider config needs to be ensured
 	if m.ensurer.ShouldProvisionKubeletCloudProviderConfig() {
-		if err := m.ensureKubeletCloudProviderConfig(ctx, osc); err != nil {
+		if err := m.ensureKubeletCloudProviderConfig(ctx, gctx, osc); err != nil {
 			return err
 		}
 	}
 
-	if err := m.ensurer.EnsureAdditionalFiles(ctx, &osc.Spec.Files); err != nil {
+	if err := m.ensurer.EnsureAdditionalFiles(ctx, gctx, &osc.Spec.Files); err != nil {
 		return err
 	}
 
-	if err := m.ensurer.EnsureAdditionalUnits(ctx, &osc.Spec.Units); err != nil {
+	if err := m.ensurer.EnsureAdditionalUnits(ctx, gctx, &osc.Spec.Units); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (m *mutator) ensureKubeletServiceUnitContent(ctx context.Context, content *string) error {
+func (m *mutator) ensureKubeletServiceUnitContent(ctx context.Context, gctx extensionscontext.GardenContext, content *string) error {
 	var opts []*unit.UnitOption
 	var err error
 
@@ -203,7 +226,7 @@ func (m *mutator) ensureKubeletServiceUnitContent(ctx context.Context, content *
 		return errors.Wrap(err, "could not deserialize kubelet.service unit content")
 	}
 
-	if opts, err = m.ensurer.EnsureKubeletServiceUnitOptions(ctx, opts); err != nil {
+	if opts, err = m.ensurer.EnsureKubeletServiceUnitOptions(ctx, gctx, opts); err != nil {
 		return err
 	}
 
@@ -215,7 +238,7 @@ func (m *mutator) ensureKubeletServiceUnitContent(ctx context.Context, content *
 	return nil
 }
 
-func (m *mutator) ensureKubeletConfigFileContent(ctx context.Context, fci *extensionsv1alpha1.FileContentInline) error {
+func (m *mutator) ensureKubeletConfigFileContent(ctx context.Context, gctx extensionscontext.GardenContext, fci *extensionsv1alpha1.FileContentInline) error {
 	var kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration
 	var err error
 
@@ -224,7 +247,7 @@ func (m *mutator) ensureKubeletConfigFileContent(ctx context.Context, fci *exten
 		return errors.Wrap(err, "could not decode kubelet configuration")
 	}
 
-	if err = m.ensurer.EnsureKubeletConfiguration(ctx, kubeletConfig); err != nil {
+	if err = m.ensurer.EnsureKubeletConfiguration(ctx, gctx, kubeletConfig); err != nil {
 		return err
 	}
 
@@ -238,7 +261,7 @@ func (m *mutator) ensureKubeletConfigFileContent(ctx context.Context, fci *exten
 	return nil
 }
 
-func (m *mutator) ensureKubernetesGeneralConfiguration(ctx context.Context, fci *extensionsv1alpha1.FileContentInline) error {
+func (m *mutator) ensureKubernetesGeneralConfiguration(ctx context.Context, gctx extensionscontext.GardenContext, fci *extensionsv1alpha1.FileContentInline) error {
 	var data []byte
 	var err error
 
@@ -248,7 +271,7 @@ func (m *mutator) ensureKubernetesGeneralConfiguration(ctx context.Context, fci
 	}
 
 	s := string(data)
-	if err = m.ensurer.EnsureKubernetesGeneralConfiguration(ctx, &s); err != nil {
+	if err = m.ensurer.EnsureKubernetesGeneralConfiguration(ctx, gctx, &s); err != nil {
 		return err
 	}
 
@@ -264,12 +287,12 @@ func (m *mutator) ensureKubernetesGeneralConfiguration(ctx context.Context, fci
 
 const cloudProviderConfigPath = "/var/lib/kubelet/cloudprovider.conf"
 
-func (m *mutator) ensureKubeletCloudProviderConfig(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+func (m *mutator) ensureKubeletCloudProviderConfig(ctx context.Context, gctx extensionscontext.GardenContext, osc *extensionsv1alpha1.OperatingSystemConfig) error {
 	var err error
 
 	// Ensure kubelet cloud provider config
 	var s string
-	if err = m.ensurer.EnsureKubeletCloudProviderConfig(ctx, &s, osc.Namespace); err != nil {
+	if err = m.ensurer.EnsureKubeletCloudProviderConfig(ctx, gctx, &s, osc.Namespace); err != nil {
 		return err
 	}
 
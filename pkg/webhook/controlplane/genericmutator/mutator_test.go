@@ -25,6 +25,7 @@ import (
 	mockgenericmutator "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/webhook/controlplane/genericmutator"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	extensionscontext "github.com/gardener/gardener-extensions/pkg/webhook/context"
 
 	"github.com/coreos/go-systemd/unit"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
@@ -32,6 +33,7 @@ import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -104,7 +106,7 @@ var _ = Describe("Mutator", func() {
 
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
-			ensurer.EXPECT().EnsureKubeAPIServerService(context.TODO(), svc).Return(nil)
+			ensurer.EXPECT().EnsureKubeAPIServerService(context.TODO(), gomock.Any(), svc).Return(nil)
 
 			// Create mutator
 			mutator := NewMutator(ensurer, nil, nil, nil, logger)
@@ -138,7 +140,7 @@ var _ = Describe("Mutator", func() {
 
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
-			ensurer.EXPECT().EnsureKubeAPIServerDeployment(context.TODO(), dep).Return(nil)
+			ensurer.EXPECT().EnsureKubeAPIServerDeployment(context.TODO(), gomock.Any(), dep).Return(nil)
 
 			// Create mutator
 			mutator := NewMutator(ensurer, nil, nil, nil, logger)
@@ -157,7 +159,7 @@ var _ = Describe("Mutator", func() {
 
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
-			ensurer.EXPECT().EnsureKubeControllerManagerDeployment(context.TODO(), dep).Return(nil)
+			ensurer.EXPECT().EnsureKubeControllerManagerDeployment(context.TODO(), gomock.Any(), dep).Return(nil)
 
 			// Create mutator
 			mutator := NewMutator(ensurer, nil, nil, nil, logger)
@@ -176,7 +178,7 @@ var _ = Describe("Mutator", func() {
 
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
-			ensurer.EXPECT().EnsureKubeSchedulerDeployment(context.TODO(), dep).Return(nil)
+			ensurer.EXPECT().EnsureKubeSchedulerDeployment(context.TODO(), gomock.Any(), dep).Return(nil)
 
 			// Create mutator
 			mutator := NewMutator(ensurer, nil, nil, nil, logger)
@@ -186,20 +188,38 @@ var _ = Describe("Mutator", func() {
 			Expect(err).To(Not(HaveOccurred()))
 		})
 
-		It("should ignore other deployments than kube-apiserver, kube-controller-manager, and kube-scheduler", func() {
-			var (
-				dep = &appsv1.Deployment{
-					ObjectMeta: metav1.ObjectMeta{Name: "test"},
-				}
-			)
+		DescribeTable("should invoke the appropriate ensurer method with other control plane deployments",
+			func(name string, expect func(*mockgenericmutator.MockEnsurer, *appsv1.Deployment)) {
+				var (
+					dep = &appsv1.Deployment{
+						ObjectMeta: metav1.ObjectMeta{Name: name},
+					}
+				)
 
-			// Create mutator
-			mutator := NewMutator(nil, nil, nil, nil, logger)
+				// Create mock ensurer
+				ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
+				expect(ensurer, dep)
 
-			// Call Mutate method and check the result
-			err := mutator.Mutate(context.TODO(), dep)
-			Expect(err).To(Not(HaveOccurred()))
-		})
+				// Create mutator
+				mutator := NewMutator(ensurer, nil, nil, nil, logger)
+
+				// Call Mutate method and check the result
+				err := mutator.Mutate(context.TODO(), dep)
+				Expect(err).To(Not(HaveOccurred()))
+			},
+			Entry("cluster-autoscaler", v1alpha1constants.DeploymentNameClusterAutoscaler, func(e *mockgenericmutator.MockEnsurer, dep *appsv1.Deployment) {
+				e.EXPECT().EnsureClusterAutoscalerDeployment(context.TODO(), gomock.Any(), dep).Return(nil)
+			}),
+			Entry("machine-controller-manager", DeploymentNameMachineControllerManager, func(e *mockgenericmutator.MockEnsurer, dep *appsv1.Deployment) {
+				e.EXPECT().EnsureMachineControllerManagerDeployment(context.TODO(), gomock.Any(), dep).Return(nil)
+			}),
+			Entry("gardener-resource-manager", v1alpha1constants.DeploymentNameGardenerResourceManager, func(e *mockgenericmutator.MockEnsurer, dep *appsv1.Deployment) {
+				e.EXPECT().EnsureGardenerResourceManagerDeployment(context.TODO(), gomock.Any(), dep).Return(nil)
+			}),
+			Entry("any other deployment", "test", func(e *mockgenericmutator.MockEnsurer, dep *appsv1.Deployment) {
+				e.EXPECT().EnsureDeployment(context.TODO(), gomock.Any(), dep).Return(nil)
+			}),
+		)
 
 		It("should invoke ensurer.EnsureETCDStatefulSet with a etcd-main stateful set", func() {
 			var (
@@ -214,7 +234,13 @@ var _ = Describe("Mutator", func() {
 
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
-			ensurer.EXPECT().EnsureETCDStatefulSet(context.TODO(), ss, cluster).Return(nil)
+			ensurer.EXPECT().EnsureETCDStatefulSet(context.TODO(), gomock.Any(), ss).DoAndReturn(
+				func(ctx context.Context, gctx extensionscontext.GardenContext, ss *appsv1.StatefulSet) error {
+					c, err := gctx.GetCluster(ctx)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(c).To(Equal(cluster))
+					return nil
+				})
 
 			// Create mutator
 			mutator := NewMutator(ensurer, nil, nil, nil, logger)
@@ -239,7 +265,13 @@ var _ = Describe("Mutator", func() {
 
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
-			ensurer.EXPECT().EnsureETCDStatefulSet(context.TODO(), ss, cluster).Return(nil)
+			ensurer.EXPECT().EnsureETCDStatefulSet(context.TODO(), gomock.Any(), ss).DoAndReturn(
+				func(ctx context.Context, gctx extensionscontext.GardenContext, ss *appsv1.StatefulSet) error {
+					c, err := gctx.GetCluster(ctx)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(c).To(Equal(cluster))
+					return nil
+				})
 
 			// Create mutator
 			mutator := NewMutator(ensurer, nil, nil, nil, logger)
@@ -331,33 +363,33 @@ var _ = Describe("Mutator", func() {
 
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
-			ensurer.EXPECT().EnsureKubeletServiceUnitOptions(context.TODO(), oldUnitOptions).Return(newUnitOptions, nil)
-			ensurer.EXPECT().EnsureKubeletConfiguration(context.TODO(), oldKubeletConfig).DoAndReturn(
-				func(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
+			ensurer.EXPECT().EnsureKubeletServiceUnitOptions(context.TODO(), gomock.Any(), oldUnitOptions).Return(newUnitOptions, nil)
+			ensurer.EXPECT().EnsureKubeletConfiguration(context.TODO(), gomock.Any(), oldKubeletConfig).DoAndReturn(
+				func(ctx context.Context, gctx extensionscontext.GardenContext, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
 					*kubeletConfig = *newKubeletConfig
 					return nil
 				},
 			)
-			ensurer.EXPECT().EnsureKubernetesGeneralConfiguration(context.TODO(), util.StringPtr(oldKubernetesGeneralConfigData)).DoAndReturn(
-				func(ctx context.Context, data *string) error {
+			ensurer.EXPECT().EnsureKubernetesGeneralConfiguration(context.TODO(), gomock.Any(), util.StringPtr(oldKubernetesGeneralConfigData)).DoAndReturn(
+				func(ctx context.Context, gctx extensionscontext.GardenContext, data *string) error {
 					*data = newKubernetesGeneralConfigData
 					return nil
 				},
 			)
-			ensurer.EXPECT().EnsureAdditionalUnits(context.TODO(), &osc.Spec.Units).DoAndReturn(
-				func(ctx context.Context, oscUnits *[]extensionsv1alpha1.Unit) error {
+			ensurer.EXPECT().EnsureAdditionalUnits(context.TODO(), gomock.Any(), &osc.Spec.Units).DoAndReturn(
+				func(ctx context.Context, gctx extensionscontext.GardenContext, oscUnits *[]extensionsv1alpha1.Unit) error {
 					*oscUnits = append(*oscUnits, additionalUnit)
 					return nil
 				})
-			ensurer.EXPECT().EnsureAdditionalFiles(context.TODO(), &osc.Spec.Files).DoAndReturn(
-				func(ctx context.Context, oscFiles *[]extensionsv1alpha1.File) error {
+			ensurer.EXPECT().EnsureAdditionalFiles(context.TODO(), gomock.Any(), &osc.Spec.Files).DoAndReturn(
+				func(ctx context.Context, gctx extensionscontext.GardenContext, oscFiles *[]extensionsv1alpha1.File) error {
 					*oscFiles = append(*oscFiles, additionalFile)
 					return nil
 				})
 
 			ensurer.EXPECT().ShouldProvisionKubeletCloudProviderConfig().Return(true)
-			ensurer.EXPECT().EnsureKubeletCloudProviderConfig(context.TODO(), util.StringPtr(""), osc.Namespace).DoAndReturn(
-				func(ctx context.Context, data *string, _ string) error {
+			ensurer.EXPECT().EnsureKubeletCloudProviderConfig(context.TODO(), gomock.Any(), util.StringPtr(""), osc.Namespace).DoAndReturn(
+				func(ctx context.Context, gctx extensionscontext.GardenContext, data *string, _ string) error {
 					*data = cloudproviderconf
 					return nil
 				},
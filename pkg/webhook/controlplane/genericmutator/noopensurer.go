@@ -17,7 +17,7 @@ package genericmutator
 import (
 	"context"
 
-	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionscontext "github.com/gardener/gardener-extensions/pkg/webhook/context"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
 	"github.com/coreos/go-systemd/unit"
@@ -30,42 +30,62 @@ import (
 type NoopEnsurer struct{}
 
 // EnsureKubeAPIServerService ensures that the kube-apiserver service conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeAPIServerService(context.Context, *corev1.Service) error {
+func (e *NoopEnsurer) EnsureKubeAPIServerService(context.Context, extensionscontext.GardenContext, *corev1.Service) error {
 	return nil
 }
 
 // EnsureKubeAPIServerDeployment ensures that the kube-apiserver deployment conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeAPIServerDeployment(context.Context, *appsv1.Deployment) error {
+func (e *NoopEnsurer) EnsureKubeAPIServerDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error {
 	return nil
 }
 
 // EnsureKubeControllerManagerDeployment ensures that the kube-controller-manager deployment conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeControllerManagerDeployment(context.Context, *appsv1.Deployment) error {
+func (e *NoopEnsurer) EnsureKubeControllerManagerDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error {
 	return nil
 }
 
 // EnsureKubeSchedulerDeployment ensures that the kube-scheduler deployment conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeSchedulerDeployment(context.Context, *appsv1.Deployment) error {
+func (e *NoopEnsurer) EnsureKubeSchedulerDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error {
+	return nil
+}
+
+// EnsureClusterAutoscalerDeployment ensures that the cluster-autoscaler deployment conforms to the provider requirements.
+func (e *NoopEnsurer) EnsureClusterAutoscalerDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error {
+	return nil
+}
+
+// EnsureMachineControllerManagerDeployment ensures that the machine-controller-manager deployment conforms to the provider requirements.
+func (e *NoopEnsurer) EnsureMachineControllerManagerDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error {
+	return nil
+}
+
+// EnsureGardenerResourceManagerDeployment ensures that the gardener-resource-manager deployment conforms to the provider requirements.
+func (e *NoopEnsurer) EnsureGardenerResourceManagerDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error {
+	return nil
+}
+
+// EnsureDeployment ensures that any other control plane deployment conforms to the provider requirements.
+func (e *NoopEnsurer) EnsureDeployment(context.Context, extensionscontext.GardenContext, *appsv1.Deployment) error {
 	return nil
 }
 
 // EnsureETCDStatefulSet ensures that the etcd stateful sets conform to the provider requirements.
-func (e *NoopEnsurer) EnsureETCDStatefulSet(context.Context, *appsv1.StatefulSet, *extensionscontroller.Cluster) error {
+func (e *NoopEnsurer) EnsureETCDStatefulSet(context.Context, extensionscontext.GardenContext, *appsv1.StatefulSet) error {
 	return nil
 }
 
 // EnsureKubeletServiceUnitOptions ensures that the kubelet.service unit options conform to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeletServiceUnitOptions(_ context.Context, opts []*unit.UnitOption) ([]*unit.UnitOption, error) {
+func (e *NoopEnsurer) EnsureKubeletServiceUnitOptions(_ context.Context, _ extensionscontext.GardenContext, opts []*unit.UnitOption) ([]*unit.UnitOption, error) {
 	return opts, nil
 }
 
 // EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeletConfiguration(context.Context, *kubeletconfigv1beta1.KubeletConfiguration) error {
+func (e *NoopEnsurer) EnsureKubeletConfiguration(context.Context, extensionscontext.GardenContext, *kubeletconfigv1beta1.KubeletConfiguration) error {
 	return nil
 }
 
 // EnsureKubernetesGeneralConfiguration ensures that the kubernetes general configuration conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubernetesGeneralConfiguration(context.Context, *string) error {
+func (e *NoopEnsurer) EnsureKubernetesGeneralConfiguration(context.Context, extensionscontext.GardenContext, *string) error {
 	return nil
 }
 
@@ -75,16 +95,16 @@ func (e *NoopEnsurer) ShouldProvisionKubeletCloudProviderConfig() bool {
 }
 
 // EnsureKubeletCloudProviderConfig ensures that the cloud provider config file conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeletCloudProviderConfig(context.Context, *string, string) error {
+func (e *NoopEnsurer) EnsureKubeletCloudProviderConfig(context.Context, extensionscontext.GardenContext, *string, string) error {
 	return nil
 }
 
 // EnsureAdditionalUnits ensures that additional required system units are added.
-func (e *NoopEnsurer) EnsureAdditionalUnits(ctx context.Context, units *[]extensionsv1alpha1.Unit) error {
+func (e *NoopEnsurer) EnsureAdditionalUnits(ctx context.Context, gctx extensionscontext.GardenContext, units *[]extensionsv1alpha1.Unit) error {
 	return nil
 }
 
 // EnsureAdditionalFiles ensures that additional required system files are added.
-func (e *NoopEnsurer) EnsureAdditionalFiles(ctx context.Context, files *[]extensionsv1alpha1.File) error {
+func (e *NoopEnsurer) EnsureAdditionalFiles(ctx context.Context, gctx extensionscontext.GardenContext, files *[]extensionsv1alpha1.File) error {
 	return nil
 }
@@ -30,6 +30,11 @@ const (
 	TargetSeed = "seed"
 	// TargetShoot defines that the webhook is to be installed in the shoot.
 	TargetShoot = "shoot"
+
+	// ActionMutating defines that the webhook mutates objects. It is registered in a MutatingWebhookConfiguration.
+	ActionMutating = "mutating"
+	// ActionValidating defines that the webhook validates objects. It is registered in a ValidatingWebhookConfiguration.
+	ActionValidating = "validating"
 )
 
 // Webhook is the specification of a webhook.
@@ -39,6 +44,7 @@ type Webhook struct {
 	Provider string
 	Path     string
 	Target   string
+	Action   string
 	Types    []runtime.Object
 	Webhook  *admission.Webhook
 	Handler  http.Handler
@@ -0,0 +1,148 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	"github.com/pkg/errors"
+)
+
+// FlagAction is an action that is applied to a command line flag.
+type FlagAction string
+
+const (
+	// FlagActionEnsure ensures that the flag is present with the given value. An existing value is rewritten.
+	FlagActionEnsure FlagAction = "Ensure"
+	// FlagActionRemove ensures that the flag is not present.
+	FlagActionRemove FlagAction = "Remove"
+	// FlagActionEnsureListItem ensures that the flag is present and that its list value contains the given value.
+	FlagActionEnsureListItem FlagAction = "EnsureListItem"
+	// FlagActionRemoveListItem ensures that the list value of the flag, if present, does not contain the given value.
+	FlagActionRemoveListItem FlagAction = "RemoveListItem"
+
+	// DefaultFlagListSeparator is the separator used for list values if no separator is given.
+	DefaultFlagListSeparator = ","
+)
+
+// VersionedFlag describes how a command line flag is to be managed for the Kubernetes versions that match a
+// version constraint.
+type VersionedFlag struct {
+	// Name is the name of the flag including the leading dashes, e.g. `--cloud-provider`.
+	Name string
+	// Value is the value of the flag or list item. It is ignored for FlagActionRemove.
+	Value string
+	// Action is the action to apply to the flag.
+	Action FlagAction
+	// Separator is the separator of list values. Defaults to DefaultFlagListSeparator.
+	Separator string
+	// VersionConstraint is a semantic version constraint like `>= 1.16` or `< 1.15`. If empty, the flag is
+	// managed for all versions.
+	VersionConstraint string
+}
+
+// EnsureFlag returns a VersionedFlag that ensures the flag with the given name has the given value.
+func EnsureFlag(name, value string) VersionedFlag {
+	return VersionedFlag{Name: name, Value: value, Action: FlagActionEnsure}
+}
+
+// RemoveFlag returns a VersionedFlag that ensures the flag with the given name is not present.
+func RemoveFlag(name string) VersionedFlag {
+	return VersionedFlag{Name: name, Action: FlagActionRemove}
+}
+
+// EnsureFlagListItem returns a VersionedFlag that ensures the list value of the flag with the given name contains
+// the given value.
+func EnsureFlagListItem(name, value string) VersionedFlag {
+	return VersionedFlag{Name: name, Value: value, Action: FlagActionEnsureListItem}
+}
+
+// RemoveFlagListItem returns a VersionedFlag that ensures the list value of the flag with the given name does not
+// contain the given value.
+func RemoveFlagListItem(name, value string) VersionedFlag {
+	return VersionedFlag{Name: name, Value: value, Action: FlagActionRemoveListItem}
+}
+
+// ForVersions returns a copy of the VersionedFlag that is only managed for versions matching the given constraint.
+func (f VersionedFlag) ForVersions(constraint string) VersionedFlag {
+	f.VersionConstraint = constraint
+	return f
+}
+
+// Matches returns true if the given Kubernetes version matches the version constraint of the flag.
+// Pre-release and build metadata of the version are ignored, e.g. `v1.15.2-gke.1` is treated as `1.15.2`.
+func (f VersionedFlag) Matches(version string) (bool, error) {
+	if len(f.VersionConstraint) == 0 {
+		return true, nil
+	}
+
+	matches, err := util.VersionMatchesConstraint(version, f.VersionConstraint)
+	if err != nil {
+		return false, errors.Wrapf(err, "could not check version constraint of flag %s", f.Name)
+	}
+	return matches, nil
+}
+
+// EnsureVersionedFlags applies the given flags to the given command line for the given Kubernetes version. Flags
+// whose version constraint does not match the version are skipped. The flags are applied in the given order, hence
+// a later flag overrides the effect of an earlier flag with the same name.
+func EnsureVersionedFlags(command []string, version string, flags ...VersionedFlag) ([]string, error) {
+	for _, flag := range flags {
+		matches, err := flag.Matches(version)
+		if err != nil {
+			return nil, err
+		}
+		if !matches {
+			continue
+		}
+
+		prefix := flag.Name + "="
+		separator := flag.Separator
+		if len(separator) == 0 {
+			separator = DefaultFlagListSeparator
+		}
+
+		switch flag.Action {
+		case FlagActionEnsure:
+			command = EnsureStringWithPrefix(command, prefix, flag.Value)
+		case FlagActionRemove:
+			command = removeFlag(EnsureNoStringWithPrefix(command, prefix), flag.Name)
+		case FlagActionEnsureListItem:
+			command = EnsureStringWithPrefixContains(command, prefix, flag.Value, separator)
+		case FlagActionRemoveListItem:
+			command = EnsureNoStringWithPrefixContains(command, prefix, flag.Value, separator)
+		default:
+			return nil, fmt.Errorf("unknown action %q for flag %s", flag.Action, flag.Name)
+		}
+	}
+	return command, nil
+}
+
+// removeFlag removes all occurrences of the flag with the given name that are not in the `--flag=value` form from the
+// given command line, i.e. boolean flags like `--flag` and flags in the two-token `--flag value` form. A token following
+// the flag is considered its value unless it is a flag itself.
+func removeFlag(command []string, name string) []string {
+	for i := StringIndex(command, name); i >= 0; i = StringIndex(command, name) {
+		end := i + 1
+		if end < len(command) && !strings.HasPrefix(command[end], "-") {
+			end++
+		}
+		command = append(command[:i], command[end:]...)
+	}
+	return command
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Flags", func() {
+	Describe("#EnsureVersionedFlags", func() {
+		var command []string
+
+		BeforeEach(func() {
+			command = []string{
+				"/hyperkube",
+				"apiserver",
+				"--cloud-provider=foo",
+				"--enable-admission-plugins=Priority,PersistentVolumeLabel",
+				"--deprecated-flag",
+			}
+		})
+
+		It("should add, rewrite and remove flags for all versions", func() {
+			result, err := EnsureVersionedFlags(command, "1.15.2",
+				EnsureFlag("--cloud-provider", "aws"),
+				EnsureFlag("--cloud-config", "/etc/cloudprovider.conf"),
+				RemoveFlag("--deprecated-flag"),
+				RemoveFlagListItem("--enable-admission-plugins", "PersistentVolumeLabel"),
+				EnsureFlagListItem("--enable-admission-plugins", "NodeRestriction"),
+			)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]string{
+				"/hyperkube",
+				"apiserver",
+				"--cloud-provider=aws",
+				"--enable-admission-plugins=Priority,NodeRestriction",
+				"--cloud-config=/etc/cloudprovider.conf",
+			}))
+		})
+
+		It("should only manage flags whose version constraint matches", func() {
+			flags := []VersionedFlag{
+				EnsureFlag("--new-flag", "true").ForVersions(">= 1.16"),
+				RemoveFlag("--deprecated-flag").ForVersions(">= 1.16"),
+			}
+
+			result, err := EnsureVersionedFlags(append([]string{}, command...), "v1.15.3", flags...)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(command))
+
+			result, err = EnsureVersionedFlags(append([]string{}, command...), "v1.16.0-rc.1", flags...)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(ConsistOf("/hyperkube", "apiserver", "--cloud-provider=foo",
+				"--enable-admission-plugins=Priority,PersistentVolumeLabel", "--new-flag=true"))
+		})
+
+		It("should remove flags given in the two-token form together with their value", func() {
+			result, err := EnsureVersionedFlags([]string{
+				"/hyperkube",
+				"apiserver",
+				"--cloud-config", "/etc/cloudprovider.conf",
+				"--deprecated-flag",
+				"--cloud-provider", "foo",
+				"--cloud-config=/etc/other.conf",
+			}, "1.15.2",
+				RemoveFlag("--cloud-config"),
+				RemoveFlag("--deprecated-flag"),
+			)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]string{
+				"/hyperkube",
+				"apiserver",
+				"--cloud-provider", "foo",
+			}))
+		})
+
+		It("should fail for an invalid version", func() {
+			_, err := EnsureVersionedFlags(command, "foo", EnsureFlag("--new-flag", "true").ForVersions(">= 1.16"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
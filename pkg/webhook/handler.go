@@ -19,8 +19,11 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/appscode/jsonpatch"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -51,6 +54,7 @@ type handler struct {
 	typesMap map[metav1.GroupVersionKind]runtime.Object
 	mutator  Mutator
 	decoder  *admission.Decoder
+	audit    AuditOptions
 	logger   logr.Logger
 }
 
@@ -60,6 +64,11 @@ func (h *handler) InjectDecoder(d *admission.Decoder) error {
 	return nil
 }
 
+// InjectAuditOptions injects the given audit options into the handler.
+func (h *handler) InjectAuditOptions(opts AuditOptions) {
+	h.audit = opts
+}
+
 // InjectClient injects the given client into the mutator.
 // TODO Replace this with the more generic InjectFunc when controller runtime supports it
 func (h *handler) InjectClient(client client.Client) error {
@@ -74,12 +83,22 @@ func (h *handler) Handle(ctx context.Context, req admission.Request) admission.R
 	f := func(ctx context.Context, newObj runtime.Object, r *http.Request) error {
 		return h.mutator.Mutate(ctx, newObj)
 	}
-	return handle(ctx, req, nil, f, h.typesMap, h.decoder, h.logger)
+	return handle(ctx, req, nil, f, h.typesMap, h.decoder, h.audit, h.logger)
 }
 
 type mutateFunc func(context.Context, runtime.Object, *http.Request) error
 
-func handle(ctx context.Context, req admission.Request, r *http.Request, f mutateFunc, typesMap map[metav1.GroupVersionKind]runtime.Object, decoder *admission.Decoder, logger logr.Logger) admission.Response {
+func handle(ctx context.Context, req admission.Request, r *http.Request, f mutateFunc, typesMap map[metav1.GroupVersionKind]runtime.Object, decoder *admission.Decoder, audit AuditOptions, logger logr.Logger) admission.Response {
+	ar := req.AdmissionRequest
+
+	resp := mutate(ctx, req, r, f, typesMap, decoder, audit, logger)
+	if !resp.Allowed {
+		recordMutation(audit, ar.Kind.Kind, MutationResultError)
+	}
+	return resp
+}
+
+func mutate(ctx context.Context, req admission.Request, r *http.Request, f mutateFunc, typesMap map[metav1.GroupVersionKind]runtime.Object, decoder *admission.Decoder, audit AuditOptions, logger logr.Logger) admission.Response {
 	ar := req.AdmissionRequest
 
 	// Decode object
@@ -106,20 +125,53 @@ func handle(ctx context.Context, req admission.Request, r *http.Request, f mutat
 			errors.Wrapf(err, "could not mutate %s %s/%s", ar.Kind.Kind, accessor.GetNamespace(), accessor.GetName()))
 	}
 
-	// Return a patch response if the resource should be changed
-	if !equality.Semantic.DeepEqual(obj, newObj) {
-		oldObjMarshaled, err := json.Marshal(obj)
-		if err != nil {
-			return admission.Errored(http.StatusInternalServerError, err)
-		}
-		newObjMarshaled, err := json.Marshal(newObj)
-		if err != nil {
-			return admission.Errored(http.StatusInternalServerError, err)
-		}
+	// Return a validation response if the resource should not be changed
+	if equality.Semantic.DeepEqual(obj, newObj) {
+		recordMutation(audit, ar.Kind.Kind, MutationResultNoop)
+		return admission.ValidationResponse(true, "")
+	}
+
+	// Compute the patch between the original and the mutated resource
+	oldObjMarshaled, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	newObjMarshaled, err := json.Marshal(newObj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	patches, err := jsonpatch.CreatePatch(oldObjMarshaled, newObjMarshaled)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	patchMarshaled, err := json.Marshal(patches)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	recordMutation(audit, ar.Kind.Kind, MutationResultMutated)
+	logger.Info("Mutated resource", "webhook", audit.WebhookName, "kind", ar.Kind.Kind, "namespace", accessor.GetNamespace(),
+		"name", accessor.GetName(), "operation", ar.Operation, "reportOnly", audit.ReportOnly, "patch", string(patchMarshaled))
 
-		return admission.PatchResponseFromRaw(oldObjMarshaled, newObjMarshaled)
+	reason, message := EventReasonMutated, "Webhook %q mutated the object: %s"
+	if audit.ReportOnly {
+		reason, message = EventReasonMutationReported, "Webhook %q would have mutated the object (report-only mode): %s"
+	}
+	if audit.Recorder != nil {
+		audit.Recorder.Eventf(obj, corev1.EventTypeNormal, reason, message, audit.WebhookName, truncatePatch(string(patchMarshaled)))
 	}
 
-	// Return a validation response if the resource should not be changed
-	return admission.ValidationResponse(true, "")
+	// Do not apply the patch in report-only mode
+	if audit.ReportOnly {
+		return admission.ValidationResponse(true, "")
+	}
+
+	// Return a patch response as the resource should be changed
+	return admission.Response{
+		Patches: patches,
+		AdmissionResponse: admissionv1beta1.AdmissionResponse{
+			Allowed:   true,
+			PatchType: func() *admissionv1beta1.PatchType { pt := admissionv1beta1.PatchTypeJSONPatch; return &pt }(),
+		},
+	}
 }
@@ -66,6 +66,7 @@ type handlerShootClient struct {
 	mutator  MutatorWithShootClient
 	client   client.Client
 	decoder  *admission.Decoder
+	audit    AuditOptions
 	logger   logr.Logger
 }
 
@@ -75,6 +76,11 @@ func (h *handlerShootClient) InjectDecoder(d *admission.Decoder) error {
 	return nil
 }
 
+// InjectAuditOptions injects the given audit options into the handler.
+func (h *handlerShootClient) InjectAuditOptions(opts AuditOptions) {
+	h.audit = opts
+}
+
 // InjectClient injects the given client into the mutator.
 // TODO Replace this with the more generic InjectFunc when controller runtime supports it
 func (h *handlerShootClient) InjectClient(client client.Client) error {
@@ -121,7 +127,7 @@ func (h *handlerShootClient) HandleWithRequest(ctx context.Context, req admissio
 		return h.mutator.Mutate(ctx, newObj, shootClient)
 	}
 
-	return handle(ctx, req, r, f, h.typesMap, h.decoder, h.logger)
+	return handle(ctx, req, r, f, h.typesMap, h.decoder, h.audit, h.logger)
 }
 
 // ServeHTTP is a handler for serving an HTTP endpoint that is used for shoot webhooks.
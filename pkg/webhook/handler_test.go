@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
@@ -142,6 +143,41 @@ var _ = Describe("Handler", func() {
 			}))
 		})
 
+		It("should record an event and not apply the patch in report-only mode", func() {
+			// Create mock mutator
+			mutator := mockwebhook.NewMockMutator(ctrl)
+			mutator.EXPECT().Mutate(context.TODO(), svc).DoAndReturn(func(ctx context.Context, obj runtime.Object) error {
+				accessor, _ := meta.Accessor(obj)
+				accessor.SetAnnotations(map[string]string{"foo": "bar"})
+				return nil
+			})
+
+			// Create handler
+			h, err := NewHandler(mgr, objTypes, mutator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			err = h.InjectDecoder(decoder)
+			Expect(err).NotTo(HaveOccurred())
+
+			recorder := record.NewFakeRecorder(1)
+			Expect(InjectAuditOptions(&Webhook{Webhook: &admission.Webhook{Handler: h}}, AuditOptions{
+				WebhookName: "test",
+				ReportOnly:  true,
+				Recorder:    recorder,
+			})).To(BeTrue())
+
+			// Call Handle and check response
+			resp := h.Handle(context.TODO(), req)
+			Expect(resp).To(Equal(admission.Response{
+				AdmissionResponse: admissionv1beta1.AdmissionResponse{
+					Allowed: true,
+					Result: &metav1.Status{
+						Code: 200,
+					},
+				},
+			}))
+			Expect(recorder.Events).To(Receive(Equal(`Normal WebhookMutationReported Webhook "test" would have mutated the object (report-only mode): [{"op":"add","path":"/metadata/annotations","value":{"foo":"bar"}}]`)))
+		})
+
 		It("should return an error response if the mutator returned an error", func() {
 			// Create mock mutator
 			mutator := mockwebhook.NewMockMutator(ctrl)
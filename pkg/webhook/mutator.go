@@ -32,3 +32,9 @@ type MutatorWithShootClient interface {
 	// Mutate validates and if needed mutates the given object.
 	Mutate(ctx context.Context, obj runtime.Object, shootClient client.Client) error
 }
+
+// Validator validates objects.
+type Validator interface {
+	// Validate validates the given new object. The old object is only set for updates, it is nil otherwise.
+	Validate(ctx context.Context, new, old runtime.Object) error
+}
@@ -29,11 +29,15 @@ import (
 )
 
 // RegisterWebhooks registers the given webhooks in the Kubernetes cluster targeted by the provided manager.
+// Mutating seed webhooks are registered in a MutatingWebhookConfiguration, validating seed webhooks in a
+// ValidatingWebhookConfiguration. The returned webhooks are the mutating ones for the seed and the shoot.
 func RegisterWebhooks(ctx context.Context, mgr manager.Manager, namespace, providerName string, port int, mode, url string, caBundle []byte, webhooks []*Webhook) (webhooksToRegisterSeed []admissionregistrationv1beta1.Webhook, webhooksToRegisterShoot []admissionregistrationv1beta1.Webhook, err error) {
 	var (
-		fail                             = admissionregistrationv1beta1.Fail
-		ignore                           = admissionregistrationv1beta1.Ignore
-		mutatingWebhookConfigurationSeed = &admissionregistrationv1beta1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-" + providerName}}
+		fail                               = admissionregistrationv1beta1.Fail
+		ignore                             = admissionregistrationv1beta1.Ignore
+		mutatingWebhookConfigurationSeed   = &admissionregistrationv1beta1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-" + providerName}}
+		validatingWebhookConfigurationSeed = &admissionregistrationv1beta1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-" + providerName}}
+		validatingWebhooksToRegisterSeed   []admissionregistrationv1beta1.Webhook
 	)
 
 	for _, webhook := range webhooks {
@@ -56,8 +60,15 @@ func RegisterWebhooks(ctx context.Context, mgr manager.Manager, namespace, provi
 		case TargetSeed:
 			webhookToRegister.FailurePolicy = &fail
 			webhookToRegister.ClientConfig = buildClientConfigFor(webhook, namespace, providerName, port, mode, url, caBundle)
-			webhooksToRegisterSeed = append(webhooksToRegisterSeed, webhookToRegister)
+			if webhook.Action == ActionValidating {
+				validatingWebhooksToRegisterSeed = append(validatingWebhooksToRegisterSeed, webhookToRegister)
+			} else {
+				webhooksToRegisterSeed = append(webhooksToRegisterSeed, webhookToRegister)
+			}
 		case TargetShoot:
+			if webhook.Action == ActionValidating {
+				return nil, nil, fmt.Errorf("validating webhooks are not supported for target %s", webhook.Target)
+			}
 			webhookToRegister.FailurePolicy = &ignore
 			webhookToRegister.ClientConfig = buildClientConfigFor(webhook, namespace, providerName, port, ModeURLWithServiceName, url, caBundle)
 			webhooksToRegisterShoot = append(webhooksToRegisterShoot, webhookToRegister)
@@ -66,17 +77,28 @@ func RegisterWebhooks(ctx context.Context, mgr manager.Manager, namespace, provi
 		}
 	}
 
-	if len(webhooksToRegisterSeed) > 0 {
+	if len(webhooksToRegisterSeed) > 0 || len(validatingWebhooksToRegisterSeed) > 0 {
 		c, err := getClient(mgr)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		if _, err := controllerutil.CreateOrUpdate(ctx, c, mutatingWebhookConfigurationSeed, func() error {
-			mutatingWebhookConfigurationSeed.Webhooks = webhooksToRegisterSeed
-			return nil
-		}); err != nil {
-			return nil, nil, err
+		if len(webhooksToRegisterSeed) > 0 {
+			if _, err := controllerutil.CreateOrUpdate(ctx, c, mutatingWebhookConfigurationSeed, func() error {
+				mutatingWebhookConfigurationSeed.Webhooks = webhooksToRegisterSeed
+				return nil
+			}); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if len(validatingWebhooksToRegisterSeed) > 0 {
+			if _, err := controllerutil.CreateOrUpdate(ctx, c, validatingWebhookConfigurationSeed, func() error {
+				validatingWebhookConfigurationSeed.Webhooks = validatingWebhooksToRegisterSeed
+				return nil
+			}); err != nil {
+				return nil, nil, err
+			}
 		}
 	}
 
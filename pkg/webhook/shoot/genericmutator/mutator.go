@@ -0,0 +1,93 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericmutator
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+// Ensurer ensures that various standard objects in the shoot cluster conform to the provider requirements.
+// If they don't initially, they are mutated accordingly.
+type Ensurer interface {
+	// EnsurePod ensures that the given pod conforms to the provider requirements.
+	EnsurePod(context.Context, *corev1.Pod) error
+	// EnsureService ensures that the given service conforms to the provider requirements.
+	EnsureService(context.Context, *corev1.Service) error
+	// EnsureStorageClass ensures that the given storage class conforms to the provider requirements.
+	EnsureStorageClass(context.Context, *storagev1.StorageClass) error
+	// EnsureNode ensures that the given node conforms to the provider requirements.
+	EnsureNode(context.Context, *corev1.Node) error
+}
+
+// NewMutator creates a new shoot mutator that dispatches the objects to the given ensurer by their type.
+func NewMutator(ensurer Ensurer, logger logr.Logger) extensionswebhook.Mutator {
+	return &mutator{
+		ensurer: ensurer,
+		logger:  logger.WithName("mutator"),
+	}
+}
+
+type mutator struct {
+	ensurer Ensurer
+	logger  logr.Logger
+}
+
+// InjectClient injects the given client into the ensurer.
+// TODO Replace this with the more generic InjectFunc when controller runtime supports it
+func (m *mutator) InjectClient(client client.Client) error {
+	if _, err := inject.ClientInto(client, m.ensurer); err != nil {
+		return errors.Wrap(err, "could not inject the client into the ensurer")
+	}
+	return nil
+}
+
+// Mutate validates and if needed mutates the given object.
+func (m *mutator) Mutate(ctx context.Context, obj runtime.Object) error {
+	acc, err := meta.Accessor(obj)
+	if err != nil {
+		return errors.Wrapf(err, "could not create accessor during webhook")
+	}
+	// If the object does have a deletion timestamp then we don't want to mutate anything.
+	if acc.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	switch x := obj.(type) {
+	case *corev1.Pod:
+		extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
+		return m.ensurer.EnsurePod(ctx, x)
+	case *corev1.Service:
+		extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
+		return m.ensurer.EnsureService(ctx, x)
+	case *storagev1.StorageClass:
+		extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
+		return m.ensurer.EnsureStorageClass(ctx, x)
+	case *corev1.Node:
+		extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
+		return m.ensurer.EnsureNode(ctx, x)
+	}
+	return nil
+}
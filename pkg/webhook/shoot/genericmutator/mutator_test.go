@@ -0,0 +1,108 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericmutator
+
+import (
+	"context"
+	"testing"
+
+	mockgenericmutator "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/webhook/shoot/genericmutator"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+func TestShoot(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Shoot Webhook Generic Mutator Suite")
+}
+
+var _ = Describe("Mutator", func() {
+	var (
+		ctrl   *gomock.Controller
+		logger = log.Log.WithName("test")
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#Mutate", func() {
+		DescribeTable("should invoke the appropriate ensurer method",
+			func(obj runtime.Object, expect func(*mockgenericmutator.MockEnsurer, runtime.Object)) {
+				// Create mock ensurer
+				ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
+				expect(ensurer, obj)
+
+				// Create mutator
+				mutator := NewMutator(ensurer, logger)
+
+				// Call Mutate method and check the result
+				err := mutator.Mutate(context.TODO(), obj)
+				Expect(err).To(Not(HaveOccurred()))
+			},
+			Entry("pod", &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test"}}, func(e *mockgenericmutator.MockEnsurer, obj runtime.Object) {
+				e.EXPECT().EnsurePod(context.TODO(), obj).Return(nil)
+			}),
+			Entry("service", &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "test"}}, func(e *mockgenericmutator.MockEnsurer, obj runtime.Object) {
+				e.EXPECT().EnsureService(context.TODO(), obj).Return(nil)
+			}),
+			Entry("storage class", &storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "test"}}, func(e *mockgenericmutator.MockEnsurer, obj runtime.Object) {
+				e.EXPECT().EnsureStorageClass(context.TODO(), obj).Return(nil)
+			}),
+			Entry("node", &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test"}}, func(e *mockgenericmutator.MockEnsurer, obj runtime.Object) {
+				e.EXPECT().EnsureNode(context.TODO(), obj).Return(nil)
+			}),
+		)
+
+		It("should not mutate objects with a deletion timestamp", func() {
+			var (
+				now = metav1.Now()
+				svc = &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "test", DeletionTimestamp: &now}}
+			)
+
+			// Create mutator
+			mutator := NewMutator(mockgenericmutator.NewMockEnsurer(ctrl), logger)
+
+			// Call Mutate method and check the result
+			err := mutator.Mutate(context.TODO(), svc)
+			Expect(err).To(Not(HaveOccurred()))
+		})
+
+		It("should ignore other objects", func() {
+			var (
+				cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
+			)
+
+			// Create mutator
+			mutator := NewMutator(mockgenericmutator.NewMockEnsurer(ctrl), logger)
+
+			// Call Mutate method and check the result
+			err := mutator.Mutate(context.TODO(), cm)
+			Expect(err).To(Not(HaveOccurred()))
+		})
+	})
+})
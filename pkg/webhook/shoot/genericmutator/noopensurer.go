@@ -0,0 +1,45 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericmutator
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+// NoopEnsurer provides no-op implementation of Ensurer. This can be anonymously composed by actual Ensurers for convenience.
+type NoopEnsurer struct{}
+
+// EnsurePod ensures that the given pod conforms to the provider requirements.
+func (e *NoopEnsurer) EnsurePod(context.Context, *corev1.Pod) error {
+	return nil
+}
+
+// EnsureService ensures that the given service conforms to the provider requirements.
+func (e *NoopEnsurer) EnsureService(context.Context, *corev1.Service) error {
+	return nil
+}
+
+// EnsureStorageClass ensures that the given storage class conforms to the provider requirements.
+func (e *NoopEnsurer) EnsureStorageClass(context.Context, *storagev1.StorageClass) error {
+	return nil
+}
+
+// EnsureNode ensures that the given node conforms to the provider requirements.
+func (e *NoopEnsurer) EnsureNode(context.Context, *corev1.Node) error {
+	return nil
+}
@@ -16,9 +16,11 @@ package shoot
 
 import (
 	"context"
+	"fmt"
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -79,3 +81,43 @@ func EnsureNetworkPolicy(ctx context.Context, c client.Client, namespace, provid
 	})
 	return err
 }
+
+// CheckNetworkPolicy checks whether the kube-apiserver running in the given namespace is allowed to reach the extension
+// webhook server on the given port, i.e. whether the network policy created by EnsureNetworkPolicy allows the egress
+// traffic to the port and selects at least one kube-apiserver pod.
+func CheckNetworkPolicy(ctx context.Context, c client.Client, namespace, providerName string, port int) error {
+	networkPolicy := GetNetworkPolicyMeta(namespace, providerName)
+	if err := c.Get(ctx, kutil.Key(namespace, networkPolicy.Name), networkPolicy); err != nil {
+		return errors.Wrapf(err, "could not get network policy '%s/%s'", namespace, networkPolicy.Name)
+	}
+
+	if !allowsEgressToPort(networkPolicy, port) {
+		return fmt.Errorf("network policy '%s/%s' does not allow egress traffic to port %d", namespace, networkPolicy.Name, port)
+	}
+
+	podList := &corev1.PodList{}
+	if err := c.List(ctx, podList, client.InNamespace(namespace), client.MatchingLabels(networkPolicy.Spec.PodSelector.MatchLabels)); err != nil {
+		return errors.Wrapf(err, "could not list pods selected by network policy '%s/%s'", namespace, networkPolicy.Name)
+	}
+	if len(podList.Items) == 0 {
+		return fmt.Errorf("network policy '%s/%s' does not select any kube-apiserver pod", namespace, networkPolicy.Name)
+	}
+
+	return nil
+}
+
+func allowsEgressToPort(networkPolicy *networkingv1.NetworkPolicy, port int) bool {
+	for _, rule := range networkPolicy.Spec.Egress {
+		// A rule without ports allows all ports.
+		if len(rule.Ports) == 0 {
+			return true
+		}
+		for _, policyPort := range rule.Ports {
+			if (policyPort.Protocol == nil || *policyPort.Protocol == corev1.ProtocolTCP) &&
+				(policyPort.Port == nil || policyPort.Port.IntValue() == port) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("NetworkPolicy", func() {
+	const (
+		namespace    = "shoot--foo--bar"
+		providerName = "provider-test"
+		port         = 443
+	)
+
+	var (
+		ctx = context.TODO()
+		c   client.Client
+
+		kubeAPIServerPod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "kube-apiserver",
+				Labels: map[string]string{
+					v1alpha1constants.LabelApp:  v1alpha1constants.LabelKubernetes,
+					v1alpha1constants.LabelRole: v1alpha1constants.LabelAPIServer,
+				},
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		c = fake.NewFakeClient(kubeAPIServerPod.DeepCopy())
+	})
+
+	Describe("#CheckNetworkPolicy", func() {
+		It("should succeed if the network policy allows the kube-apiserver to reach the webhook server", func() {
+			Expect(EnsureNetworkPolicy(ctx, c, namespace, providerName, port)).To(Succeed())
+
+			Expect(CheckNetworkPolicy(ctx, c, namespace, providerName, port)).To(Succeed())
+		})
+
+		It("should fail if the network policy does not exist", func() {
+			Expect(CheckNetworkPolicy(ctx, c, namespace, providerName, port)).NotTo(Succeed())
+		})
+
+		It("should fail if the network policy does not allow the webhook server port", func() {
+			Expect(EnsureNetworkPolicy(ctx, c, namespace, providerName, 8443)).To(Succeed())
+
+			Expect(CheckNetworkPolicy(ctx, c, namespace, providerName, port)).NotTo(Succeed())
+		})
+
+		It("should fail if the network policy does not select any kube-apiserver pod", func() {
+			Expect(c.Delete(ctx, kubeAPIServerPod.DeepCopy())).To(Succeed())
+			Expect(EnsureNetworkPolicy(ctx, c, namespace, providerName, port)).To(Succeed())
+
+			Expect(CheckNetworkPolicy(ctx, c, namespace, providerName, port)).NotTo(Succeed())
+		})
+	})
+
+	Describe("#allowsEgressToPort", func() {
+		It("should allow all ports if a rule has no ports", func() {
+			Expect(allowsEgressToPort(&networkingv1.NetworkPolicy{Spec: networkingv1.NetworkPolicySpec{
+				Egress: []networkingv1.NetworkPolicyEgressRule{{}},
+			}}, port)).To(BeTrue())
+		})
+
+		It("should not allow other protocols", func() {
+			udp, policyPort := corev1.ProtocolUDP, intstr.FromInt(port)
+			Expect(allowsEgressToPort(&networkingv1.NetworkPolicy{Spec: networkingv1.NetworkPolicySpec{
+				Egress: []networkingv1.NetworkPolicyEgressRule{{Ports: []networkingv1.NetworkPolicyPort{{Protocol: &udp, Port: &policyPort}}}},
+			}}, port)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,78 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	"github.com/gardener/gardener-extensions/pkg/webhook/shoot"
+	"github.com/gardener/gardener-extensions/pkg/webhook/shoot/genericmutator"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// WebhookName is the name of the shoot service webhook.
+	WebhookName = "shoot-service"
+)
+
+// Add creates a new shoot service webhook that adds the given default annotations to LoadBalancer services and adds
+// it to the given Manager.
+func Add(mgr manager.Manager, defaultAnnotations map[string]string, logger logr.Logger) (*extensionswebhook.Webhook, error) {
+	logger.Info("Adding webhook to manager")
+	return shoot.Add(mgr, shoot.AddArgs{
+		Name:    WebhookName,
+		Kind:    shoot.KindAll,
+		Types:   []runtime.Object{&corev1.Service{}},
+		Mutator: genericmutator.NewMutator(NewEnsurer(defaultAnnotations, logger), logger),
+	})
+}
+
+// NewEnsurer creates a new shoot service ensurer that adds the given default annotations to LoadBalancer services.
+func NewEnsurer(defaultAnnotations map[string]string, logger logr.Logger) genericmutator.Ensurer {
+	return &ensurer{
+		defaultAnnotations: defaultAnnotations,
+		logger:             logger.WithName("shoot-service-ensurer"),
+	}
+}
+
+type ensurer struct {
+	genericmutator.NoopEnsurer
+	defaultAnnotations map[string]string
+	logger             logr.Logger
+}
+
+// EnsureService ensures that LoadBalancer services carry the default annotations. Annotations that were set by the
+// user are kept.
+func (e *ensurer) EnsureService(ctx context.Context, svc *corev1.Service) error {
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+
+	for key, value := range e.defaultAnnotations {
+		if _, ok := svc.Annotations[key]; ok {
+			continue
+		}
+		if svc.Annotations == nil {
+			svc.Annotations = make(map[string]string)
+		}
+		svc.Annotations[key] = value
+	}
+	return nil
+}
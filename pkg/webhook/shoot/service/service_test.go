@@ -0,0 +1,60 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service_test
+
+import (
+	"context"
+
+	. "github.com/gardener/gardener-extensions/pkg/webhook/shoot/service"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var _ = Describe("Ensurer", func() {
+	const annotation = "service.beta.kubernetes.io/test-load-balancer-idle-timeout"
+
+	DescribeTable("#EnsureService",
+		func(svc *corev1.Service, expectedAnnotations map[string]string) {
+			ensurer := NewEnsurer(map[string]string{annotation: "3600"}, log.Log.WithName("test"))
+
+			err := ensurer.EnsureService(context.TODO(), svc)
+
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(svc.Annotations).To(Equal(expectedAnnotations))
+		},
+
+		Entry("load balancer without annotations",
+			&corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}},
+			map[string]string{annotation: "3600"},
+		),
+		Entry("load balancer with user-defined annotation",
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{annotation: "60"}}, Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}},
+			map[string]string{annotation: "60"},
+		),
+		Entry("load balancer with other annotations",
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"foo": "bar"}}, Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}},
+			map[string]string{"foo": "bar", annotation: "3600"},
+		),
+		Entry("cluster ip service",
+			&corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP}},
+			nil,
+		),
+	)
+})
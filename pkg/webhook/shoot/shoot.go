@@ -32,12 +32,18 @@ const (
 	WebhookName = "shoot"
 	// KindSystem is used for webhooks which should only apply to the to the kube-system namespace.
 	KindSystem = "system"
+	// KindAll is used for webhooks which should apply to all namespaces and to cluster-scoped objects.
+	KindAll = "all"
 )
 
 var logger = log.Log.WithName("shoot-webhook")
 
 // AddArgs are arguments for adding a shoot webhook to a manager.
 type AddArgs struct {
+	// Name is the name of the webhook. It is also used as path. Defaults to WebhookName.
+	Name string
+	// Kind is the kind of the webhook, i.e. KindSystem or KindAll. Defaults to KindSystem.
+	Kind string
 	// Types is a list of resource types.
 	Types []runtime.Object
 	// Mutator is a mutator to be used by the admission handler. It doesn't need the shoot client.
@@ -48,18 +54,22 @@ type AddArgs struct {
 
 // Add creates a new shoot webhook and adds it to the given Manager.
 func Add(mgr manager.Manager, args AddArgs) (*extensionswebhook.Webhook, error) {
-	logger.Info("Creating webhook", "name", WebhookName)
+	name := args.Name
+	if len(name) == 0 {
+		name = WebhookName
+	}
+	logger.Info("Creating webhook", "name", name)
 
-	// Build namespace selector from the webhook kind and provider
-	namespaceSelector, err := buildSelector()
+	// Build namespace selector from the webhook kind
+	namespaceSelector, err := buildSelector(args.Kind)
 	if err != nil {
 		return nil, err
 	}
 
 	wh := &extensionswebhook.Webhook{
-		Name:     WebhookName,
+		Name:     name,
 		Types:    args.Types,
-		Path:     WebhookName,
+		Path:     name,
 		Target:   extensionswebhook.TargetShoot,
 		Selector: namespaceSelector,
 	}
@@ -95,12 +105,18 @@ func Add(mgr manager.Manager, args AddArgs) (*extensionswebhook.Webhook, error)
 	return nil, fmt.Errorf("neither mutator nor mutator with shoot client is set")
 }
 
-// buildSelector creates and returns a LabelSelector for the given webhook kind and provider.
-func buildSelector() (*metav1.LabelSelector, error) {
-	// Create and return LabelSelector
-	return &metav1.LabelSelector{
-		MatchExpressions: []metav1.LabelSelectorRequirement{
-			{Key: v1alpha1constants.GardenerPurpose, Operator: metav1.LabelSelectorOpIn, Values: []string{metav1.NamespaceSystem}},
-		},
-	}, nil
+// buildSelector creates and returns a LabelSelector for the given webhook kind. A nil selector matches all namespaces.
+func buildSelector(kind string) (*metav1.LabelSelector, error) {
+	switch kind {
+	case "", KindSystem:
+		return &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: v1alpha1constants.GardenerPurpose, Operator: metav1.LabelSelectorOpIn, Values: []string{metav1.NamespaceSystem}},
+			},
+		}, nil
+	case KindAll:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("invalid webhook kind '%s'", kind)
+	}
 }
@@ -0,0 +1,41 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Shoot", func() {
+	Describe("#buildSelector", func() {
+		It("should select the kube-system namespace by default", func() {
+			selector, err := buildSelector("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector).NotTo(BeNil())
+		})
+
+		It("should select all namespaces for kind all", func() {
+			selector, err := buildSelector(KindAll)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector).To(BeNil())
+		})
+
+		It("should fail for an unknown kind", func() {
+			_, err := buildSelector("foo")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
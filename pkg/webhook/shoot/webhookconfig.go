@@ -0,0 +1,213 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	"github.com/gardener/gardener-resource-manager/pkg/manager"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/pkg/errors"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// WebhookConfigKey is the key of the MutatingWebhookConfiguration in the secret of the managed resource.
+	WebhookConfigKey = "mutatingwebhookconfiguration.yaml"
+
+	// DefaultEndpointTimeout is the default timeout for connecting to a shoot webhook endpoint.
+	DefaultEndpointTimeout = 5 * time.Second
+)
+
+// ReconcileWebhookConfig ensures that the given shoot webhooks are deployed into the shoot via a managed resource
+// with the given name, and that the kube-apiserver running in the given namespace is allowed to reach the extension
+// webhook server on the given port.
+func ReconcileWebhookConfig(ctx context.Context, c client.Client, namespace, providerName, managedResourceName string, port int, webhooks []admissionregistrationv1beta1.Webhook) error {
+	if err := EnsureNetworkPolicy(ctx, c, namespace, providerName, port); err != nil {
+		return errors.Wrapf(err, "could not create or update network policy for shoot webhooks in namespace '%s'", namespace)
+	}
+
+	webhookConfiguration, err := MarshalWebhooks(webhooks, providerName)
+	if err != nil {
+		return err
+	}
+
+	if err := manager.
+		NewSecret(c).
+		WithNamespacedName(namespace, managedResourceName).
+		WithKeyValues(map[string][]byte{WebhookConfigKey: webhookConfiguration}).
+		Reconcile(ctx); err != nil {
+		return errors.Wrapf(err, "could not create or update secret '%s/%s' of managed resource containing shoot webhooks", namespace, managedResourceName)
+	}
+
+	if err := manager.
+		NewManagedResource(c).
+		WithNamespacedName(namespace, managedResourceName).
+		WithSecretRef(managedResourceName).
+		Reconcile(ctx); err != nil {
+		return errors.Wrapf(err, "could not create or update managed resource '%s/%s' containing shoot webhooks", namespace, managedResourceName)
+	}
+
+	return nil
+}
+
+// DeleteWebhookConfig deletes the network policy and the managed resource with the given name that were created by
+// ReconcileWebhookConfig, and waits until the managed resource is gone.
+func DeleteWebhookConfig(ctx context.Context, c client.Client, namespace, providerName, managedResourceName string) error {
+	networkPolicy := GetNetworkPolicyMeta(namespace, providerName)
+	if err := c.Delete(ctx, networkPolicy); client.IgnoreNotFound(err) != nil {
+		return errors.Wrapf(err, "could not delete network policy for shoot webhooks in namespace '%s'", namespace)
+	}
+
+	if err := extensionscontroller.DeleteManagedResource(ctx, c, namespace, managedResourceName); err != nil {
+		return errors.Wrapf(err, "could not delete managed resource '%s/%s' containing shoot webhooks", namespace, managedResourceName)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	if err := extensionscontroller.WaitUntilManagedResourceDeleted(timeoutCtx, c, namespace, managedResourceName); err != nil {
+		return errors.Wrapf(err, "error while waiting for managed resource '%s/%s' containing shoot webhooks to be deleted", namespace, managedResourceName)
+	}
+
+	return nil
+}
+
+// TriggerWebhookConfigReconciliation annotates all controlplanes in namespaces to which the shoot webhooks of the
+// provider with the given name were deployed with the reconcile operation annotation. This way, the shoot webhooks
+// are deployed again by ReconcileWebhookConfig, e.g. after the CA bundle of the webhook server was rotated.
+// Controlplanes that already carry an operation annotation are skipped.
+func TriggerWebhookConfigReconciliation(ctx context.Context, c client.Client, providerName string) error {
+	controlPlaneList := &extensionsv1alpha1.ControlPlaneList{}
+	if err := c.List(ctx, controlPlaneList); err != nil {
+		return errors.Wrapf(err, "could not list controlplanes")
+	}
+
+	for _, cp := range controlPlaneList.Items {
+		if cp.Spec.Purpose != nil && *cp.Spec.Purpose == extensionsv1alpha1.Exposure {
+			continue
+		}
+		// Don't overwrite pending operations like migrate or restore, they would be lost otherwise.
+		if _, ok := cp.Annotations[v1alpha1constants.GardenerOperation]; ok {
+			continue
+		}
+
+		networkPolicy := GetNetworkPolicyMeta(cp.Namespace, providerName)
+		if err := c.Get(ctx, client.ObjectKey{Namespace: networkPolicy.Namespace, Name: networkPolicy.Name}, networkPolicy); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return errors.Wrapf(err, "could not get network policy '%s/%s'", networkPolicy.Namespace, networkPolicy.Name)
+		}
+
+		controlPlane := cp.DeepCopy()
+		withoutOpAnnotation := controlPlane.DeepCopy()
+		metav1.SetMetaDataAnnotation(&controlPlane.ObjectMeta, v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationReconcile)
+		if err := c.Patch(ctx, controlPlane, client.MergeFrom(withoutOpAnnotation)); client.IgnoreNotFound(err) != nil {
+			return errors.Wrapf(err, "could not annotate controlplane '%s/%s'", controlPlane.Namespace, controlPlane.Name)
+		}
+	}
+
+	return nil
+}
+
+// MarshalWebhooks marshals the given webhooks into a MutatingWebhookConfiguration for the provider with the given name.
+func MarshalWebhooks(webhooks []admissionregistrationv1beta1.Webhook, name string) ([]byte, error) {
+	var (
+		buf     = new(bytes.Buffer)
+		encoder = json.NewYAMLSerializer(json.DefaultMetaFactory, nil, nil)
+
+		apiVersion, kind             = admissionregistrationv1beta1.SchemeGroupVersion.WithKind("MutatingWebhookConfiguration").ToAPIVersionAndKind()
+		mutatingWebhookConfiguration = admissionregistrationv1beta1.MutatingWebhookConfiguration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: apiVersion,
+				Kind:       kind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("gardener-extension-%s-shoot", name),
+			},
+			Webhooks: webhooks,
+		}
+	)
+
+	if err := encoder.Encode(&mutatingWebhookConfiguration, buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// DialContextFunc connects to the given address on the named network.
+type DialContextFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// NewEndpointChecker creates a function that checks whether the endpoints of the given shoot webhooks are
+// reachable with the given dial function, using the given timeout per endpoint.
+func NewEndpointChecker(dial DialContextFunc, timeout time.Duration) func(context.Context, []admissionregistrationv1beta1.Webhook) error {
+	return func(ctx context.Context, webhooks []admissionregistrationv1beta1.Webhook) error {
+		checked := make(map[string]bool)
+		for _, webhook := range webhooks {
+			address, err := endpointAddress(webhook.ClientConfig)
+			if err != nil {
+				return errors.Wrapf(err, "could not determine endpoint of webhook '%s'", webhook.Name)
+			}
+			if checked[address] {
+				continue
+			}
+			checked[address] = true
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+			conn, err := dial(timeoutCtx, "tcp", address)
+			cancel()
+			if err != nil {
+				return errors.Wrapf(err, "endpoint '%s' of webhook '%s' is unreachable", address, webhook.Name)
+			}
+			_ = conn.Close()
+		}
+		return nil
+	}
+}
+
+// CheckWebhookEndpoints checks whether the endpoints of the given shoot webhooks are reachable from the extension.
+// It only checks TCP connectivity, whether the kube-apiserver of the shoot may reach them is checked by
+// CheckNetworkPolicy.
+var CheckWebhookEndpoints = NewEndpointChecker((&net.Dialer{}).DialContext, DefaultEndpointTimeout)
+
+func endpointAddress(clientConfig admissionregistrationv1beta1.WebhookClientConfig) (string, error) {
+	if clientConfig.URL != nil {
+		u, err := url.Parse(*clientConfig.URL)
+		if err != nil {
+			return "", err
+		}
+		if len(u.Port()) == 0 {
+			return net.JoinHostPort(u.Hostname(), "443"), nil
+		}
+		return u.Host, nil
+	}
+	if clientConfig.Service != nil {
+		return net.JoinHostPort(fmt.Sprintf("%s.%s", clientConfig.Service.Name, clientConfig.Service.Namespace), "443"), nil
+	}
+	return "", fmt.Errorf("neither url nor service is set")
+}
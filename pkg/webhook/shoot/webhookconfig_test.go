@@ -0,0 +1,148 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("WebhookConfig", func() {
+	Describe("#NewEndpointChecker", func() {
+		var (
+			urlA = "https://gardener-extension-provider-test.extension:443/shoot"
+			urlB = "https://gardener-extension-provider-test.extension/shoot-service"
+			urlC = "https://other.extension:8443/shoot"
+
+			webhooks = []admissionregistrationv1beta1.Webhook{
+				{Name: "a", ClientConfig: admissionregistrationv1beta1.WebhookClientConfig{URL: &urlA}},
+				{Name: "b", ClientConfig: admissionregistrationv1beta1.WebhookClientConfig{URL: &urlB}},
+				{Name: "c", ClientConfig: admissionregistrationv1beta1.WebhookClientConfig{URL: &urlC}},
+			}
+
+			dialed []string
+		)
+
+		BeforeEach(func() {
+			dialed = nil
+		})
+
+		It("should dial each endpoint once", func() {
+			check := NewEndpointChecker(func(_ context.Context, network, address string) (net.Conn, error) {
+				dialed = append(dialed, address)
+				client, server := net.Pipe()
+				_ = server.Close()
+				return client, nil
+			}, time.Second)
+
+			Expect(check(context.TODO(), webhooks)).To(Succeed())
+			Expect(dialed).To(Equal([]string{
+				"gardener-extension-provider-test.extension:443",
+				"other.extension:8443",
+			}))
+		})
+
+		It("should return an error if an endpoint is unreachable", func() {
+			check := NewEndpointChecker(func(_ context.Context, network, address string) (net.Conn, error) {
+				dialed = append(dialed, address)
+				return nil, fmt.Errorf("connection refused")
+			}, time.Second)
+
+			err := check(context.TODO(), webhooks)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("webhook 'a' is unreachable"))
+			Expect(dialed).To(HaveLen(1))
+		})
+
+		It("should return an error if a webhook has no endpoint", func() {
+			check := NewEndpointChecker(nil, time.Second)
+
+			Expect(check(context.TODO(), []admissionregistrationv1beta1.Webhook{{Name: "a"}})).NotTo(Succeed())
+		})
+	})
+
+	Describe("#TriggerWebhookConfigReconciliation", func() {
+		const providerName = "provider-test"
+
+		var (
+			ctx = context.TODO()
+			c   client.Client
+
+			exposure = extensionsv1alpha1.Exposure
+		)
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+			Expect(networkingv1.AddToScheme(scheme)).To(Succeed())
+
+			c = fake.NewFakeClientWithScheme(scheme,
+				&extensionsv1alpha1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar"}},
+				&extensionsv1alpha1.ControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar-exposure"},
+					Spec:       extensionsv1alpha1.ControlPlaneSpec{Purpose: &exposure},
+				},
+				&extensionsv1alpha1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--baz", Name: "baz"}},
+				GetNetworkPolicyMeta("shoot--foo--bar", providerName),
+			)
+		})
+
+		It("should only annotate the controlplanes the shoot webhooks were deployed for", func() {
+			Expect(TriggerWebhookConfigReconciliation(ctx, c, providerName)).To(Succeed())
+
+			for _, expected := range []struct {
+				namespace, name string
+				annotated       bool
+			}{
+				{"shoot--foo--bar", "bar", true},
+				{"shoot--foo--bar", "bar-exposure", false},
+				{"shoot--foo--baz", "baz", false},
+			} {
+				cp := &extensionsv1alpha1.ControlPlane{}
+				Expect(c.Get(ctx, client.ObjectKey{Namespace: expected.namespace, Name: expected.name}, cp)).To(Succeed())
+				if expected.annotated {
+					Expect(cp.Annotations).To(HaveKeyWithValue(v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationReconcile))
+				} else {
+					Expect(cp.Annotations).NotTo(HaveKey(v1alpha1constants.GardenerOperation))
+				}
+			}
+		})
+
+		It("should not overwrite a pending operation of a controlplane", func() {
+			cp := &extensionsv1alpha1.ControlPlane{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: "shoot--foo--bar", Name: "bar"}, cp)).To(Succeed())
+			metav1.SetMetaDataAnnotation(&cp.ObjectMeta, v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationMigrate)
+			Expect(c.Update(ctx, cp)).To(Succeed())
+
+			Expect(TriggerWebhookConfigReconciliation(ctx, c, providerName)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: "shoot--foo--bar", Name: "bar"}, cp)).To(Succeed())
+			Expect(cp.Annotations).To(HaveKeyWithValue(v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationMigrate))
+		})
+	})
+})
@@ -0,0 +1,184 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// InfrastructureValidateFunc validates the given new Infrastructure. The old Infrastructure is only set for updates.
+type InfrastructureValidateFunc func(ctx context.Context, decoder runtime.Decoder, new, old *extensionsv1alpha1.Infrastructure) field.ErrorList
+
+// ControlPlaneValidateFunc validates the given new ControlPlane. The old ControlPlane is only set for updates.
+type ControlPlaneValidateFunc func(ctx context.Context, decoder runtime.Decoder, new, old *extensionsv1alpha1.ControlPlane) field.ErrorList
+
+// WorkerValidateFunc validates the given new Worker. The old Worker is only set for updates.
+type WorkerValidateFunc func(ctx context.Context, decoder runtime.Decoder, new, old *extensionsv1alpha1.Worker) field.ErrorList
+
+// NetworkValidateFunc validates the given new Network. The old Network is only set for updates.
+type NetworkValidateFunc func(ctx context.Context, decoder runtime.Decoder, new, old *extensionsv1alpha1.Network) field.ErrorList
+
+// Funcs are the functions a validator uses to validate the extension resources.
+type Funcs struct {
+	// Infrastructure validates Infrastructure resources.
+	Infrastructure InfrastructureValidateFunc
+	// ControlPlane validates ControlPlane resources.
+	ControlPlane ControlPlaneValidateFunc
+	// Worker validates Worker resources.
+	Worker WorkerValidateFunc
+	// Network validates Network resources.
+	Network NetworkValidateFunc
+}
+
+// Types returns the resource types for which validation functions are set.
+func (f Funcs) Types() []runtime.Object {
+	var types []runtime.Object
+	if f.Infrastructure != nil {
+		types = append(types, &extensionsv1alpha1.Infrastructure{})
+	}
+	if f.ControlPlane != nil {
+		types = append(types, &extensionsv1alpha1.ControlPlane{})
+	}
+	if f.Worker != nil {
+		types = append(types, &extensionsv1alpha1.Worker{})
+	}
+	if f.Network != nil {
+		types = append(types, &extensionsv1alpha1.Network{})
+	}
+	return types
+}
+
+// NewValidator creates a new validator that validates extension resources with the given functions. Resources
+// that are being deleted and updates that do not change the spec are not validated. If validation fails, an Invalid
+// error is returned.
+func NewValidator(funcs Funcs) extensionswebhook.Validator {
+	return &validator{funcs: funcs}
+}
+
+type validator struct {
+	funcs   Funcs
+	decoder runtime.Decoder
+}
+
+// InjectScheme injects the given scheme into the validator.
+func (v *validator) InjectScheme(scheme *runtime.Scheme) error {
+	v.decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+	return nil
+}
+
+// Validate validates the given new object. The old object is only set for updates.
+func (v *validator) Validate(ctx context.Context, new, old runtime.Object) error {
+	acc, err := meta.Accessor(new)
+	if err != nil {
+		return fmt.Errorf("could not create accessor during webhook: %v", err)
+	}
+	// If the object does have a deletion timestamp then we don't want to validate anything.
+	if acc.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	var (
+		kind    string
+		allErrs field.ErrorList
+	)
+
+	switch obj := new.(type) {
+	case *extensionsv1alpha1.Infrastructure:
+		kind = extensionsv1alpha1.InfrastructureResource
+		oldObj, _ := old.(*extensionsv1alpha1.Infrastructure)
+		if v.funcs.Infrastructure != nil && (oldObj == nil || !apiequality.Semantic.DeepEqual(obj.Spec, oldObj.Spec)) {
+			allErrs = v.funcs.Infrastructure(ctx, v.decoder, obj, oldObj)
+		}
+	case *extensionsv1alpha1.ControlPlane:
+		kind = extensionsv1alpha1.ControlPlaneResource
+		oldObj, _ := old.(*extensionsv1alpha1.ControlPlane)
+		if v.funcs.ControlPlane != nil && (oldObj == nil || !apiequality.Semantic.DeepEqual(obj.Spec, oldObj.Spec)) {
+			allErrs = v.funcs.ControlPlane(ctx, v.decoder, obj, oldObj)
+		}
+	case *extensionsv1alpha1.Worker:
+		kind = extensionsv1alpha1.WorkerResource
+		oldObj, _ := old.(*extensionsv1alpha1.Worker)
+		if v.funcs.Worker != nil && (oldObj == nil || !apiequality.Semantic.DeepEqual(obj.Spec, oldObj.Spec)) {
+			allErrs = v.funcs.Worker(ctx, v.decoder, obj, oldObj)
+		}
+	case *extensionsv1alpha1.Network:
+		kind = extensionsv1alpha1.NetworkResource
+		oldObj, _ := old.(*extensionsv1alpha1.Network)
+		if v.funcs.Network != nil && (oldObj == nil || !apiequality.Semantic.DeepEqual(obj.Spec, oldObj.Spec)) {
+			allErrs = v.funcs.Network(ctx, v.decoder, obj, oldObj)
+		}
+	default:
+		return fmt.Errorf("could not validate, object is of unexpected type %T", new)
+	}
+
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(schema.GroupKind{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Kind: kind}, acc.GetName(), allErrs)
+	}
+	return nil
+}
+
+// DecodeProviderConfig decodes the given provider config into the given object. It returns a Required error if the
+// provider config is not set and an Invalid error if it cannot be decoded.
+func DecodeProviderConfig(decoder runtime.Decoder, providerConfig *runtime.RawExtension, into runtime.Object, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if providerConfig == nil || len(providerConfig.Raw) == 0 {
+		return append(allErrs, field.Required(fldPath, "must provide a provider config"))
+	}
+	if _, _, err := decoder.Decode(providerConfig.Raw, nil, into); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, string(providerConfig.Raw), fmt.Sprintf("could not decode provider config: %v", err)))
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerPoolZones validates that each of the given worker pools specifies at least one zone and that the zones
+// of a worker pool are unique.
+func ValidateWorkerPoolZones(pools []extensionsv1alpha1.WorkerPool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, pool := range pools {
+		zonesPath := fldPath.Index(i).Child("zones")
+		if len(pool.Zones) == 0 {
+			allErrs = append(allErrs, field.Required(zonesPath, fmt.Sprintf("must provide at least one zone for worker pool %q", pool.Name)))
+		}
+
+		zones := map[string]bool{}
+		for j, zone := range pool.Zones {
+			if len(zone) == 0 {
+				allErrs = append(allErrs, field.Required(zonesPath.Index(j), "must provide a zone name"))
+				continue
+			}
+			if zones[zone] {
+				allErrs = append(allErrs, field.Duplicate(zonesPath.Index(j), zone))
+			}
+			zones[zone] = true
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator_test
+
+import (
+	"context"
+
+	. "github.com/gardener/gardener-extensions/pkg/webhook/validator"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+var _ = Describe("Funcs", func() {
+	var (
+		ctx            = context.TODO()
+		infrastructure *extensionsv1alpha1.Infrastructure
+		oldObjects     []*extensionsv1alpha1.Infrastructure
+		funcs          Funcs
+	)
+
+	BeforeEach(func() {
+		infrastructure = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"}}
+		oldObjects = nil
+		funcs = Funcs{
+			Infrastructure: func(_ context.Context, _ runtime.Decoder, new, old *extensionsv1alpha1.Infrastructure) field.ErrorList {
+				oldObjects = append(oldObjects, old)
+				if new.Spec.Region == "" {
+					return field.ErrorList{field.Required(field.NewPath("spec", "region"), "must provide a region")}
+				}
+				return nil
+			},
+		}
+	})
+
+	Describe("#Types", func() {
+		It("should only return the types with validation functions", func() {
+			Expect(funcs.Types()).To(Equal([]runtime.Object{&extensionsv1alpha1.Infrastructure{}}))
+		})
+	})
+
+	Describe("#NewValidator", func() {
+		It("should return an Invalid error if validation fails", func() {
+			err := NewValidator(funcs).Validate(ctx, infrastructure, nil)
+
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.region"))
+			Expect(oldObjects).To(Equal([]*extensionsv1alpha1.Infrastructure{nil}))
+		})
+
+		It("should pass the old object on updates", func() {
+			old := infrastructure.DeepCopy()
+			infrastructure.Spec.Region = "eu-west-1"
+
+			Expect(NewValidator(funcs).Validate(ctx, infrastructure, old)).To(Succeed())
+			Expect(oldObjects).To(Equal([]*extensionsv1alpha1.Infrastructure{old}))
+		})
+
+		It("should not validate updates that do not change the spec", func() {
+			old := infrastructure.DeepCopy()
+			infrastructure.Annotations = map[string]string{"foo": "bar"}
+
+			Expect(NewValidator(funcs).Validate(ctx, infrastructure, old)).To(Succeed())
+			Expect(oldObjects).To(BeEmpty())
+		})
+
+		It("should not validate objects that are being deleted", func() {
+			now := metav1.Now()
+			infrastructure.DeletionTimestamp = &now
+
+			Expect(NewValidator(funcs).Validate(ctx, infrastructure, nil)).To(Succeed())
+			Expect(oldObjects).To(BeEmpty())
+		})
+
+		It("should allow types without validation function", func() {
+			Expect(NewValidator(funcs).Validate(ctx, &extensionsv1alpha1.Worker{}, nil)).To(Succeed())
+		})
+
+		It("should inject a decoder for the scheme", func() {
+			var decoder runtime.Decoder
+			funcs.Infrastructure = func(_ context.Context, d runtime.Decoder, _, _ *extensionsv1alpha1.Infrastructure) field.ErrorList {
+				decoder = d
+				return nil
+			}
+			validator := NewValidator(funcs)
+
+			Expect(validator.(inject.Scheme).InjectScheme(scheme.Scheme)).To(Succeed())
+			Expect(validator.Validate(ctx, infrastructure, nil)).To(Succeed())
+			Expect(decoder).NotTo(BeNil())
+		})
+	})
+
+	Describe("#DecodeProviderConfig", func() {
+		var (
+			decoder = serializer.NewCodecFactory(scheme.Scheme).UniversalDecoder()
+			fldPath = field.NewPath("spec", "providerConfig")
+		)
+
+		It("should decode the provider config", func() {
+			configMap := &corev1.ConfigMap{}
+
+			Expect(DecodeProviderConfig(decoder, &runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","data":{"foo":"bar"}}`)}, configMap, fldPath)).To(BeEmpty())
+			Expect(configMap.Data).To(Equal(map[string]string{"foo": "bar"}))
+		})
+
+		It("should require a provider config", func() {
+			Expect(DecodeProviderConfig(decoder, nil, &corev1.ConfigMap{}, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig"),
+			}))))
+		})
+
+		It("should forbid provider configs that cannot be decoded", func() {
+			Expect(DecodeProviderConfig(decoder, &runtime.RawExtension{Raw: []byte(`{`)}, &corev1.ConfigMap{}, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig"),
+			}))))
+		})
+	})
+})
+
+var _ = Describe("#ValidateWorkerPoolZones", func() {
+	fldPath := field.NewPath("spec", "pools")
+
+	It("should allow pools with unique zones", func() {
+		Expect(ValidateWorkerPoolZones([]extensionsv1alpha1.WorkerPool{{Name: "a", Zones: []string{"z1", "z2"}}}, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid pools without or with duplicate zones", func() {
+		errs := ValidateWorkerPoolZones([]extensionsv1alpha1.WorkerPool{{Name: "a"}, {Name: "b", Zones: []string{"z1", "z1"}}}, fldPath)
+
+		Expect(errs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.pools[0].zones"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeDuplicate),
+			"Field": Equal("spec.pools[1].zones[1]"),
+		}))))
+	})
+})